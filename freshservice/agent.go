@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -13,7 +12,7 @@ const (
 	agentReactivateUrl = "agents/%d/reactivate"
 )
 
-// AgentService API Docs: https://api.freshservice.com/#agents https://api.freshservice.com/#agent-roles https://api.freshservice.com/#agent-groups
+// AgentService API Docs: https://api.freshservice.com/#agents https://api.freshservice.com/#agent-roles (Agent Groups are available through GroupService)
 type AgentService struct {
 	client *Client
 }
@@ -51,11 +50,11 @@ type Agent struct {
 	MemberOf              []int                 `json:"member_of"`
 	ObserverOf            []int                 `json:"observer_of"`
 	Roles                 []AgentRoleAssignment `json:"roles"`
-	LastLoginAt           time.Time             `json:"last_login_at"`
-	LastActiveAt          time.Time             `json:"last_active_at"`
+	LastLoginAt           Time                  `json:"last_login_at"`
+	LastActiveAt          Time                  `json:"last_active_at"`
 	HasLoggedIn           bool                  `json:"has_logged_in"`
-	CreatedAt             time.Time             `json:"created_at"`
-	UpdatedAt             time.Time             `json:"updated_at"`
+	CreatedAt             Time                  `json:"created_at"`
+	UpdatedAt             Time                  `json:"updated_at"`
 }
 
 // CreateAgentModel is a data struct for creating a new Agent
@@ -83,20 +82,27 @@ type CreateAgentModel struct {
 
 // UpdateAgentModel ris the data struct required to update an Agent
 type UpdateAgentModel struct {
-	Occasional            bool                  `json:"occasional"`
-	Email                 string                `json:"email"`
-	DepartmentIDs         []int                 `json:"department_ids"`
-	Address               string                `json:"address"`
-	ReportingManagerID    int                   `json:"reporting_manager_id"`
-	TimeZone              string                `json:"time_zone"`
-	TimeFormat            string                `json:"time_format"`
-	Language              string                `json:"language"`
-	LocationID            int                   `json:"location_id"`
-	BackgroundInformation string                `json:"background_information"`
-	ScoreboardLevelID     int                   `json:"scoreboard_level_id"`
-	MemberOf              []int                 `json:"member_of"`
-	ObserverOf            []int                 `json:"observer_of"`
-	Roles                 []AgentRoleAssignment `json:"roles"`
+	Occasional            *bool                 `json:"occasional,omitempty"`
+	Email                 *string               `json:"email,omitempty"`
+	DepartmentIDs         []int                 `json:"department_ids,omitempty"`
+	Address               *string               `json:"address,omitempty"`
+	ReportingManagerID    *int                  `json:"reporting_manager_id,omitempty"`
+	TimeZone              *string               `json:"time_zone,omitempty"`
+	TimeFormat            *string               `json:"time_format,omitempty"`
+	Language              *string               `json:"language,omitempty"`
+	LocationID            *int                  `json:"location_id,omitempty"`
+	BackgroundInformation *string               `json:"background_information,omitempty"`
+	ScoreboardLevelID     *int                  `json:"scoreboard_level_id,omitempty"`
+	MemberOf              []int                 `json:"member_of,omitempty"`
+	ObserverOf            []int                 `json:"observer_of,omitempty"`
+	Roles                 []AgentRoleAssignment `json:"roles,omitempty"`
+	NullFields            []string              `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateAgentModel which are set, along with the NullFields as null
+func (m UpdateAgentModel) MarshalJSON() ([]byte, error) {
+	type model UpdateAgentModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // AgentRoleAssignment represents a Role Assignment on an Agent
@@ -115,48 +121,78 @@ type ListAgentsOptions struct {
 }
 
 // GetAgent will return a single Agent by id
-func (s *AgentService) GetAgent(id int) (*Agent, *http.Response, error) {
+func (s *AgentService) GetAgent(ctx context.Context, id int) (*Agent, *Response, error) {
 	o := new(agentWrapper)
-	res, err := s.client.Get(fmt.Sprintf(agentIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(agentIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListAgents will return paginated/filtered Agents using ListAgentsOptions
-func (s *AgentService) ListAgents(opt *ListAgentsOptions) (*Agents, *http.Response, error) {
+func (s *AgentService) ListAgents(ctx context.Context, opt *ListAgentsOptions) (*Agents, *Response, error) {
 	o := new(Agents)
-	res, err := s.client.List(agentsUrl, opt, &o)
+	res, err := s.client.List(ctx, agentsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateAgent will create and return a new Agent based on CreateAgentModel
-func (s *AgentService) CreateAgent(newAgent *CreateAgentModel) (*Agent, *http.Response, error) {
+func (s *AgentService) CreateAgent(ctx context.Context, newAgent *CreateAgentModel) (*Agent, *Response, error) {
 	o := new(agentWrapper)
-	res, err := s.client.Post(agentsUrl, newAgent, &o)
+	res, err := s.client.Post(ctx, agentsUrl, newAgent, &o)
 	return &o.Details, res, err
 }
 
 // UpdateAgent will update and return an Agent matching id based on UpdateAgentModel
-func (s *AgentService) UpdateAgent(id int, agent *UpdateAgentModel) (*Agent, *http.Response, error) {
+func (s *AgentService) UpdateAgent(ctx context.Context, id int, agent *UpdateAgentModel) (*Agent, *Response, error) {
 	o := new(agentWrapper)
-	res, err := s.client.Put(fmt.Sprintf(agentIdUrl, id), agent, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(agentIdUrl, id), agent, &o)
 	return &o.Details, res, err
 }
 
 // DeleteAgent will completely remove an Agent from FreshService matching id (along with their requested Tickets)
-func (s *AgentService) DeleteAgent(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(agentForgetUrl, id))
+func (s *AgentService) DeleteAgent(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(agentForgetUrl, id))
 	return success, res, err
 }
 
 // DeactivateAgent will deactivate the Agent matching the id
-func (s *AgentService) DeactivateAgent(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(agentIdUrl, id))
+func (s *AgentService) DeactivateAgent(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(agentIdUrl, id))
 	return success, res, err
 }
 
 // ReactivateAgent will reactivate a deactivated Agent matching the id
-func (s *AgentService) ReactivateAgent(id int) (*Agent, *http.Response, error) {
+func (s *AgentService) ReactivateAgent(ctx context.Context, id int) (*Agent, *Response, error) {
 	o := new(agentWrapper)
-	res, err := s.client.Put(fmt.Sprintf(agentReactivateUrl, id), nil, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(agentReactivateUrl, id), nil, &o)
 	return &o.Details, res, err
 }
+
+// IterAgents will call fn for every Agent matching ListAgentsOptions, following pagination until fn returns false or a limit is reached
+func (s *AgentService) IterAgents(ctx context.Context, opt *ListAgentsOptions, limit *PaginationOptions, fn func(Agent) bool) error {
+	o := ListAgentsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListAgents(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllAgents will return every Agent matching ListAgentsOptions by following pagination
+func (s *AgentService) ListAllAgents(ctx context.Context, opt *ListAgentsOptions, limit *PaginationOptions) ([]Agent, error) {
+	var all []Agent
+	err := s.IterAgents(ctx, opt, limit, func(i Agent) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -23,12 +22,12 @@ type agentRoleWrapper struct {
 
 // AgentRole represents a FreshService AgentRole
 type AgentRole struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Default     bool      `json:"default"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+	CreatedAt   Time   `json:"created_at"`
+	UpdatedAt   Time   `json:"updated_at"`
 }
 
 // ListAgentRolesOptions represents pagination/filtering for AgentRoles
@@ -37,15 +36,42 @@ type ListAgentRolesOptions struct {
 }
 
 // GetAgentRole will return a single AgentRole by id
-func (s *AgentService) GetAgentRole(id int) (*AgentRole, *http.Response, error) {
+func (s *AgentService) GetAgentRole(ctx context.Context, id int) (*AgentRole, *Response, error) {
 	o := new(agentRoleWrapper)
-	res, err := s.client.Get(fmt.Sprintf(agentRoleIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(agentRoleIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListAgentRoles will return paginated/filtered AgentRoles using ListAgentRolesOptions
-func (s *AgentService) ListAgentRoles(opt ListAgentRolesOptions) (*AgentRoles, *http.Response, error) {
+func (s *AgentService) ListAgentRoles(ctx context.Context, opt ListAgentRolesOptions) (*AgentRoles, *Response, error) {
 	o := new(AgentRoles)
-	res, err := s.client.List(agentRolesUrl, opt, &o)
+	res, err := s.client.List(ctx, agentRolesUrl, opt, &o)
 	return o, res, err
 }
+
+// IterAgentRoles will call fn for every AgentRole matching ListAgentRolesOptions, following pagination until fn returns false or a limit is reached
+func (s *AgentService) IterAgentRoles(ctx context.Context, opt ListAgentRolesOptions, limit *PaginationOptions, fn func(AgentRole) bool) error {
+	o := opt
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListAgentRoles(ctx, o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllAgentRoles will return every AgentRole matching ListAgentRolesOptions by following pagination
+func (s *AgentService) ListAllAgentRoles(ctx context.Context, opt ListAgentRolesOptions, limit *PaginationOptions) ([]AgentRole, error) {
+	var all []AgentRole
+	err := s.IterAgentRoles(ctx, opt, limit, func(i AgentRole) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
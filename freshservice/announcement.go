@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -28,48 +27,55 @@ type announcementWrapper struct {
 
 // Announcement represents a FreshService Announcement
 type Announcement struct {
-	ID               int       `json:"id"`
-	CreatedBy        int       `json:"created_by"`
-	State            string    `json:"state"`
-	Title            string    `json:"title"`
-	Body             string    `json:"body"`
-	BodyHtml         string    `json:"body_html"`
-	VisibleFrom      time.Time `json:"visible_from"`
-	VisibleTo        time.Time `json:"visible_to"`
-	Visibility       string    `json:"visibility"`
-	Departments      []int     `json:"departments"`
-	Groups           []int     `json:"groups"`
-	IsRead           bool      `json:"is_read"`
-	SendEmail        bool      `json:"send_email"`
-	AdditionalEmails []string  `json:"additional_emails"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID               int      `json:"id"`
+	CreatedBy        int      `json:"created_by"`
+	State            string   `json:"state"`
+	Title            string   `json:"title"`
+	Body             string   `json:"body"`
+	BodyHtml         string   `json:"body_html"`
+	VisibleFrom      Time     `json:"visible_from"`
+	VisibleTo        Time     `json:"visible_to"`
+	Visibility       string   `json:"visibility"`
+	Departments      []int    `json:"departments"`
+	Groups           []int    `json:"groups"`
+	IsRead           bool     `json:"is_read"`
+	SendEmail        bool     `json:"send_email"`
+	AdditionalEmails []string `json:"additional_emails"`
+	CreatedAt        Time     `json:"created_at"`
+	UpdatedAt        Time     `json:"updated_at"`
 }
 
 // CreateAnnouncementModel is the data structure required to create an Announcement
 type CreateAnnouncementModel struct {
-	Title            string    `json:"title"`
-	BodyHtml         string    `json:"body_html"`
-	VisibleFrom      time.Time `json:"visible_from"`
-	VisibleTo        time.Time `json:"visible_to"`
-	Visibility       string    `json:"visibility"`
-	Departments      []int     `json:"departments"`
-	Groups           []int     `json:"groups"`
-	SendEmail        bool      `json:"send_email"`
-	AdditionalEmails []string  `json:"additional_emails"`
+	Title            string   `json:"title"`
+	BodyHtml         string   `json:"body_html"`
+	VisibleFrom      Time     `json:"visible_from"`
+	VisibleTo        Time     `json:"visible_to"`
+	Visibility       string   `json:"visibility"`
+	Departments      []int    `json:"departments"`
+	Groups           []int    `json:"groups"`
+	SendEmail        bool     `json:"send_email"`
+	AdditionalEmails []string `json:"additional_emails"`
 }
 
 // UpdateAnnouncementModel is the data structure required to update an Announcement
 type UpdateAnnouncementModel struct {
-	Title            string    `json:"title"`
-	BodyHtml         string    `json:"body_html"`
-	VisibleFrom      time.Time `json:"visible_from"`
-	VisibleTo        time.Time `json:"visible_to"`
-	Visibility       string    `json:"visibility"`
-	Departments      []int     `json:"departments"`
-	Groups           []int     `json:"groups"`
-	SendEmail        bool      `json:"send_email"`
-	AdditionalEmails []string  `json:"additional_emails"`
+	Title            *string  `json:"title,omitempty"`
+	BodyHtml         *string  `json:"body_html,omitempty"`
+	VisibleFrom      *Time    `json:"visible_from,omitempty"`
+	VisibleTo        *Time    `json:"visible_to,omitempty"`
+	Visibility       *string  `json:"visibility,omitempty"`
+	Departments      []int    `json:"departments,omitempty"`
+	Groups           []int    `json:"groups,omitempty"`
+	SendEmail        *bool    `json:"send_email,omitempty"`
+	AdditionalEmails []string `json:"additional_emails,omitempty"`
+	NullFields       []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateAnnouncementModel which are set, along with the NullFields as null
+func (m UpdateAnnouncementModel) MarshalJSON() ([]byte, error) {
+	type model UpdateAnnouncementModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListAnnouncementsOptions represents filters/pagination for Announcements
@@ -79,35 +85,62 @@ type ListAnnouncementsOptions struct {
 }
 
 // GetAnnouncement will return a single Announcement by id
-func (s *AnnouncementService) GetAnnouncement(id int) (*Announcement, *http.Response, error) {
+func (s *AnnouncementService) GetAnnouncement(ctx context.Context, id int) (*Announcement, *Response, error) {
 	o := new(announcementWrapper)
-	res, err := s.client.Get(fmt.Sprintf(announcementIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(announcementIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListAnnouncements will return paginated/filtered Announcements using ListAnnouncementsOptions
-func (s *AnnouncementService) ListAnnouncements(opt ListAnnouncementsOptions) (*Announcements, *http.Response, error) {
+func (s *AnnouncementService) ListAnnouncements(ctx context.Context, opt ListAnnouncementsOptions) (*Announcements, *Response, error) {
 	o := new(Announcements)
-	res, err := s.client.List(announcementsUrl, opt, &o)
+	res, err := s.client.List(ctx, announcementsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateAnnouncement will create and return a new Announcement based on CreateAnnouncementModel
-func (s *AnnouncementService) CreateAnnouncement(newAnnouncement *CreateAnnouncementModel) (*Announcement, *http.Response, error) {
+func (s *AnnouncementService) CreateAnnouncement(ctx context.Context, newAnnouncement *CreateAnnouncementModel) (*Announcement, *Response, error) {
 	o := new(announcementWrapper)
-	res, err := s.client.Post(announcementsUrl, newAnnouncement, &o)
+	res, err := s.client.Post(ctx, announcementsUrl, newAnnouncement, &o)
 	return &o.Details, res, err
 }
 
 // UpdateAnnouncement will update and return the Announcement matching the id based on UpdateAnnouncementModel
-func (s *AnnouncementService) UpdateAnnouncement(id int, announcement *UpdateAnnouncementModel) (*Announcement, *http.Response, error) {
+func (s *AnnouncementService) UpdateAnnouncement(ctx context.Context, id int, announcement *UpdateAnnouncementModel) (*Announcement, *Response, error) {
 	o := new(announcementWrapper)
-	res, err := s.client.Put(fmt.Sprintf(announcementIdUrl, id), announcement, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(announcementIdUrl, id), announcement, &o)
 	return &o.Details, res, err
 }
 
 // DeleteAnnouncement irrecoverably removes an Announcement from FreshService matching the id
-func (s *AnnouncementService) DeleteAnnouncement(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(announcementIdUrl, id))
+func (s *AnnouncementService) DeleteAnnouncement(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(announcementIdUrl, id))
 	return success, res, err
 }
+
+// IterAnnouncements will call fn for every Announcement matching ListAnnouncementsOptions, following pagination until fn returns false or a limit is reached
+func (s *AnnouncementService) IterAnnouncements(ctx context.Context, opt ListAnnouncementsOptions, limit *PaginationOptions, fn func(Announcement) bool) error {
+	o := opt
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListAnnouncements(ctx, o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllAnnouncements will return every Announcement matching ListAnnouncementsOptions by following pagination
+func (s *AnnouncementService) ListAllAnnouncements(ctx context.Context, opt ListAnnouncementsOptions, limit *PaginationOptions) ([]Announcement, error) {
+	var all []Announcement
+	err := s.IterAnnouncements(ctx, opt, limit, func(i Announcement) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
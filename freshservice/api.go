@@ -0,0 +1,980 @@
+// Code generated by apigen; DO NOT EDIT.
+
+package freshservice
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// AgentsAPI is the interface of AgentService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type AgentsAPI interface {
+	// CreateAgent will create and return a new Agent based on CreateAgentModel
+	CreateAgent(ctx context.Context, newAgent *CreateAgentModel) (*Agent, *Response, error)
+
+	// DeactivateAgent will deactivate the Agent matching the id
+	DeactivateAgent(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteAgent will completely remove an Agent from FreshService matching id (along with their requested Tickets)
+	DeleteAgent(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetAgent will return a single Agent by id
+	GetAgent(ctx context.Context, id int) (*Agent, *Response, error)
+
+	// GetAgentRole will return a single AgentRole by id
+	GetAgentRole(ctx context.Context, id int) (*AgentRole, *Response, error)
+
+	// IterAgentRoles will call fn for every AgentRole matching ListAgentRolesOptions, following pagination until fn returns false or a limit is reached
+	IterAgentRoles(ctx context.Context, opt ListAgentRolesOptions, limit *PaginationOptions, fn func(AgentRole) bool) error
+
+	// IterAgents will call fn for every Agent matching ListAgentsOptions, following pagination until fn returns false or a limit is reached
+	IterAgents(ctx context.Context, opt *ListAgentsOptions, limit *PaginationOptions, fn func(Agent) bool) error
+
+	// ListAgentRoles will return paginated/filtered AgentRoles using ListAgentRolesOptions
+	ListAgentRoles(ctx context.Context, opt ListAgentRolesOptions) (*AgentRoles, *Response, error)
+
+	// ListAgents will return paginated/filtered Agents using ListAgentsOptions
+	ListAgents(ctx context.Context, opt *ListAgentsOptions) (*Agents, *Response, error)
+
+	// ListAllAgentRoles will return every AgentRole matching ListAgentRolesOptions by following pagination
+	ListAllAgentRoles(ctx context.Context, opt ListAgentRolesOptions, limit *PaginationOptions) ([]AgentRole, error)
+
+	// ListAllAgents will return every Agent matching ListAgentsOptions by following pagination
+	ListAllAgents(ctx context.Context, opt *ListAgentsOptions, limit *PaginationOptions) ([]Agent, error)
+
+	// ReactivateAgent will reactivate a deactivated Agent matching the id
+	ReactivateAgent(ctx context.Context, id int) (*Agent, *Response, error)
+
+	// UpdateAgent will update and return an Agent matching id based on UpdateAgentModel
+	UpdateAgent(ctx context.Context, id int, agent *UpdateAgentModel) (*Agent, *Response, error)
+}
+
+// AnnouncementsAPI is the interface of AnnouncementService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type AnnouncementsAPI interface {
+	// CreateAnnouncement will create and return a new Announcement based on CreateAnnouncementModel
+	CreateAnnouncement(ctx context.Context, newAnnouncement *CreateAnnouncementModel) (*Announcement, *Response, error)
+
+	// DeleteAnnouncement irrecoverably removes an Announcement from FreshService matching the id
+	DeleteAnnouncement(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetAnnouncement will return a single Announcement by id
+	GetAnnouncement(ctx context.Context, id int) (*Announcement, *Response, error)
+
+	// IterAnnouncements will call fn for every Announcement matching ListAnnouncementsOptions, following pagination until fn returns false or a limit is reached
+	IterAnnouncements(ctx context.Context, opt ListAnnouncementsOptions, limit *PaginationOptions, fn func(Announcement) bool) error
+
+	// ListAllAnnouncements will return every Announcement matching ListAnnouncementsOptions by following pagination
+	ListAllAnnouncements(ctx context.Context, opt ListAnnouncementsOptions, limit *PaginationOptions) ([]Announcement, error)
+
+	// ListAnnouncements will return paginated/filtered Announcements using ListAnnouncementsOptions
+	ListAnnouncements(ctx context.Context, opt ListAnnouncementsOptions) (*Announcements, *Response, error)
+
+	// UpdateAnnouncement will update and return the Announcement matching the id based on UpdateAnnouncementModel
+	UpdateAnnouncement(ctx context.Context, id int, announcement *UpdateAnnouncementModel) (*Announcement, *Response, error)
+}
+
+// AssetsAPI is the interface of AssetService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type AssetsAPI interface {
+	// CreateAsset will create and return a new Asset based on CreateAssetModel
+	CreateAsset(ctx context.Context, newAsset *CreateAssetModel) (*Asset, *Response, error)
+
+	// CreateAssetType creates and returns a new AssetType based on CreateAssetTypeModel
+	CreateAssetType(ctx context.Context, newAssetType CreateAssetTypeModel) (*AssetType, *Response, error)
+
+	// CreateRelationships will start a RelationshipJob creating the Relationships in bulk, use WaitForRelationshipJob
+	// to wait for it to complete
+	CreateRelationships(ctx context.Context, relationships []CreateRelationshipModel) (*RelationshipJob, *Response, error)
+
+	// DeleteAsset irrecoverably removes an Asset from FreshService matching the displayId
+	DeleteAsset(ctx context.Context, displayId int) (bool, *Response, error)
+
+	// DeleteAssetType irrecoverably deletes an AssetType from FreshService matching the id
+	DeleteAssetType(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteRelationships will remove the Relationships matching ids
+	DeleteRelationships(ctx context.Context, ids ...int) (bool, *Response, error)
+
+	// FilterAssets will return a page of Assets matching the Query
+	// e.g. Q.Eq("asset_type_id", 5).And(Q.Eq("location_id", 3))
+	FilterAssets(ctx context.Context, q Query, opt *ListAssetsOptions) (*Assets, *Response, error)
+
+	// GetAsset will return a single Asset by displayId
+	GetAsset(ctx context.Context, displayId int) (*Asset, *Response, error)
+
+	// GetAssetType returns an AssetType by id
+	GetAssetType(ctx context.Context, id int) (*AssetType, *Response, error)
+
+	// GetAssetWithTypeFields will return a single Asset by displayId, including its TypeFields
+	GetAssetWithTypeFields(ctx context.Context, displayId int) (*Asset, *Response, error)
+
+	// GetRelationshipJob will return the current state of a RelationshipJob by id
+	GetRelationshipJob(ctx context.Context, jobId string) (*RelationshipJob, *Response, error)
+
+	// GetRelationships will return the Relationships matching ids
+	GetRelationships(ctx context.Context, ids ...int) (*Relationships, *Response, error)
+
+	// IterAssetTypes will call fn for every AssetType matching ListAssetTypesOptions, following pagination until fn returns false or a limit is reached
+	IterAssetTypes(ctx context.Context, opt *ListAssetTypesOptions, limit *PaginationOptions, fn func(AssetType) bool) error
+
+	// IterAssets will call fn for every Asset matching ListAssetsOptions, following pagination until fn returns false or a limit is reached
+	IterAssets(ctx context.Context, opt *ListAssetsOptions, limit *PaginationOptions, fn func(Asset) bool) error
+
+	// IterFilterAssets will call fn for every Asset matching the filter Query, following pagination until fn returns false or a limit is reached
+	IterFilterAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions, fn func(Asset) bool) error
+
+	// IterSearchAssets will call fn for every Asset matching the search Query, following pagination until fn returns false or a limit is reached
+	IterSearchAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions, fn func(Asset) bool) error
+
+	// ListAllAssetTypes will return every AssetType matching ListAssetTypesOptions by following pagination
+	ListAllAssetTypes(ctx context.Context, opt *ListAssetTypesOptions, limit *PaginationOptions) ([]AssetType, error)
+
+	// ListAllAssets will return every Asset matching ListAssetsOptions by following pagination
+	ListAllAssets(ctx context.Context, opt *ListAssetsOptions, limit *PaginationOptions) ([]Asset, error)
+
+	// ListAllFilterAssets will return every Asset matching the filter Query by following pagination
+	ListAllFilterAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions) ([]Asset, error)
+
+	// ListAllSearchAssets will return every Asset matching the search Query by following pagination
+	ListAllSearchAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions) ([]Asset, error)
+
+	// ListAssetComponents will return all AssetComponents for a given Asset by displayId
+	ListAssetComponents(ctx context.Context, displayId int) (*AssetComponents, *Response, error)
+
+	// ListAssetContracts will return all AssetContracts for a given Asset by displayId
+	ListAssetContracts(ctx context.Context, displayId int) (*AssetContracts, *Response, error)
+
+	// ListAssetTypes will return paginated/filtered AssetTypes using ListAssetTypesOptions
+	ListAssetTypes(ctx context.Context, opt *ListAssetTypesOptions) (*AssetTypes, *Response, error)
+
+	// ListAssets will return paginated/filtered Assets using ListAssetsOptions
+	ListAssets(ctx context.Context, opt *ListAssetsOptions) (*Assets, *Response, error)
+
+	// ListRelationshipTypes will return all RelationshipTypes
+	ListRelationshipTypes(ctx context.Context) (*RelationshipTypes, *Response, error)
+
+	// ListRelationships will return all Relationships of an Asset by displayId
+	ListRelationships(ctx context.Context, displayId int) (*Relationships, *Response, error)
+
+	// RestoreAsset will restore a previously Trashed Asset by displayId
+	RestoreAsset(ctx context.Context, displayId int) (bool, *Response, error)
+
+	// SearchAssets will return a page of Assets matching the Query, which may use the name, asset_tag, serial_number,
+	// mac_addresses, ip_addresses, uuid or item_id fields e.g. Q.Eq("serial_number", "HSN123")
+	SearchAssets(ctx context.Context, q Query, opt *ListAssetsOptions) (*Assets, *Response, error)
+
+	// TrashAsset will trash the Asset matching the displayId (non-permanent delete)
+	TrashAsset(ctx context.Context, displayId int) (bool, *Response, error)
+
+	// UpdateAsset will update and return an Asset matching displayId based on UpdateAssetModel
+	UpdateAsset(ctx context.Context, displayId int, asset *UpdateAssetModel) (*Asset, *Response, error)
+
+	// UpdateAssetType updates and returns an AssetType matching id based on UpdateAssetTypeModel
+	UpdateAssetType(ctx context.Context, id int, updatedAssetType UpdateAssetTypeModel) (*AssetType, *Response, error)
+
+	// WaitForRelationshipJob will poll the RelationshipJob every interval (2 seconds when 0) until it is Done or ctx is
+	// cancelled. A failed job is returned along with an error, a partial job without one so the results can be inspected.
+	// A job with an empty or unknown status is returned with an error rather than polled forever.
+	WaitForRelationshipJob(ctx context.Context, jobId string, interval time.Duration) (*RelationshipJob, error)
+}
+
+// BusinessHoursAPI is the interface of BusinessHoursService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type BusinessHoursAPI interface {
+	// GetBusinessHours will return a single BusinessHour configuration by id
+	GetBusinessHours(ctx context.Context, id int) (*BusinessHour, *Response, error)
+
+	// IterBusinessHours will call fn for every BusinessHour matching ListBusinessHoursOptions, following pagination until fn returns false or a limit is reached
+	IterBusinessHours(ctx context.Context, opt *ListBusinessHoursOptions, limit *PaginationOptions, fn func(BusinessHour) bool) error
+
+	// ListAllBusinessHours will return every BusinessHour matching ListBusinessHoursOptions by following pagination
+	ListAllBusinessHours(ctx context.Context, opt *ListBusinessHoursOptions, limit *PaginationOptions) ([]BusinessHour, error)
+
+	// ListBusinessHours will return paginated/filtered BusinessHours using ListBusinessHoursOptions
+	ListBusinessHours(ctx context.Context, opt *ListBusinessHoursOptions) (*BusinessHours, *Response, error)
+}
+
+// ChangesAPI is the interface of ChangeService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type ChangesAPI interface {
+	// CreateChange will create and return a new Change based on CreateChangeModel
+	CreateChange(ctx context.Context, newChange *CreateChangeModel) (*Change, *Response, error)
+
+	// CreateChangeNote will create and return a new Note based on UpsertNoteModel
+	CreateChangeNote(ctx context.Context, changeId int, note *UpsertNoteModel) (*Note, *Response, error)
+
+	// DeleteChange will trash a Change from FreshService (Can be restored by RestoreChange)
+	DeleteChange(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteChangeNote will completely remove a Note from a Change
+	DeleteChangeNote(ctx context.Context, changeId int, changeNoteId int) (bool, *Response, error)
+
+	// GetChange will return a single Change by id
+	GetChange(ctx context.Context, id int) (*Change, *Response, error)
+
+	// GetChangeNote will return a single Note by id
+	GetChangeNote(ctx context.Context, changeId int, changeNoteId int) (*Note, *Response, error)
+
+	// IterChanges will call fn for every Change matching ListChangesOptions, following pagination until fn returns false or a limit is reached
+	IterChanges(ctx context.Context, opt *ListChangesOptions, limit *PaginationOptions, fn func(Change) bool) error
+
+	// ListAllChanges will return every Change matching ListChangesOptions by following pagination
+	ListAllChanges(ctx context.Context, opt *ListChangesOptions, limit *PaginationOptions) ([]Change, error)
+
+	// ListChangeFormFields will return the FormFields of the Change form
+	ListChangeFormFields(ctx context.Context) (*FormFields, *Response, error)
+
+	// ListChangeNotes will return  Notes for a specific Change
+	ListChangeNotes(ctx context.Context, changeId int) (*Notes, *Response, error)
+
+	// ListChanges will return paginated/filtered Change using ListChangesOptions
+	ListChanges(ctx context.Context, opt *ListChangesOptions) (*Changes, *Response, error)
+
+	// RestoreChange will restore a previously trashed (deleted) Change
+	RestoreChange(ctx context.Context, id int) (bool, *Response, error)
+
+	// UpdateChange will update and return a Change matching id based on UpdateChangeModel
+	UpdateChange(ctx context.Context, id int, ticket *UpdateChangeModel) (*Change, *Response, error)
+
+	// UpdateChangeNote will update and return a Note matching id based on UpsertNoteModel
+	UpdateChangeNote(ctx context.Context, changeId int, changeNoteId int, note *UpsertNoteModel) (*Note, *Response, error)
+}
+
+// ContractsAPI is the interface of ContractService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type ContractsAPI interface {
+	// ApproveContract allows for a Contract to be Approved
+	ApproveContract(ctx context.Context, id int) (bool, *Response, error)
+
+	// CreateContract will create and return a new Contract based on CreateContractModel
+	CreateContract(ctx context.Context, contract *CreateContractModel) (*Contract, *Response, error)
+
+	// GetContract will return a single Contract by id
+	GetContract(ctx context.Context, id int) (*Contract, *Response, error)
+
+	// IterContracts will call fn for every Contract matching ListContractsOptions, following pagination until fn returns false or a limit is reached
+	IterContracts(ctx context.Context, opt *ListContractsOptions, limit *PaginationOptions, fn func(Contract) bool) error
+
+	// ListAllContracts will return every Contract matching ListContractsOptions by following pagination
+	ListAllContracts(ctx context.Context, opt *ListContractsOptions, limit *PaginationOptions) ([]Contract, error)
+	ListContractAssociatedAssets(ctx context.Context, id int) (*AssociatedAssets, *Response, error)
+
+	// ListContractTypes will return ContractTypes
+	ListContractTypes(ctx context.Context) (*ContractTypes, *Response, error)
+
+	// ListContracts will return paginated/filtered Contracts using ListContractsOptions
+	ListContracts(ctx context.Context, opt *ListContractsOptions) (*Contracts, *Response, error)
+
+	// RejectContract rejects the Contract that was submitted for approval
+	RejectContract(ctx context.Context, id int) (bool, *Response, error)
+
+	// SubmitContractApproval allows for a Contract to be submitted for approval
+	SubmitContractApproval(ctx context.Context, id int) (bool, *Response, error)
+
+	// UpdateContract will update and return a Contract matching id based on UpdateContractModel
+	UpdateContract(ctx context.Context, id int, contract *UpdateContractModel) (*Contract, *Response, error)
+}
+
+// DepartmentsAPI is the interface of DepartmentService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type DepartmentsAPI interface {
+	// CreateDepartment will create and return a new Department based on CreateDepartmentModel
+	CreateDepartment(ctx context.Context, newDepartment *CreateDepartmentModel) (*Department, *Response, error)
+
+	// DeleteDepartment will completely remove a Department from FreshService matching id
+	DeleteDepartment(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetDepartment will return a single Department by id
+	GetDepartment(ctx context.Context, id int) (*Department, *Response, error)
+
+	// IterDepartments will call fn for every Department matching ListDepartmentsOptions, following pagination until fn returns false or a limit is reached
+	IterDepartments(ctx context.Context, opt *ListDepartmentsOptions, limit *PaginationOptions, fn func(Department) bool) error
+
+	// ListAllDepartments will return every Department matching ListDepartmentsOptions by following pagination
+	ListAllDepartments(ctx context.Context, opt *ListDepartmentsOptions, limit *PaginationOptions) ([]Department, error)
+
+	// ListDepartments will return paginated/filtered Departments using ListDepartmentsOptions
+	ListDepartments(ctx context.Context, opt *ListDepartmentsOptions) (*Departments, *Response, error)
+
+	// UpdateDepartment will update and return a Department matching id based on UpdateDepartmentModel
+	UpdateDepartment(ctx context.Context, id int, department *UpdateDepartmentModel) (*Department, *Response, error)
+}
+
+// GroupsAPI is the interface of GroupService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type GroupsAPI interface {
+	// CreateGroup will create and return a new Group based on CreateGroupModel
+	CreateGroup(ctx context.Context, newGroup *CreateGroupModel) (*Group, *Response, error)
+
+	// DeleteGroup will completely remove a Group from FreshService matching id
+	DeleteGroup(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetGroup will return a single Group by id
+	GetGroup(ctx context.Context, id int) (*Group, *Response, error)
+
+	// IterGroups will call fn for every Group, following pagination until fn returns false or a limit is reached
+	IterGroups(ctx context.Context, opt *ListGroupsOptions, limit *PaginationOptions, fn func(Group) bool) error
+
+	// ListAllGroups will return every Group by following pagination
+	ListAllGroups(ctx context.Context, opt *ListGroupsOptions, limit *PaginationOptions) ([]Group, error)
+
+	// ListGroups will return paginated Groups using ListGroupsOptions
+	ListGroups(ctx context.Context, opt *ListGroupsOptions) (*Groups, *Response, error)
+
+	// UpdateGroup will update and return a Group matching id based on UpdateGroupModel
+	UpdateGroup(ctx context.Context, id int, group *UpdateGroupModel) (*Group, *Response, error)
+}
+
+// LocationsAPI is the interface of LocationService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type LocationsAPI interface {
+	// CreateLocation will create and return a new Location based on CreateLocationModel
+	CreateLocation(ctx context.Context, newLocation *CreateLocationModel) (*Location, *Response, error)
+
+	// DeleteLocation will completely remove a Location from FreshService matching id
+	DeleteLocation(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetLocation will return a Location by id
+	GetLocation(ctx context.Context, id int) (*Location, *Response, error)
+
+	// IterLocations will call fn for every Location matching ListLocationsOptions, following pagination until fn returns false or a limit is reached
+	IterLocations(ctx context.Context, opt *ListLocationsOptions, limit *PaginationOptions, fn func(Location) bool) error
+
+	// ListAllLocations will return every Location matching ListLocationsOptions by following pagination
+	ListAllLocations(ctx context.Context, opt *ListLocationsOptions, limit *PaginationOptions) ([]Location, error)
+
+	// ListLocations will return paginated/filtered Locations using ListLocationsOptions
+	ListLocations(ctx context.Context, opt *ListLocationsOptions) (*Locations, *Response, error)
+
+	// UpdateLocation will update and return a Location matching id based UpdateLocationModel
+	UpdateLocation(ctx context.Context, id int, location *UpdateLocationModel) (*Location, *Response, error)
+}
+
+// ProblemsAPI is the interface of ProblemService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type ProblemsAPI interface {
+	// CreateProblem will create and return a new Problem based on CreateProblemModel
+	CreateProblem(ctx context.Context, problem *CreateProblemModel) (*Problem, *Response, error)
+
+	// CreateProblemNote will create and return a new Note based on UpsertNoteModel
+	CreateProblemNote(ctx context.Context, problemId int, note *UpsertNoteModel) (*Note, *Response, error)
+
+	// CreateTask will create and return a new Task based on CreateTaskModel
+	CreateTask(ctx context.Context, problemId int, newTask *CreateTaskModel) (*Task, *Response, error)
+
+	// CreateTimeEntry will create and return a new TimeEntry for the corresponding Problem by problemId based on CreateTimeEntryModel
+	CreateTimeEntry(ctx context.Context, problemId int, timeEntry *CreateTimeEntryModel) (*TimeEntry, *Response, error)
+
+	// DeleteProblem will delete a Problem matching the id (non-permanent delete)
+	DeleteProblem(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteProblemNote will completely remove a Note from a Problem
+	DeleteProblemNote(ctx context.Context, problemId int, problemNoteId int) (bool, *Response, error)
+
+	// DeleteTask deletes the Task on a Problem with the given ID
+	DeleteTask(ctx context.Context, problemId int, taskId int) (bool, *Response, error)
+
+	// DeleteTimeEntry will completely remove a TimeEntry from a Problem
+	DeleteTimeEntry(ctx context.Context, problemId int, timeEntryId int) (bool, *Response, error)
+
+	// GetProblem will return a single Problem by id
+	GetProblem(ctx context.Context, id int) (*Problem, *Response, error)
+
+	// GetProblemNote will return a single Note by id
+	GetProblemNote(ctx context.Context, problemId int, problemNoteId int) (*Note, *Response, error)
+
+	// GetTask will return a single Task from a Problem by the id
+	GetTask(ctx context.Context, problemId int, taskId int) (*Task, *Response, error)
+
+	// GetTimeEntry will return a single TimeEntry for the specified Problem
+	GetTimeEntry(ctx context.Context, problemId int, timeEntryId int) (*TimeEntry, *Response, error)
+
+	// IterProblemTasks will call fn for every Task matching ListTasksOptions, following pagination until fn returns false or a limit is reached
+	IterProblemTasks(ctx context.Context, problemId int, opt *ListTasksOptions, limit *PaginationOptions, fn func(Task) bool) error
+
+	// IterProblems will call fn for every Problem matching ListProblemsOptions, following pagination until fn returns false or a limit is reached
+	IterProblems(ctx context.Context, opt *ListProblemsOptions, limit *PaginationOptions, fn func(Problem) bool) error
+
+	// ListAllProblemTasks will return every Task matching ListTasksOptions by following pagination
+	ListAllProblemTasks(ctx context.Context, problemId int, opt *ListTasksOptions, limit *PaginationOptions) ([]Task, error)
+
+	// ListAllProblems will return every Problem matching ListProblemsOptions by following pagination
+	ListAllProblems(ctx context.Context, opt *ListProblemsOptions, limit *PaginationOptions) ([]Problem, error)
+
+	// ListProblemFormFields will return the FormFields of the Problem form
+	ListProblemFormFields(ctx context.Context) (*FormFields, *Response, error)
+
+	// ListProblemNotes will return  Notes for a specific Problem
+	ListProblemNotes(ctx context.Context, problemId int) (*Notes, *Response, error)
+
+	// ListProblems will return paginated/filtered Problems using ListProblemsOptions
+	ListProblems(ctx context.Context, opt *ListProblemsOptions) (*Problems, *Response, error)
+
+	// ListTasks will return paginated/filtered Tasks using ListTasksOptions
+	ListTasks(ctx context.Context, problemId int, opt *ListTasksOptions) (*Tasks, *Response, error)
+
+	// ListTimeEntries will return TimeEntries for the specified Problem
+	ListTimeEntries(ctx context.Context, problemId int) (*TimeEntries, *Response, error)
+
+	// RestoreProblem will restore a previously deleted Problem by id
+	RestoreProblem(ctx context.Context, id int) (bool, *Response, error)
+
+	// UpdateProblem will update and return a Problem matching id based on UpdateProblemModel
+	UpdateProblem(ctx context.Context, id int, problem *UpdateProblemModel) (*Problem, *Response, error)
+
+	// UpdateProblemNote will update and return a Note matching id based on UpsertNoteModel
+	UpdateProblemNote(ctx context.Context, problemId int, problemNoteId int, note *UpsertNoteModel) (*Note, *Response, error)
+
+	// UpdateTask will update and return a Task matching id based on UpdateTaskModel
+	UpdateTask(ctx context.Context, problemId int, taskId int, task *UpdateTaskModel) (*Task, *Response, error)
+}
+
+// ProductsAPI is the interface of ProductService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type ProductsAPI interface {
+	// CreateProduct will create and return a new Product based on CreateProductModel
+	CreateProduct(ctx context.Context, newProduct *CreateProductModel) (*Product, *Response, error)
+
+	// DeleteProduct will completely remove a Product from FreshService matching id
+	DeleteProduct(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetProduct will return a Product by id
+	GetProduct(ctx context.Context, id int) (*Product, *Response, error)
+
+	// IterProducts will call fn for every Product matching ListProductsOptions, following pagination until fn returns false or a limit is reached
+	IterProducts(ctx context.Context, opt *ListProductsOptions, limit *PaginationOptions, fn func(Product) bool) error
+
+	// ListAllProducts will return every Product matching ListProductsOptions by following pagination
+	ListAllProducts(ctx context.Context, opt *ListProductsOptions, limit *PaginationOptions) ([]Product, error)
+
+	// ListProducts will return paginated/filtered Products using ListProductsOptions
+	ListProducts(ctx context.Context, opt *ListProductsOptions) (*Products, *Response, error)
+
+	// UpdateProduct will update and return a Product matching id based UpdateProductModel
+	UpdateProduct(ctx context.Context, id int, product *UpdateProductModel) (*Product, *Response, error)
+}
+
+// PurchaseOrdersAPI is the interface of PurchaseOrderService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type PurchaseOrdersAPI interface {
+	// CreatePurchaseOrder will create and return a new PurchaseOrder based on CreatePurchaseOrderModel
+	CreatePurchaseOrder(ctx context.Context, newPurchaseOrder *CreatePurchaseOrderModel) (*PurchaseOrder, *Response, error)
+
+	// DeletePurchaseOrder will completely remove an PurchaseOrder from FreshService
+	DeletePurchaseOrder(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetPurchaseOrder will return a single PurchaseOrder by id
+	GetPurchaseOrder(ctx context.Context, id int) (*PurchaseOrder, *Response, error)
+
+	// IterPurchaseOrders will call fn for every PurchaseOrder matching ListPurchaseOrdersOptions, following pagination until fn returns false or a limit is reached
+	IterPurchaseOrders(ctx context.Context, opt *ListPurchaseOrdersOptions, limit *PaginationOptions, fn func(PurchaseOrder) bool) error
+
+	// ListAllPurchaseOrders will return every PurchaseOrder matching ListPurchaseOrdersOptions by following pagination
+	ListAllPurchaseOrders(ctx context.Context, opt *ListPurchaseOrdersOptions, limit *PaginationOptions) ([]PurchaseOrder, error)
+
+	// ListPurchaseOrders will return paginated/filtered PurchaseOrders using ListPurchaseOrdersOptions
+	ListPurchaseOrders(ctx context.Context, opt *ListPurchaseOrdersOptions) (*PurchaseOrders, *Response, error)
+
+	// UpdatePurchaseOrder will update and return an PurchaseOrder matching id based on UpdatePurchaseOrderModel
+	UpdatePurchaseOrder(ctx context.Context, id int, purchaseOrder *UpdatePurchaseOrderModel) (*PurchaseOrder, *Response, error)
+}
+
+// ReleasesAPI is the interface of ReleaseService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type ReleasesAPI interface {
+	// CreateRelease will create and return a new Release based on CreateReleaseModel
+	CreateRelease(ctx context.Context, newRelease *CreateReleaseModel) (*Release, *Response, error)
+
+	// CreateReleaseNote will create and return a new Note based on UpsertNoteModel
+	CreateReleaseNote(ctx context.Context, releaseId int, note *UpsertNoteModel) (*Note, *Response, error)
+
+	// CreateTask will create and return a new Task based on CreateTaskModel
+	CreateTask(ctx context.Context, releaseId int, newTask *CreateTaskModel) (*Task, *Response, error)
+
+	// CreateTimeEntry will create and return a new TimeEntry for the corresponding Release by releaseId based on CreateTimeEntryModel
+	CreateTimeEntry(ctx context.Context, releaseId int, timeEntry *CreateTimeEntryModel) (*TimeEntry, *Response, error)
+
+	// DeleteRelease will delete a Release from FreshService (Can be restored by RestoreRelease)
+	DeleteRelease(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteReleaseNote will completely remove a Note from a Release
+	DeleteReleaseNote(ctx context.Context, releaseId int, releaseNoteId int) (bool, *Response, error)
+
+	// DeleteTask deletes the Task on a Release with the given ID
+	DeleteTask(ctx context.Context, releaseId int, taskId int) (bool, *Response, error)
+
+	// DeleteTimeEntry will completely remove a TimeEntry from a Release
+	DeleteTimeEntry(ctx context.Context, releaseId int, timeEntryId int) (bool, *Response, error)
+
+	// GetRelease will return a single Release by id
+	GetRelease(ctx context.Context, id int) (*Release, *Response, error)
+
+	// GetReleaseNote will return a single Note by id
+	GetReleaseNote(ctx context.Context, releaseId int, releaseNoteId int) (*Note, *Response, error)
+
+	// GetTask will return a single Task from a Release by the id
+	GetTask(ctx context.Context, releaseId int, taskId int) (*Task, *Response, error)
+
+	// GetTimeEntry will return a single TimeEntry for the specified Release
+	GetTimeEntry(ctx context.Context, releaseId int, timeEntryId int) (*TimeEntry, *Response, error)
+
+	// IterReleaseTasks will call fn for every Task matching ListTasksOptions, following pagination until fn returns false or a limit is reached
+	IterReleaseTasks(ctx context.Context, releaseId int, opt *ListTasksOptions, limit *PaginationOptions, fn func(Task) bool) error
+
+	// IterReleases will call fn for every Release matching ListReleasesOptions, following pagination until fn returns false or a limit is reached
+	IterReleases(ctx context.Context, opt *ListReleasesOptions, limit *PaginationOptions, fn func(Release) bool) error
+
+	// ListAllReleaseTasks will return every Task matching ListTasksOptions by following pagination
+	ListAllReleaseTasks(ctx context.Context, releaseId int, opt *ListTasksOptions, limit *PaginationOptions) ([]Task, error)
+
+	// ListAllReleases will return every Release matching ListReleasesOptions by following pagination
+	ListAllReleases(ctx context.Context, opt *ListReleasesOptions, limit *PaginationOptions) ([]Release, error)
+
+	// ListReleaseFormFields will return the FormFields of the Release form
+	ListReleaseFormFields(ctx context.Context) (*FormFields, *Response, error)
+
+	// ListReleaseNotes will return  Notes for a specific Release
+	ListReleaseNotes(ctx context.Context, releaseId int) (*Notes, *Response, error)
+
+	// ListReleases will return paginated/filtered Release using ListReleasesOptions
+	ListReleases(ctx context.Context, opt *ListReleasesOptions) (*Releases, *Response, error)
+
+	// ListTasks will return paginated/filtered Tasks using ListTasksOptions
+	ListTasks(ctx context.Context, releaseId int, opt *ListTasksOptions) (*Tasks, *Response, error)
+
+	// ListTimeEntries will return TimeEntries for the specified Release
+	ListTimeEntries(ctx context.Context, releaseId int) (*TimeEntries, *Response, error)
+
+	// RestoreRelease will restore a previously trashed (deleted) Release
+	RestoreRelease(ctx context.Context, id int) (bool, *Response, error)
+
+	// UpdateRelease will update and return a Release matching id based on UpdateReleaseModel
+	UpdateRelease(ctx context.Context, id int, ticket *UpdateReleaseModel) (*Release, *Response, error)
+
+	// UpdateReleaseNote will update and return a Note matching id based on UpsertNoteModel
+	UpdateReleaseNote(ctx context.Context, releaseId int, releaseNoteId int, note *UpsertNoteModel) (*Note, *Response, error)
+
+	// UpdateTask will update and return a Task matching id based on UpdateTaskModel
+	UpdateTask(ctx context.Context, releaseId int, taskId int, task *UpdateTaskModel) (*Task, *Response, error)
+}
+
+// RequestersAPI is the interface of RequesterService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type RequestersAPI interface {
+	// CreateRequester will create and return a new Requester based on CreateRequesterModel
+	CreateRequester(ctx context.Context, newRequester *CreateRequesterModel) (*Requester, *Response, error)
+
+	// DeactivateRequester will deactivate the Requester matching the id
+	DeactivateRequester(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteRequester will completely remove a Requester from FreshService matching id (along with their requested Tickets)
+	DeleteRequester(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetRequester will return a single Requester by id
+	GetRequester(ctx context.Context, id int) (*Requester, *Response, error)
+
+	// IterRequesters will call fn for every Requester matching ListRequestersOptions, following pagination until fn returns false or a limit is reached
+	IterRequesters(ctx context.Context, opt *ListRequestersOptions, limit *PaginationOptions, fn func(Requester) bool) error
+
+	// ListAllRequesters will return every Requester matching ListRequestersOptions by following pagination
+	ListAllRequesters(ctx context.Context, opt *ListRequestersOptions, limit *PaginationOptions) ([]Requester, error)
+
+	// ListRequesterFields will return the FormFields of Requesters
+	ListRequesterFields(ctx context.Context) (*FormFields, *Response, error)
+
+	// ListRequesters will return paginated/filtered Requesters using ListRequestersOptions
+	ListRequesters(ctx context.Context, opt *ListRequestersOptions) (*Requesters, *Response, error)
+
+	// ReactivateRequester will reactivate a deactivated Requester matching the id
+	ReactivateRequester(ctx context.Context, id int) (*Requester, *Response, error)
+
+	// UpdateRequester will update and return an Requester matching id based on UpdateRequesterModel
+	UpdateRequester(ctx context.Context, id int, requester *UpdateRequesterModel) (*Requester, *Response, error)
+}
+
+// RequesterGroupsAPI is the interface of RequesterGroupService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type RequesterGroupsAPI interface {
+	// AddRequesterGroupMember will add the Requester matching requesterId to the (manual) RequesterGroup matching id
+	AddRequesterGroupMember(ctx context.Context, id int, requesterId int) (bool, *Response, error)
+
+	// CreateRequesterGroup will create and return a new RequesterGroup based on CreateRequesterGroupModel
+	CreateRequesterGroup(ctx context.Context, newGroup *CreateRequesterGroupModel) (*RequesterGroup, *Response, error)
+
+	// DeleteRequesterGroup will completely remove a RequesterGroup from FreshService matching id
+	DeleteRequesterGroup(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetRequesterGroup will return a single RequesterGroup by id
+	GetRequesterGroup(ctx context.Context, id int) (*RequesterGroup, *Response, error)
+
+	// IterRequesterGroupMembers will call fn for every member of the RequesterGroup matching id, following pagination until fn returns false or a limit is reached
+	IterRequesterGroupMembers(ctx context.Context, id int, opt *ListRequesterGroupMembersOptions, limit *PaginationOptions, fn func(Requester) bool) error
+
+	// IterRequesterGroups will call fn for every RequesterGroup, following pagination until fn returns false or a limit is reached
+	IterRequesterGroups(ctx context.Context, opt *ListRequesterGroupsOptions, limit *PaginationOptions, fn func(RequesterGroup) bool) error
+
+	// ListAllRequesterGroupMembers will return every member of the RequesterGroup matching id by following pagination
+	ListAllRequesterGroupMembers(ctx context.Context, id int, opt *ListRequesterGroupMembersOptions, limit *PaginationOptions) ([]Requester, error)
+
+	// ListAllRequesterGroups will return every RequesterGroup by following pagination
+	ListAllRequesterGroups(ctx context.Context, opt *ListRequesterGroupsOptions, limit *PaginationOptions) ([]RequesterGroup, error)
+
+	// ListRequesterGroupMembers will return the paginated Requesters which are members of the RequesterGroup matching id
+	ListRequesterGroupMembers(ctx context.Context, id int, opt *ListRequesterGroupMembersOptions) (*Requesters, *Response, error)
+
+	// ListRequesterGroups will return paginated RequesterGroups using ListRequesterGroupsOptions
+	ListRequesterGroups(ctx context.Context, opt *ListRequesterGroupsOptions) (*RequesterGroups, *Response, error)
+
+	// RemoveRequesterGroupMember will remove the Requester matching requesterId from the (manual) RequesterGroup matching id
+	RemoveRequesterGroupMember(ctx context.Context, id int, requesterId int) (bool, *Response, error)
+
+	// UpdateRequesterGroup will update and return a RequesterGroup matching id based on UpdateRequesterGroupModel
+	UpdateRequesterGroup(ctx context.Context, id int, group *UpdateRequesterGroupModel) (*RequesterGroup, *Response, error)
+}
+
+// ServicesAPI is the interface of ServiceCatalogService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type ServicesAPI interface {
+	// GetServiceItem will return a ServiceItem by displayId
+	GetServiceItem(ctx context.Context, displayId int) (*ServiceItem, *Response, error)
+
+	// ListServiceItems will return ServiceItems
+	ListServiceItems(ctx context.Context) (*ServiceItems, *Response, error)
+
+	// SearchServiceItems will return paginated/filtered ServiceItems based on ServiceItemSearch
+	SearchServiceItems(ctx context.Context, search *ServiceItemSearch) (*ServiceItems, *Response, error)
+}
+
+// ServiceLevelAgreementsAPI is the interface of SLAPoliciesService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type ServiceLevelAgreementsAPI interface {
+	// ListPolicies will return SLA Policies
+	ListPolicies(ctx context.Context) (*Policies, *Response, error)
+}
+
+// SoftwareAPI is the interface of SoftwareService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type SoftwareAPI interface {
+	// AddInstallation allows for adding a Device to an Application as a SoftwareInstallation
+	AddInstallation(ctx context.Context, applicationId int, installation *CreateInstallationModel) (*SoftwareInstallation, *Response, error)
+
+	// BulkAddUsers allows for adding many SoftwareUser records to an Application as a bulk operation, returns SoftwareUsers
+	BulkAddUsers(ctx context.Context, applicationId int, userBindings *SoftwareUserBindings) (*SoftwareUsers, *Response, error)
+
+	// BulkUpdateUsers allows for updating many SoftwareUser records of an Application as a bulk operation, returns SoftwareUsers
+	BulkUpdateUsers(ctx context.Context, applicationId int, userBindings *SoftwareUserBindings) (*SoftwareUsers, *Response, error)
+
+	// CreateApplication will create and return a new Application based on CreateApplicationModel
+	CreateApplication(ctx context.Context, newApplication *CreateApplicationModel) (*Application, *Response, error)
+
+	// DeleteApplication will completely remove an Application from FreshService matching id
+	DeleteApplication(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteInstallations allows for bulk removal of Devices from Application
+	DeleteInstallations(ctx context.Context, applicationId int, deviceIds []string) (bool, *Response, error)
+
+	// DeleteUsers allows for bulk removal of Users (Requesters or Agent)
+	DeleteUsers(ctx context.Context, applicationId int, userIds []string) (bool, *Response, error)
+
+	// GetApplication will return an Application by id
+	GetApplication(ctx context.Context, id int) (*Application, *Response, error)
+
+	// GetSoftwareUser will return an SoftwareUser by id
+	GetSoftwareUser(ctx context.Context, applicationId int, id int) (*SoftwareUser, *Response, error)
+
+	// IterApplications will call fn for every Application matching ListApplicationsOptions, following pagination until fn returns false or a limit is reached
+	IterApplications(ctx context.Context, opt *ListApplicationsOptions, limit *PaginationOptions, fn func(Application) bool) error
+
+	// IterSoftwareUsers will call fn for every SoftwareUser matching ListSoftwareUsersOptions, following pagination until fn returns false or a limit is reached
+	IterSoftwareUsers(ctx context.Context, applicationId int, opt *ListSoftwareUsersOptions, limit *PaginationOptions, fn func(SoftwareUser) bool) error
+
+	// ListAllApplications will return every Application matching ListApplicationsOptions by following pagination
+	ListAllApplications(ctx context.Context, opt *ListApplicationsOptions, limit *PaginationOptions) ([]Application, error)
+
+	// ListAllSoftwareUsers will return every SoftwareUser matching ListSoftwareUsersOptions by following pagination
+	ListAllSoftwareUsers(ctx context.Context, applicationId int, opt *ListSoftwareUsersOptions, limit *PaginationOptions) ([]SoftwareUser, error)
+
+	// ListApplications will return paginated/filtered Applications using ListApplicationsOptions
+	ListApplications(ctx context.Context, opt *ListApplicationsOptions) (*Applications, *Response, error)
+
+	// ListInstallations will return SoftwareInstallations for a specific Application
+	ListInstallations(ctx context.Context, applicationId int) (*SoftwareInstallations, *Response, error)
+
+	// ListSoftwareUsers will return paginated/filtered SoftwareUsers using ListSoftwareUsersOptions
+	ListSoftwareUsers(ctx context.Context, applicationId int, opt *ListSoftwareUsersOptions) (*SoftwareUsers, *Response, error)
+
+	// UpdateApplication will update and return a Application matching id based UpdateApplicationModel
+	UpdateApplication(ctx context.Context, id int, application *UpdateApplicationModel) (*Application, *Response, error)
+}
+
+// SolutionsAPI is the interface of SolutionService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type SolutionsAPI interface {
+	// CreateSolutionArticle will create and return a new SolutionArticle based on CreateSolutionArticleModel
+	CreateSolutionArticle(ctx context.Context, solutionArticle *CreateSolutionArticleModel) (*SolutionArticle, *Response, error)
+
+	// CreateSolutionCategory will create and return a new SolutionCategory based on CreateSolutionCategoryModel
+	CreateSolutionCategory(ctx context.Context, solutionCategory *CreateSolutionCategoryModel) (*SolutionCategory, *Response, error)
+
+	// CreateSolutionFolder will create and return a new SolutionFolder based on CreateSolutionFolderModel
+	CreateSolutionFolder(ctx context.Context, solutionFolder *CreateSolutionFolderModel) (*SolutionFolder, *Response, error)
+
+	// DeleteSolutionArticle will completely remove a SolutionArticle from FreshService matching id
+	DeleteSolutionArticle(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteSolutionCategory will completely remove a SolutionCategory from FreshService matching id
+	DeleteSolutionCategory(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteSolutionFolder will completely remove a SolutionFolder from FreshService matching id
+	DeleteSolutionFolder(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetSolutionArticle will return a SolutionArticle by id
+	GetSolutionArticle(ctx context.Context, id int) (*SolutionArticle, *Response, error)
+
+	// GetSolutionCategory will return a single SolutionCategory by id
+	GetSolutionCategory(ctx context.Context, id int) (*SolutionCategory, *Response, error)
+
+	// GetSolutionFolder will return a SolutionFolder by id
+	GetSolutionFolder(ctx context.Context, id int) (*SolutionFolder, *Response, error)
+
+	// IterSolutionArticles will call fn for every SolutionArticle matching ListSolutionArticlesOptions, following pagination until fn returns false or a limit is reached
+	IterSolutionArticles(ctx context.Context, opt *ListSolutionArticlesOptions, limit *PaginationOptions, fn func(SolutionArticle) bool) error
+
+	// IterSolutionCategories will call fn for every SolutionCategory matching ListSolutionCategoriesOptions, following pagination until fn returns false or a limit is reached
+	IterSolutionCategories(ctx context.Context, opt *ListSolutionCategoriesOptions, limit *PaginationOptions, fn func(SolutionCategory) bool) error
+
+	// IterSolutionFolders will call fn for every SolutionFolder matching ListSolutionFoldersOptions, following pagination until fn returns false or a limit is reached
+	IterSolutionFolders(ctx context.Context, opt *ListSolutionFoldersOptions, limit *PaginationOptions, fn func(SolutionFolder) bool) error
+
+	// ListAllSolutionArticles will return every SolutionArticle matching ListSolutionArticlesOptions by following pagination
+	ListAllSolutionArticles(ctx context.Context, opt *ListSolutionArticlesOptions, limit *PaginationOptions) ([]SolutionArticle, error)
+
+	// ListAllSolutionCategories will return every SolutionCategory matching ListSolutionCategoriesOptions by following pagination
+	ListAllSolutionCategories(ctx context.Context, opt *ListSolutionCategoriesOptions, limit *PaginationOptions) ([]SolutionCategory, error)
+
+	// ListAllSolutionFolders will return every SolutionFolder matching ListSolutionFoldersOptions by following pagination
+	ListAllSolutionFolders(ctx context.Context, opt *ListSolutionFoldersOptions, limit *PaginationOptions) ([]SolutionFolder, error)
+
+	// ListSolutionArticles will return paginated/filtered SolutionArticles using ListSolutionArticlesOptions
+	ListSolutionArticles(ctx context.Context, opt *ListSolutionArticlesOptions) (*SolutionArticles, *Response, error)
+
+	// ListSolutionCategories will return paginated/filtered SolutionCategories using ListSolutionCategoriesOptions
+	ListSolutionCategories(ctx context.Context, opt *ListSolutionCategoriesOptions) (*SolutionCategories, *Response, error)
+
+	// ListSolutionFolders will return paginated/filtered SolutionFolders using ListSolutionFoldersOptions
+	ListSolutionFolders(ctx context.Context, opt *ListSolutionFoldersOptions) (*SolutionFolders, *Response, error)
+
+	// SendSolutionArticleForApproval sends the SolutionArticle matching id for approval
+	SendSolutionArticleForApproval(ctx context.Context, id int) (*SolutionArticle, *Response, error)
+
+	// UpdateSolutionArticle will update and return a SolutionArticle matching id based UpdateSolutionArticleModel
+	UpdateSolutionArticle(ctx context.Context, id int, solutionArticle *UpdateSolutionArticleModel) (*SolutionArticle, *Response, error)
+
+	// UpdateSolutionCategory will update and return a SolutionCategory matching id based on UpdateSolutionCategoryModel
+	UpdateSolutionCategory(ctx context.Context, id int, solutionCategory *UpdateSolutionCategoryModel) (*SolutionCategory, *Response, error)
+
+	// UpdateSolutionFolder will update and return a SolutionFolder matching id based UpdateSolutionFolderModel
+	UpdateSolutionFolder(ctx context.Context, id int, solutionFolder *UpdateSolutionFolderModel) (*SolutionFolder, *Response, error)
+}
+
+// TicketsAPI is the interface of TicketService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type TicketsAPI interface {
+	// CreateNote will add a Note to a Ticket and return the new Conversation
+	CreateNote(ctx context.Context, ticketId int, note *CreateConversationNoteModel) (*Conversation, *Response, error)
+
+	// CreateNoteWithAttachments will add a Note to a Ticket, uploading files as attachments (see CreateTicketWithAttachments)
+	CreateNoteWithAttachments(ctx context.Context, ticketId int, note *CreateConversationNoteModel, files ...io.Reader) (*Conversation, *Response, error)
+
+	// CreateTask will create and return a new Task based on CreateTaskModel
+	CreateTask(ctx context.Context, ticketId int, newTask *CreateTaskModel) (*Task, *Response, error)
+
+	// CreateTicket will create and return a new Ticket based on CreateTicketModel
+	CreateTicket(ctx context.Context, newTicket *CreateTicketModel) (*Ticket, *Response, error)
+
+	// CreateTicketWithAttachments will create and return a new Ticket based on CreateTicketModel, uploading files as attachments.
+	// Use NamedReader to set the file name of readers without a Name method, the combined size is limited to 15 MB.
+	CreateTicketWithAttachments(ctx context.Context, newTicket *CreateTicketModel, files ...io.Reader) (*Ticket, *Response, error)
+
+	// CreateTimeEntry will create and return a new TimeEntry for the corresponding Ticket by ticketId based on CreateTimeEntryModel
+	CreateTimeEntry(ctx context.Context, ticketId int, timeEntry *CreateTimeEntryModel) (*TimeEntry, *Response, error)
+
+	// DeleteAttachment will remove a TicketAttachment from a Ticket
+	DeleteAttachment(ctx context.Context, ticketId int, attachmentId int) (bool, *Response, error)
+
+	// DeleteConversation will completely remove a Conversation (reply or note) from a Ticket
+	DeleteConversation(ctx context.Context, conversationId int) (bool, *Response, error)
+
+	// DeleteTask deletes the Task on a Ticket with the given ID
+	DeleteTask(ctx context.Context, ticketId int, taskId int) (bool, *Response, error)
+
+	// DeleteTicket will trash a Ticket from FreshService (Can be restored by RestoreTicket)
+	DeleteTicket(ctx context.Context, id int) (bool, *Response, error)
+
+	// DeleteTimeEntry will completely remove a TimeEntry from a Ticket
+	DeleteTimeEntry(ctx context.Context, ticketId int, timeEntryId int) (bool, *Response, error)
+
+	// DownloadAttachment streams a TicketAttachment (of a Ticket or Conversation) to w, verifying the size and content type
+	// against the attachment. progress is optional and is called as the attachment is written.
+	DownloadAttachment(ctx context.Context, attachment *TicketAttachment, w io.Writer, progress ProgressFunc) (*Response, error)
+
+	// FilterTickets will return a page of Tickets matching the Query, the total number of matches is set on Response.TotalEntries
+	FilterTickets(ctx context.Context, q Query, opt *FilterTicketsOptions) (*Tickets, *Response, error)
+
+	// GetAudit returns TicketActivities for a specific Ticket
+	GetAudit(ctx context.Context, ticketId int) (*TicketActivities, *Response, error)
+
+	// GetTask will return a single Task from a Ticket by the id
+	GetTask(ctx context.Context, ticketId int, taskId int) (*Task, *Response, error)
+
+	// GetTicket will return a single Ticket by id
+	GetTicket(ctx context.Context, id int) (*Ticket, *Response, error)
+
+	// GetTimeEntry will return a single TimeEntry for the specified Ticket
+	GetTimeEntry(ctx context.Context, ticketId int, timeEntryId int) (*TimeEntry, *Response, error)
+
+	// IterConversations will call fn for every Conversation matching ListConversationsOptions, following pagination until fn returns false or a limit is reached
+	IterConversations(ctx context.Context, ticketId int, opt *ListConversationsOptions, limit *PaginationOptions, fn func(Conversation) bool) error
+
+	// IterFilterTickets will call fn for every Ticket matching the Query, until fn returns false, a limit is reached or the
+	// last page (10) of the filter endpoint has been read. PaginationOptions.PerPage is ignored as the page size is fixed.
+	IterFilterTickets(ctx context.Context, q Query, limit *PaginationOptions, fn func(Ticket) bool) error
+
+	// IterTicketTasks will call fn for every Task matching ListTasksOptions, following pagination until fn returns false or a limit is reached
+	IterTicketTasks(ctx context.Context, ticketId int, opt *ListTasksOptions, limit *PaginationOptions, fn func(Task) bool) error
+
+	// IterTickets will call fn for every Ticket matching ListTicketsOptions, following pagination until fn returns false or a limit is reached
+	IterTickets(ctx context.Context, opt *ListTicketsOptions, limit *PaginationOptions, fn func(Ticket) bool) error
+
+	// ListAllConversations will return every Conversation matching ListConversationsOptions by following pagination
+	ListAllConversations(ctx context.Context, ticketId int, opt *ListConversationsOptions, limit *PaginationOptions) ([]Conversation, error)
+
+	// ListAllFilterTickets will return every Ticket matching the Query, up to the 300 results the filter endpoint allows
+	ListAllFilterTickets(ctx context.Context, q Query, limit *PaginationOptions) ([]Ticket, error)
+
+	// ListAllTicketTasks will return every Task matching ListTasksOptions by following pagination
+	ListAllTicketTasks(ctx context.Context, ticketId int, opt *ListTasksOptions, limit *PaginationOptions) ([]Task, error)
+
+	// ListAllTickets will return every Ticket matching ListTicketsOptions by following pagination
+	ListAllTickets(ctx context.Context, opt *ListTicketsOptions, limit *PaginationOptions) ([]Ticket, error)
+
+	// ListConversations will return paginated/filtered Conversation using ListConversationsOptions
+	ListConversations(ctx context.Context, ticketId int, opt *ListConversationsOptions) (*Conversations, *Response, error)
+
+	// ListTasks will return paginated/filtered Tasks using ListTasksOptions
+	ListTasks(ctx context.Context, ticketId int, opt *ListTasksOptions) (*Tasks, *Response, error)
+
+	// ListTicketFormFields will return the FormFields of the Ticket form
+	ListTicketFormFields(ctx context.Context) (*FormFields, *Response, error)
+
+	// ListTickets will return paginated/filtered Ticket using ListTicketsOptions
+	ListTickets(ctx context.Context, opt *ListTicketsOptions) (*Tickets, *Response, error)
+
+	// ListTimeEntries will return TimeEntries for the specified Ticket
+	ListTimeEntries(ctx context.Context, ticketId int) (*TimeEntries, *Response, error)
+
+	// Reply will reply to the requester of a Ticket and return the new Conversation
+	Reply(ctx context.Context, ticketId int, reply *CreateReplyModel) (*Conversation, *Response, error)
+
+	// ReplyWithAttachments will reply to the requester of a Ticket, uploading files as attachments (see CreateTicketWithAttachments)
+	ReplyWithAttachments(ctx context.Context, ticketId int, reply *CreateReplyModel, files ...io.Reader) (*Conversation, *Response, error)
+
+	// RestoreTicket will restore a previously trashed (deleted) Ticket
+	RestoreTicket(ctx context.Context, id int) (bool, *Response, error)
+
+	// UpdateConversation will update and return a Conversation (reply or note) matching id based on UpdateConversationModel
+	UpdateConversation(ctx context.Context, conversationId int, conversation *UpdateConversationModel) (*Conversation, *Response, error)
+
+	// UpdateConversationWithAttachments will update a Conversation, uploading files as additional attachments (see CreateTicketWithAttachments)
+	UpdateConversationWithAttachments(ctx context.Context, conversationId int, conversation *UpdateConversationModel, files ...io.Reader) (*Conversation, *Response, error)
+
+	// UpdateTask will update and return a Task matching id based on UpdateTaskModel
+	UpdateTask(ctx context.Context, ticketId int, taskId int, task *UpdateTaskModel) (*Task, *Response, error)
+
+	// UpdateTicket will update and return a Ticket matching id based on UpdateTicketModel
+	UpdateTicket(ctx context.Context, id int, ticket *UpdateTicketModel) (*Ticket, *Response, error)
+}
+
+// VendorsAPI is the interface of VendorService, allowing it to be replaced (e.g. by freshservicemock) in tests
+type VendorsAPI interface {
+	// CreateVendor will create and return a new Vendor based on CreateVendorModel
+	CreateVendor(ctx context.Context, newVendor *CreateVendorModel) (*Vendor, *Response, error)
+
+	// DeleteVendor will completely remove a Vendor from FreshService matching id
+	DeleteVendor(ctx context.Context, id int) (bool, *Response, error)
+
+	// GetVendor will return a single Vendor by id
+	GetVendor(ctx context.Context, id int) (*Vendor, *Response, error)
+
+	// IterVendors will call fn for every Vendor matching ListVendorsOptions, following pagination until fn returns false or a limit is reached
+	IterVendors(ctx context.Context, opt *ListVendorsOptions, limit *PaginationOptions, fn func(Vendor) bool) error
+
+	// ListAllVendors will return every Vendor matching ListVendorsOptions by following pagination
+	ListAllVendors(ctx context.Context, opt *ListVendorsOptions, limit *PaginationOptions) ([]Vendor, error)
+
+	// ListVendors will return paginated/filtered Vendors using ListVendorsOptions
+	ListVendors(ctx context.Context, opt *ListVendorsOptions) (*Vendors, *Response, error)
+
+	// UpdateVendor will update and return a Vendor matching id based on UpdateVendorModel
+	UpdateVendor(ctx context.Context, id int, vendor *UpdateVendorModel) (*Vendor, *Response, error)
+}
+
+var (
+	_ AgentsAPI                 = (*AgentService)(nil)
+	_ AnnouncementsAPI          = (*AnnouncementService)(nil)
+	_ AssetsAPI                 = (*AssetService)(nil)
+	_ BusinessHoursAPI          = (*BusinessHoursService)(nil)
+	_ ChangesAPI                = (*ChangeService)(nil)
+	_ ContractsAPI              = (*ContractService)(nil)
+	_ DepartmentsAPI            = (*DepartmentService)(nil)
+	_ GroupsAPI                 = (*GroupService)(nil)
+	_ LocationsAPI              = (*LocationService)(nil)
+	_ ProblemsAPI               = (*ProblemService)(nil)
+	_ ProductsAPI               = (*ProductService)(nil)
+	_ PurchaseOrdersAPI         = (*PurchaseOrderService)(nil)
+	_ ReleasesAPI               = (*ReleaseService)(nil)
+	_ RequestersAPI             = (*RequesterService)(nil)
+	_ RequesterGroupsAPI        = (*RequesterGroupService)(nil)
+	_ ServicesAPI               = (*ServiceCatalogService)(nil)
+	_ ServiceLevelAgreementsAPI = (*SLAPoliciesService)(nil)
+	_ SoftwareAPI               = (*SoftwareService)(nil)
+	_ SolutionsAPI              = (*SolutionService)(nil)
+	_ TicketsAPI                = (*TicketService)(nil)
+	_ VendorsAPI                = (*VendorService)(nil)
+)
+
+// API holds the services of a Client as interfaces, code depending on API rather than Client can be tested by
+// assembling it from mocks (see freshservicemock)
+type API struct {
+	Agents                 AgentsAPI
+	Announcements          AnnouncementsAPI
+	Assets                 AssetsAPI
+	BusinessHours          BusinessHoursAPI
+	Changes                ChangesAPI
+	Contracts              ContractsAPI
+	Departments            DepartmentsAPI
+	Groups                 GroupsAPI
+	Locations              LocationsAPI
+	Problems               ProblemsAPI
+	Products               ProductsAPI
+	PurchaseOrders         PurchaseOrdersAPI
+	Releases               ReleasesAPI
+	Requesters             RequestersAPI
+	RequesterGroups        RequesterGroupsAPI
+	Services               ServicesAPI
+	ServiceLevelAgreements ServiceLevelAgreementsAPI
+	Software               SoftwareAPI
+	Solutions              SolutionsAPI
+	Tickets                TicketsAPI
+	Vendors                VendorsAPI
+}
+
+// API returns the services of the Client as an API
+func (c *Client) API() *API {
+	return &API{
+		Agents:                 c.Agents,
+		Announcements:          c.Announcements,
+		Assets:                 c.Assets,
+		BusinessHours:          c.BusinessHours,
+		Changes:                c.Changes,
+		Contracts:              c.Contracts,
+		Departments:            c.Departments,
+		Groups:                 c.Groups,
+		Locations:              c.Locations,
+		Problems:               c.Problems,
+		Products:               c.Products,
+		PurchaseOrders:         c.PurchaseOrders,
+		Releases:               c.Releases,
+		Requesters:             c.Requesters,
+		RequesterGroups:        c.RequesterGroups,
+		Services:               c.Services,
+		ServiceLevelAgreements: c.ServiceLevelAgreements,
+		Software:               c.Software,
+		Solutions:              c.Solutions,
+		Tickets:                c.Tickets,
+		Vendors:                c.Vendors,
+	}
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -34,106 +33,158 @@ type assetWrapper struct {
 
 // Asset represents a FreshService Asset
 type Asset struct {
-	ID           int       `json:"id"`
-	DisplayID    int       `json:"display_id"`
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	AssetTypeID  int       `json:"asset_type_id"`
-	AssetTag     string    `json:"asset_tag"`
-	Impact       string    `json:"impact"`
-	AuthorType   string    `json:"author_type"`
-	UsageType    string    `json:"usage_type"`
-	UserID       int       `json:"user_id"`
-	LocationID   int       `json:"location_id"`
-	DepartmentID int       `json:"department_id"`
-	AgentID      int       `json:"agent_id"`
-	GroupID      int       `json:"group_id"`
-	AssignedOn   time.Time `json:"assigned_on"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           int          `json:"id"`
+	DisplayID    int          `json:"display_id"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	AssetTypeID  int          `json:"asset_type_id"`
+	AssetTag     string       `json:"asset_tag"`
+	Impact       string       `json:"impact"`
+	AuthorType   string       `json:"author_type"`
+	UsageType    string       `json:"usage_type"`
+	UserID       int          `json:"user_id"`
+	LocationID   int          `json:"location_id"`
+	DepartmentID int          `json:"department_id"`
+	AgentID      int          `json:"agent_id"`
+	GroupID      int          `json:"group_id"`
+	AssignedOn   Time         `json:"assigned_on"`
+	CreatedAt    Time         `json:"created_at"`
+	UpdatedAt    Time         `json:"updated_at"`
+	TypeFields   CustomFields `json:"type_fields"`
 }
 
 // CreateAssetModel is the data structure required to create a new Asset
 type CreateAssetModel struct {
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	AssetTypeID  int       `json:"asset_type_id"`
-	AssetTag     string    `json:"asset_tag"`
-	Impact       string    `json:"impact"`
-	UsageType    string    `json:"usage_type"`
-	UserID       int       `json:"user_id"`
-	LocationID   int       `json:"location_id"`
-	DepartmentID int       `json:"department_id"`
-	AgentID      int       `json:"agent_id"`
-	GroupID      int       `json:"group_id"`
-	AssignedOn   time.Time `json:"assigned_on"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	AssetTypeID  int          `json:"asset_type_id"`
+	AssetTag     string       `json:"asset_tag"`
+	Impact       string       `json:"impact"`
+	UsageType    string       `json:"usage_type"`
+	UserID       int          `json:"user_id"`
+	LocationID   int          `json:"location_id"`
+	DepartmentID int          `json:"department_id"`
+	AgentID      int          `json:"agent_id"`
+	GroupID      int          `json:"group_id"`
+	AssignedOn   Time         `json:"assigned_on"`
+	TypeFields   CustomFields `json:"type_fields,omitempty"`
 }
 
 // UpdateAssetModel is the data structure required to update an Asset
 type UpdateAssetModel struct {
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	AssetTypeID  int       `json:"asset_type_id"`
-	AssetTag     string    `json:"asset_tag"`
-	Impact       string    `json:"impact"`
-	UsageType    string    `json:"usage_type"`
-	UserID       int       `json:"user_id"`
-	LocationID   int       `json:"location_id"`
-	DepartmentID int       `json:"department_id"`
-	AgentID      int       `json:"agent_id"`
-	GroupID      int       `json:"group_id"`
-	AssignedOn   time.Time `json:"assigned_on"`
-}
-
-// ListAssetsOptions represents filters/pagination for Assets
+	Name         *string      `json:"name,omitempty"`
+	Description  *string      `json:"description,omitempty"`
+	AssetTypeID  *int         `json:"asset_type_id,omitempty"`
+	AssetTag     *string      `json:"asset_tag,omitempty"`
+	Impact       *string      `json:"impact,omitempty"`
+	UsageType    *string      `json:"usage_type,omitempty"`
+	UserID       *int         `json:"user_id,omitempty"`
+	LocationID   *int         `json:"location_id,omitempty"`
+	DepartmentID *int         `json:"department_id,omitempty"`
+	AgentID      *int         `json:"agent_id,omitempty"`
+	GroupID      *int         `json:"group_id,omitempty"`
+	AssignedOn   *Time        `json:"assigned_on,omitempty"`
+	TypeFields   CustomFields `json:"type_fields,omitempty"`
+	NullFields   []string     `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateAssetModel which are set, along with the NullFields as null
+func (m UpdateAssetModel) MarshalJSON() ([]byte, error) {
+	type model UpdateAssetModel
+	return marshalUpdate(model(m), m.NullFields)
+}
+
+// AssetIncludeTypeFields is used with ListAssetsOptions.Include to return the TypeFields of each Asset
+const AssetIncludeTypeFields = "type_fields"
+
+// ListAssetsOptions represents filters/pagination for Assets, Trashed lists the trashed Assets instead
 type ListAssetsOptions struct {
 	ListOptions
+	Include string `json:"include,omitempty" url:"include,omitempty"`
+	Trashed bool   `json:"trashed,omitempty" url:"trashed,omitempty"`
 }
 
 // GetAsset will return a single Asset by displayId
-func (s *AssetService) GetAsset(displayId int) (*Asset, *http.Response, error) {
+func (s *AssetService) GetAsset(ctx context.Context, displayId int) (*Asset, *Response, error) {
 	o := new(assetWrapper)
-	res, err := s.client.Get(fmt.Sprintf(assetIdUrl, displayId), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(assetIdUrl, displayId), &o)
+	return &o.Details, res, err
+}
+
+// GetAssetWithTypeFields will return a single Asset by displayId, including its TypeFields
+func (s *AssetService) GetAssetWithTypeFields(ctx context.Context, displayId int) (*Asset, *Response, error) {
+	o := new(assetWrapper)
+	res, err := s.client.List(ctx, fmt.Sprintf(assetIdUrl, displayId), &ListAssetsOptions{Include: AssetIncludeTypeFields}, &o)
 	return &o.Details, res, err
 }
 
 // ListAssets will return paginated/filtered Assets using ListAssetsOptions
-func (s *AssetService) ListAssets(opt *ListAssetsOptions) (*Assets, *http.Response, error) {
+func (s *AssetService) ListAssets(ctx context.Context, opt *ListAssetsOptions) (*Assets, *Response, error) {
 	o := new(Assets)
-	res, err := s.client.List(assetsUrl, opt, &o)
+	res, err := s.client.List(ctx, assetsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateAsset will create and return a new Asset based on CreateAssetModel
-func (s *AssetService) CreateAsset(newAsset *CreateAssetModel) (*Asset, *http.Response, error) {
+func (s *AssetService) CreateAsset(ctx context.Context, newAsset *CreateAssetModel) (*Asset, *Response, error) {
 	o := new(assetWrapper)
-	res, err := s.client.Post(assetsUrl, newAsset, &o)
+	res, err := s.client.Post(ctx, assetsUrl, newAsset, &o)
 	return &o.Details, res, err
 }
 
 // UpdateAsset will update and return an Asset matching displayId based on UpdateAssetModel
-func (s *AssetService) UpdateAsset(displayId int, asset *UpdateAssetModel) (*Asset, *http.Response, error) {
+func (s *AssetService) UpdateAsset(ctx context.Context, displayId int, asset *UpdateAssetModel) (*Asset, *Response, error) {
 	o := new(assetWrapper)
-	res, err := s.client.Put(fmt.Sprintf(assetIdUrl, displayId), asset, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(assetIdUrl, displayId), asset, &o)
 	return &o.Details, res, err
 }
 
 // TrashAsset will trash the Asset matching the displayId (non-permanent delete)
-func (s *AssetService) TrashAsset(displayId int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(assetIdUrl, displayId))
+func (s *AssetService) TrashAsset(ctx context.Context, displayId int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(assetIdUrl, displayId))
 	return success, res, err
 }
 
 // RestoreAsset will restore a previously Trashed Asset by displayId
-func (s *AssetService) RestoreAsset(displayId int) (bool, *http.Response, error) {
-	res, err := s.client.Put(fmt.Sprintf(assetRestoreUrl, displayId), nil, nil)
-	success, _ := isSuccessful(res)
+func (s *AssetService) RestoreAsset(ctx context.Context, displayId int) (bool, *Response, error) {
+	res, err := s.client.Put(ctx, fmt.Sprintf(assetRestoreUrl, displayId), nil, nil)
+	success := err == nil
 	return success, res, err
 }
 
 // DeleteAsset irrecoverably removes an Asset from FreshService matching the displayId
-func (s *AssetService) DeleteAsset(displayId int) (bool, *http.Response, error) {
-	res, err := s.client.Put(fmt.Sprintf(assetDeleteUrl, displayId), nil, nil)
-	success, _ := isSuccessful(res)
+func (s *AssetService) DeleteAsset(ctx context.Context, displayId int) (bool, *Response, error) {
+	res, err := s.client.Put(ctx, fmt.Sprintf(assetDeleteUrl, displayId), nil, nil)
+	success := err == nil
 	return success, res, err
 }
+
+// IterAssets will call fn for every Asset matching ListAssetsOptions, following pagination until fn returns false or a limit is reached
+func (s *AssetService) IterAssets(ctx context.Context, opt *ListAssetsOptions, limit *PaginationOptions, fn func(Asset) bool) error {
+	o := ListAssetsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListAssets(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllAssets will return every Asset matching ListAssetsOptions by following pagination
+func (s *AssetService) ListAllAssets(ctx context.Context, opt *ListAssetsOptions, limit *PaginationOptions) ([]Asset, error) {
+	var all []Asset
+	err := s.IterAssets(ctx, opt, limit, func(i Asset) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 // AssetComponents contains Collection an array of AssetComponent
@@ -16,13 +15,13 @@ type AssetComponent struct {
 	ID            int         `json:"id"`
 	ComponentType string      `json:"component_type"`
 	ComponentData interface{} `json:"component_data"`
-	CreatedAt     time.Time   `json:"created_at"`
-	UpdatedAt     time.Time   `json:"updated_at"`
+	CreatedAt     Time        `json:"created_at"`
+	UpdatedAt     Time        `json:"updated_at"`
 }
 
 // ListAssetComponents will return all AssetComponents for a given Asset by displayId
-func (s *AssetService) ListAssetComponents(displayId int) (*AssetComponents, *http.Response, error) {
+func (s *AssetService) ListAssetComponents(ctx context.Context, displayId int) (*AssetComponents, *Response, error) {
 	o := new(AssetComponents)
-	res, err := s.client.List(fmt.Sprintf(assetComponentsUrl, displayId), nil, &o)
+	res, err := s.client.List(ctx, fmt.Sprintf(assetComponentsUrl, displayId), nil, &o)
 	return o, res, err
 }
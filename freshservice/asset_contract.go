@@ -1,8 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 )
 
 // AssetContracts contains Collection an array of AssetContract
@@ -20,8 +20,8 @@ type AssetContract struct {
 }
 
 // ListAssetContracts will return all AssetContracts for a given Asset by displayId
-func (s *AssetService) ListAssetContracts(displayId int) (*AssetContracts, *http.Response, error) {
+func (s *AssetService) ListAssetContracts(ctx context.Context, displayId int) (*AssetContracts, *Response, error) {
 	o := new(AssetContracts)
-	res, err := s.client.List(fmt.Sprintf(assetContractsUrl, displayId), nil, &o)
+	res, err := s.client.List(ctx, fmt.Sprintf(assetContractsUrl, displayId), nil, &o)
 	return o, res, err
 }
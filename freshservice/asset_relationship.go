@@ -0,0 +1,194 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	assetRelationshipsUrl = "assets/%d/relationships"
+	relationshipsUrl      = "relationships"
+	relationshipsBulkUrl  = "relationships/bulk-create"
+	relationshipTypesUrl  = "relationship_types"
+	jobIdUrl              = "jobs/%s"
+
+	// defaultJobPollInterval is how often WaitForRelationshipJob checks the job by default
+	defaultJobPollInterval = 2 * time.Second
+)
+
+// RelationshipEntityAsset is the PrimaryType / SecondaryType of a Relationship between Assets
+const RelationshipEntityAsset = "asset"
+
+// Relationship job statuses, a job is complete once it has succeeded, partially succeeded or failed
+const (
+	JobStatusQueued     = "queued"
+	JobStatusInProgress = "in progress"
+	JobStatusSuccess    = "success"
+	JobStatusPartial    = "partial"
+	JobStatusFailed     = "failed"
+)
+
+// Relationships contains Collection an array of Relationship
+type Relationships struct {
+	Collection []Relationship `json:"relationships"`
+}
+
+// Relationship represents a CMDB relationship between two configuration items (e.g. an Asset depending on another)
+type Relationship struct {
+	ID                 int    `json:"id"`
+	RelationshipTypeID int    `json:"relationship_type_id"`
+	PrimaryID          int    `json:"primary_id"`
+	PrimaryType        string `json:"primary_type"`
+	SecondaryID        int    `json:"secondary_id"`
+	SecondaryType      string `json:"secondary_type"`
+	CreatedAt          Time   `json:"created_at"`
+	UpdatedAt          Time   `json:"updated_at"`
+}
+
+// CreateRelationshipModel is the data structure required to create a new Relationship,
+// the ids are the display ids of the items and the types are e.g. RelationshipEntityAsset
+type CreateRelationshipModel struct {
+	RelationshipTypeID int    `json:"relationship_type_id"`
+	PrimaryID          int    `json:"primary_id"`
+	PrimaryType        string `json:"primary_type"`
+	SecondaryID        int    `json:"secondary_id"`
+	SecondaryType      string `json:"secondary_type"`
+}
+
+// createRelationshipsModel is the body of the bulk create endpoint
+type createRelationshipsModel struct {
+	Relationships []CreateRelationshipModel `json:"relationships"`
+}
+
+// RelationshipTypes contains Collection an array of RelationshipType
+type RelationshipTypes struct {
+	Collection []RelationshipType `json:"relationship_types"`
+}
+
+// RelationshipType represents a type of Relationship, described from both sides (e.g. "Depends On" / "Used By")
+type RelationshipType struct {
+	ID                 int    `json:"id"`
+	Description        string `json:"description"`
+	DownstreamRelation string `json:"downstream_relation"`
+	UpstreamRelation   string `json:"upstream_relation"`
+	CreatedAt          Time   `json:"created_at"`
+	UpdatedAt          Time   `json:"updated_at"`
+}
+
+// RelationshipJob represents the asynchronous job creating Relationships in bulk
+type RelationshipJob struct {
+	ID            string                  `json:"job_id"`
+	Href          string                  `json:"href"`
+	Status        string                  `json:"status"`
+	OperationName string                  `json:"operation_name"`
+	Relationships []RelationshipJobResult `json:"relationships"`
+	CreatedAt     Time                    `json:"created_at"`
+	UpdatedAt     Time                    `json:"updated_at"`
+}
+
+// RelationshipJobResult is the outcome of creating a single Relationship within a RelationshipJob
+type RelationshipJobResult struct {
+	Relationship
+	Success bool         `json:"success"`
+	Errors  []FieldError `json:"errors"`
+}
+
+// Done reports whether the RelationshipJob has finished (successfully or not)
+func (j *RelationshipJob) Done() bool {
+	return j.Status == JobStatusSuccess || j.Status == JobStatusPartial || j.Status == JobStatusFailed
+}
+
+// relationshipIds is the query string identifying multiple Relationships
+type relationshipIds struct {
+	IDs []int `url:"ids,comma"`
+}
+
+// ListRelationships will return all Relationships of an Asset by displayId
+func (s *AssetService) ListRelationships(ctx context.Context, displayId int) (*Relationships, *Response, error) {
+	o := new(Relationships)
+	res, err := s.client.List(ctx, fmt.Sprintf(assetRelationshipsUrl, displayId), nil, &o)
+	return o, res, err
+}
+
+// GetRelationships will return the Relationships matching ids
+func (s *AssetService) GetRelationships(ctx context.Context, ids ...int) (*Relationships, *Response, error) {
+	o := new(Relationships)
+	res, err := s.client.List(ctx, relationshipsUrl, &relationshipIds{IDs: ids}, &o)
+	return o, res, err
+}
+
+// CreateRelationships will start a RelationshipJob creating the Relationships in bulk, use WaitForRelationshipJob
+// to wait for it to complete
+func (s *AssetService) CreateRelationships(ctx context.Context, relationships []CreateRelationshipModel) (*RelationshipJob, *Response, error) {
+	o := new(RelationshipJob)
+	res, err := s.client.Post(ctx, relationshipsBulkUrl, &createRelationshipsModel{Relationships: relationships}, &o)
+	if err == nil && o.ID == "" {
+		return o, res, fmt.Errorf("relationship job was not started, no job id returned")
+	}
+	return o, res, err
+}
+
+// GetRelationshipJob will return the current state of a RelationshipJob by id
+func (s *AssetService) GetRelationshipJob(ctx context.Context, jobId string) (*RelationshipJob, *Response, error) {
+	o := new(RelationshipJob)
+	res, err := s.client.Get(ctx, fmt.Sprintf(jobIdUrl, jobId), &o)
+	return o, res, err
+}
+
+// WaitForRelationshipJob will poll the RelationshipJob every interval (2 seconds when 0) until it is Done or ctx is
+// cancelled. A failed job is returned along with an error, a partial job without one so the results can be inspected.
+// A job with an empty or unknown status is returned with an error rather than polled forever.
+func (s *AssetService) WaitForRelationshipJob(ctx context.Context, jobId string, interval time.Duration) (*RelationshipJob, error) {
+	if ctx == nil {
+		ctx = s.client.ctx
+	}
+	if jobId == "" {
+		return nil, fmt.Errorf("no relationship job id provided")
+	}
+	if interval <= 0 {
+		interval = defaultJobPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, _, err := s.GetRelationshipJob(ctx, jobId)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case JobStatusSuccess, JobStatusPartial:
+			return job, nil
+		case JobStatusFailed:
+			return job, fmt.Errorf("relationship job %s failed", jobId)
+		case JobStatusQueued, JobStatusInProgress:
+		default:
+			return job, fmt.Errorf("relationship job %s has unknown status %q", jobId, job.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteRelationships will remove the Relationships matching ids
+func (s *AssetService) DeleteRelationships(ctx context.Context, ids ...int) (bool, *Response, error) {
+	if len(ids) == 0 {
+		return false, nil, fmt.Errorf("no relationship ids provided")
+	}
+	success, res, err := s.client.DeleteWithOptions(ctx, relationshipsUrl, &relationshipIds{IDs: ids})
+	return success, res, err
+}
+
+// ListRelationshipTypes will return all RelationshipTypes
+func (s *AssetService) ListRelationshipTypes(ctx context.Context) (*RelationshipTypes, *Response, error) {
+	o := new(RelationshipTypes)
+	res, err := s.client.List(ctx, relationshipTypesUrl, nil, &o)
+	return o, res, err
+}
@@ -0,0 +1,161 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicetest"
+)
+
+func TestWaitForRelationshipJob(t *testing.T) {
+	tests := []struct {
+		name       string
+		jobId      string
+		statuses   []string
+		timeout    time.Duration
+		wantStatus string
+		wantPolls  int32
+		wantErr    string
+	}{
+		{name: "success", jobId: "j1", statuses: []string{"queued", "in progress", "success"}, wantStatus: "success", wantPolls: 3},
+		{name: "partial", jobId: "j1", statuses: []string{"in progress", "partial"}, wantStatus: "partial", wantPolls: 2},
+		{name: "failed", jobId: "j1", statuses: []string{"queued", "failed"}, wantStatus: "failed", wantPolls: 2, wantErr: "relationship job j1 failed"},
+		{name: "unknown status", jobId: "j1", statuses: []string{"queued", "exploded"}, wantStatus: "exploded", wantPolls: 2, wantErr: `unknown status "exploded"`},
+		{name: "empty status", jobId: "j1", statuses: []string{""}, wantPolls: 1, wantErr: `unknown status ""`},
+		{name: "empty job id", wantPolls: 0, wantErr: "no relationship job id provided"},
+		{name: "cancelled", jobId: "j1", statuses: []string{"queued"}, timeout: 30 * time.Millisecond, wantErr: context.DeadlineExceeded.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v2/jobs/"+tt.jobId {
+					t.Errorf("got request for %s", r.URL.Path)
+				}
+				n := int(atomic.AddInt32(&polls, 1))
+				if n > len(tt.statuses) {
+					n = len(tt.statuses)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"job_id": %q, "status": %q}`, tt.jobId, tt.statuses[n-1])
+			}))
+			defer ts.Close()
+
+			fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL(ts.URL+"/api/v2"))
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			job, err := fs.Assets.WaitForRelationshipJob(ctx, tt.jobId, time.Millisecond)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("WaitForRelationshipJob: %v", err)
+			}
+
+			if tt.wantStatus != "" && (job == nil || job.Status != tt.wantStatus) {
+				t.Errorf("got job %+v, want status %q", job, tt.wantStatus)
+			}
+			if tt.timeout == 0 && polls != tt.wantPolls {
+				t.Errorf("got %d polls, want %d", polls, tt.wantPolls)
+			}
+		})
+	}
+}
+
+func TestRelationshipJobDone(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{status: freshservice.JobStatusQueued},
+		{status: freshservice.JobStatusInProgress},
+		{status: freshservice.JobStatusSuccess, want: true},
+		{status: freshservice.JobStatusPartial, want: true},
+		{status: freshservice.JobStatusFailed, want: true},
+		{status: ""},
+		{status: "exploded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			job := &freshservice.RelationshipJob{Status: tt.status}
+			if got := job.Done(); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateRelationshipsWithoutJobId(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL(ts.URL+"/api/v2"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, _, err = fs.Assets.CreateRelationships(context.Background(), []freshservice.CreateRelationshipModel{{RelationshipTypeID: 1, PrimaryID: 1, SecondaryID: 2}})
+	if err == nil || !strings.Contains(err.Error(), "no job id returned") {
+		t.Errorf("got error %v, want no job id returned", err)
+	}
+}
+
+func TestCreateRelationships(t *testing.T) {
+	fs, srv := freshservicetest.New(t)
+	for i := 0; i < 2; i++ {
+		if _, err := srv.Seed("assets", map[string]interface{}{"name": fmt.Sprintf("Laptop %d", i+1), "asset_type_id": 5}); err != nil {
+			t.Fatalf("unable to seed asset: %v", err)
+		}
+	}
+
+	ctx := context.Background()
+	job, _, err := fs.Assets.CreateRelationships(ctx, []freshservice.CreateRelationshipModel{{
+		RelationshipTypeID: 1,
+		PrimaryID:          1,
+		PrimaryType:        freshservice.RelationshipEntityAsset,
+		SecondaryID:        2,
+		SecondaryType:      freshservice.RelationshipEntityAsset,
+	}})
+	if err != nil {
+		t.Fatalf("CreateRelationships: %v", err)
+	}
+
+	job, err = fs.Assets.WaitForRelationshipJob(ctx, job.ID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForRelationshipJob: %v", err)
+	}
+	if job.Status != freshservice.JobStatusSuccess || len(job.Relationships) != 1 || !job.Relationships[0].Success {
+		t.Fatalf("got job %+v, want one successful relationship", job)
+	}
+
+	list, _, err := fs.Assets.ListRelationships(ctx, 1)
+	if err != nil {
+		t.Fatalf("ListRelationships: %v", err)
+	}
+	if len(list.Collection) != 1 || list.Collection[0].SecondaryID != 2 {
+		t.Errorf("got relationships %+v, want the one created", list.Collection)
+	}
+}
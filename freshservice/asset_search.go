@@ -0,0 +1,113 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// assetsQuery is the query string sent to the assets endpoint when searching or filtering
+type assetsQuery struct {
+	ListAssetsOptions
+	Search string `url:"search,omitempty"`
+	Filter string `url:"filter,omitempty"`
+}
+
+// typeFieldSuffix matches the asset type id FreshService appends to the names of type fields (e.g. serial_number_25)
+var typeFieldSuffix = regexp.MustCompile(`_\d+$`)
+
+// TypeFieldsByName returns the TypeFields of the Asset keyed by name without the asset type id suffix,
+// e.g. "serial_number_25" becomes "serial_number". Requires the Asset to have been fetched with type fields.
+func (a Asset) TypeFieldsByName() CustomFields {
+	fields := make(CustomFields, len(a.TypeFields))
+	for k, v := range a.TypeFields {
+		fields[typeFieldSuffix.ReplaceAllString(k, "")] = v
+	}
+	return fields
+}
+
+// SearchAssets will return a page of Assets matching the Query, which may use the name, asset_tag, serial_number,
+// mac_addresses, ip_addresses, uuid or item_id fields e.g. Q.Eq("serial_number", "HSN123")
+func (s *AssetService) SearchAssets(ctx context.Context, q Query, opt *ListAssetsOptions) (*Assets, *Response, error) {
+	expr, err := q.Build()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid asset search: %v", err)
+	}
+
+	f := assetsQuery{Search: expr}
+	if opt != nil {
+		f.ListAssetsOptions = *opt
+	}
+
+	o := new(Assets)
+	res, err := s.client.List(ctx, assetsUrl, &f, &o)
+	return o, res, err
+}
+
+// FilterAssets will return a page of Assets matching the Query
+// e.g. Q.Eq("asset_type_id", 5).And(Q.Eq("location_id", 3))
+func (s *AssetService) FilterAssets(ctx context.Context, q Query, opt *ListAssetsOptions) (*Assets, *Response, error) {
+	expr, err := q.Build()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid asset filter: %v", err)
+	}
+
+	f := assetsQuery{Filter: expr}
+	if opt != nil {
+		f.ListAssetsOptions = *opt
+	}
+
+	o := new(Assets)
+	res, err := s.client.List(ctx, assetsUrl, &f, &o)
+	return o, res, err
+}
+
+// IterSearchAssets will call fn for every Asset matching the search Query, following pagination until fn returns false or a limit is reached
+func (s *AssetService) IterSearchAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions, fn func(Asset) bool) error {
+	return s.iterQuery(ctx, s.SearchAssets, q, opt, limit, fn)
+}
+
+// ListAllSearchAssets will return every Asset matching the search Query by following pagination
+func (s *AssetService) ListAllSearchAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions) ([]Asset, error) {
+	var all []Asset
+	err := s.IterSearchAssets(ctx, q, opt, limit, func(i Asset) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
+
+// IterFilterAssets will call fn for every Asset matching the filter Query, following pagination until fn returns false or a limit is reached
+func (s *AssetService) IterFilterAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions, fn func(Asset) bool) error {
+	return s.iterQuery(ctx, s.FilterAssets, q, opt, limit, fn)
+}
+
+// ListAllFilterAssets will return every Asset matching the filter Query by following pagination
+func (s *AssetService) ListAllFilterAssets(ctx context.Context, q Query, opt *ListAssetsOptions, limit *PaginationOptions) ([]Asset, error) {
+	var all []Asset
+	err := s.IterFilterAssets(ctx, q, opt, limit, func(i Asset) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
+
+// iterQuery follows the pagination of SearchAssets or FilterAssets
+func (s *AssetService) iterQuery(ctx context.Context, list func(context.Context, Query, *ListAssetsOptions) (*Assets, *Response, error), q Query, opt *ListAssetsOptions, limit *PaginationOptions, fn func(Asset) bool) error {
+	o := ListAssetsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := list(ctx, q, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
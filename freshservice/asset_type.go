@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 // AssetTypes contains Collection an array of AssetType
@@ -18,13 +17,13 @@ type assetTypeWrapper struct {
 
 // AssetType represents a FreshService AssetType
 type AssetType struct {
-	ID                int       `json:"id"`
-	Name              string    `json:"name"`
-	Description       string    `json:"description"`
-	ParentAssetTypeID int       `json:"parent_asset_type_id"`
-	Visible           bool      `json:"visible"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	ParentAssetTypeID int    `json:"parent_asset_type_id"`
+	Visible           bool   `json:"visible"`
+	CreatedAt         Time   `json:"created_at"`
+	UpdatedAt         Time   `json:"updated_at"`
 }
 
 // CreateAssetTypeModel is the data structure required to create a new AssetType
@@ -36,9 +35,16 @@ type CreateAssetTypeModel struct {
 
 // UpdateAssetTypeModel is the data structure required to update an AssetType
 type UpdateAssetTypeModel struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Visible     bool   `json:"visible"`
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Visible     *bool    `json:"visible,omitempty"`
+	NullFields  []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateAssetTypeModel which are set, along with the NullFields as null
+func (m UpdateAssetTypeModel) MarshalJSON() ([]byte, error) {
+	type model UpdateAssetTypeModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListAssetTypesOptions represents filters/pagination for AssetTypes
@@ -47,35 +53,65 @@ type ListAssetTypesOptions struct {
 }
 
 // GetAssetType returns an AssetType by id
-func (s *AssetService) GetAssetType(id int) (*AssetType, *http.Response, error) {
+func (s *AssetService) GetAssetType(ctx context.Context, id int) (*AssetType, *Response, error) {
 	o := new(assetTypeWrapper)
-	res, err := s.client.Get(fmt.Sprintf(fmt.Sprintf(assetTypeIdUrl, id), id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(fmt.Sprintf(assetTypeIdUrl, id), id), &o)
 	return &o.Details, res, err
 }
 
 // ListAssetTypes will return paginated/filtered AssetTypes using ListAssetTypesOptions
-func (s *AssetService) ListAssetTypes(opt *ListAssetTypesOptions) (*AssetTypes, *http.Response, error) {
+func (s *AssetService) ListAssetTypes(ctx context.Context, opt *ListAssetTypesOptions) (*AssetTypes, *Response, error) {
 	o := new(AssetTypes)
-	res, err := s.client.List(assetTypesUrl, opt, &o)
+	res, err := s.client.List(ctx, assetTypesUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateAssetType creates and returns a new AssetType based on CreateAssetTypeModel
-func (s *AssetService) CreateAssetType(newAssetType CreateAssetTypeModel) (*AssetType, *http.Response, error) {
+func (s *AssetService) CreateAssetType(ctx context.Context, newAssetType CreateAssetTypeModel) (*AssetType, *Response, error) {
 	o := new(assetTypeWrapper)
-	res, err := s.client.Post(assetTypesUrl, newAssetType, &o)
+	res, err := s.client.Post(ctx, assetTypesUrl, newAssetType, &o)
 	return &o.Details, res, err
 }
 
 // UpdateAssetType updates and returns an AssetType matching id based on UpdateAssetTypeModel
-func (s *AssetService) UpdateAssetType(id int, updatedAssetType UpdateAssetTypeModel) (*AssetType, *http.Response, error) {
+func (s *AssetService) UpdateAssetType(ctx context.Context, id int, updatedAssetType UpdateAssetTypeModel) (*AssetType, *Response, error) {
 	o := new(assetTypeWrapper)
-	res, err := s.client.Put(fmt.Sprintf(assetTypeIdUrl, id), updatedAssetType, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(assetTypeIdUrl, id), updatedAssetType, &o)
 	return &o.Details, res, err
 }
 
 // DeleteAssetType irrecoverably deletes an AssetType from FreshService matching the id
-func (s *AssetService) DeleteAssetType(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(assetTypeIdUrl, id))
+func (s *AssetService) DeleteAssetType(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(assetTypeIdUrl, id))
 	return success, res, err
 }
+
+// IterAssetTypes will call fn for every AssetType matching ListAssetTypesOptions, following pagination until fn returns false or a limit is reached
+func (s *AssetService) IterAssetTypes(ctx context.Context, opt *ListAssetTypesOptions, limit *PaginationOptions, fn func(AssetType) bool) error {
+	o := ListAssetTypesOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListAssetTypes(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllAssetTypes will return every AssetType matching ListAssetTypesOptions by following pagination
+func (s *AssetService) ListAllAssetTypes(ctx context.Context, opt *ListAssetTypesOptions, limit *PaginationOptions) ([]AssetType, error) {
+	var all []AssetType
+	err := s.IterAssetTypes(ctx, opt, limit, func(i AssetType) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -35,8 +34,8 @@ type BusinessHour struct {
 	TimeZone         string             `json:"time_zone"`
 	ListOfHolidays   []Holiday          `json:"list_of_holidays"`
 	ServiceDeskHours map[string]Workday `json:"service_desk_hours"`
-	CreatedAt        time.Time          `json:"created_at"`
-	UpdatedAt        time.Time          `json:"updated_at"`
+	CreatedAt        Time               `json:"created_at"`
+	UpdatedAt        Time               `json:"updated_at"`
 }
 
 // Workday is a data struct representing the start and end of a working day
@@ -47,7 +46,7 @@ type Workday struct {
 
 // Holiday is a data struct representing a configured holiday
 type Holiday struct {
-	HolidayDate string `json:"holiday_date"`
+	HolidayDate Date   `json:"holiday_date"`
 	HolidayName string `json:"holiday_name"`
 }
 
@@ -57,15 +56,45 @@ type ListBusinessHoursOptions struct {
 }
 
 // GetBusinessHours will return a single BusinessHour configuration by id
-func (s *BusinessHoursService) GetBusinessHours(id int) (*BusinessHour, *http.Response, error) {
+func (s *BusinessHoursService) GetBusinessHours(ctx context.Context, id int) (*BusinessHour, *Response, error) {
 	o := new(businessHourWrapper)
-	res, err := s.client.Get(fmt.Sprintf(businessHoursIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(businessHoursIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListBusinessHours will return paginated/filtered BusinessHours using ListBusinessHoursOptions
-func (s *BusinessHoursService) ListBusinessHours(opt *ListBusinessHoursOptions) (*BusinessHours, *http.Response, error) {
+func (s *BusinessHoursService) ListBusinessHours(ctx context.Context, opt *ListBusinessHoursOptions) (*BusinessHours, *Response, error) {
 	o := new(BusinessHours)
-	res, err := s.client.List(businessHoursUrl, opt, &o)
+	res, err := s.client.List(ctx, businessHoursUrl, opt, &o)
 	return o, res, err
 }
+
+// IterBusinessHours will call fn for every BusinessHour matching ListBusinessHoursOptions, following pagination until fn returns false or a limit is reached
+func (s *BusinessHoursService) IterBusinessHours(ctx context.Context, opt *ListBusinessHoursOptions, limit *PaginationOptions, fn func(BusinessHour) bool) error {
+	o := ListBusinessHoursOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListBusinessHours(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllBusinessHours will return every BusinessHour matching ListBusinessHoursOptions by following pagination
+func (s *BusinessHoursService) ListAllBusinessHours(ctx context.Context, opt *ListBusinessHoursOptions, limit *PaginationOptions) ([]BusinessHour, error) {
+	var all []BusinessHour
+	err := s.IterBusinessHours(ctx, opt, limit, func(i BusinessHour) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -1,8 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"time"
 )
 
@@ -14,30 +14,6 @@ const (
 	changeNoteIdUrl  = "changes/%d/notes/%d"
 )
 
-const (
-	ChangeStatusOpen           = 1
-	ChangeStatusPlanning       = 2
-	ChangeStatusApproval       = 3
-	ChangeStatusPendingRelease = 4
-	ChangeStatusPendingReview  = 5
-	ChangeStatusClosed         = 6
-	ChangePriorityLow          = 1
-	ChangePriorityMedium       = 2
-	ChangePriorityHigh         = 3
-	ChangePriorityUrgent       = 4
-	ChangeImpactLow            = 1
-	ChangeImpactMedium         = 2
-	ChangeImpactHigh           = 3
-	ChangeTypeMinor            = 1
-	ChangeTypeStandard         = 2
-	ChangeTypeMajor            = 3
-	ChangeTypeEmergency        = 4
-	ChangeRiskLow              = 1
-	ChangeRiskMedium           = 2
-	ChangeRiskHigh             = 3
-	ChangeRiskVeryHigh         = 4
-)
-
 // ChangeService API Docs: https://api.freshservice.com/#changes
 type ChangeService struct {
 	client *Client
@@ -55,66 +31,76 @@ type changeWrapper struct {
 
 // Change represents a Change request on FreshService
 type Change struct {
-	ID               int       `json:"id"`
-	AgentID          int       `json:"agent_id"`
-	Description      string    `json:"description"`
-	DescriptionText  string    `json:"description_text"`
-	RequesterID      int       `json:"requester_id"`
-	GroupID          int       `json:"group_id"`
-	Priority         int       `json:"priority"`
-	Impact           int       `json:"impact"`
-	Status           int       `json:"status"`
-	Risk             int       `json:"risk"`
-	ChangeType       int       `json:"change_type"`
-	ApprovalStatus   int       `json:"approval_status"`
-	PlannedStartDate time.Time `json:"planned_start_date"`
-	PlannedEndDate   time.Time `json:"planned_end_date"`
-	Subject          string    `json:"subject"`
-	DepartmentID     int       `json:"department_id"`
-	Category         string    `json:"category"`
-	SubCategory      string    `json:"sub_category"`
-	ItemCategory     string    `json:"item_category"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID               int          `json:"id"`
+	AgentID          int          `json:"agent_id"`
+	Description      string       `json:"description"`
+	DescriptionText  string       `json:"description_text"`
+	RequesterID      int          `json:"requester_id"`
+	GroupID          int          `json:"group_id"`
+	Priority         Priority     `json:"priority"`
+	Impact           Impact       `json:"impact"`
+	Status           ChangeStatus `json:"status"`
+	Risk             ChangeRisk   `json:"risk"`
+	ChangeType       ChangeType   `json:"change_type"`
+	ApprovalStatus   int          `json:"approval_status"`
+	PlannedStartDate Time         `json:"planned_start_date"`
+	PlannedEndDate   Time         `json:"planned_end_date"`
+	Subject          string       `json:"subject"`
+	DepartmentID     int          `json:"department_id"`
+	Category         string       `json:"category"`
+	SubCategory      string       `json:"sub_category"`
+	ItemCategory     string       `json:"item_category"`
+	CreatedAt        Time         `json:"created_at"`
+	UpdatedAt        Time         `json:"updated_at"`
+	CustomFields     CustomFields `json:"custom_fields"`
 }
 
 // CreateChangeModel is a data struct for creating a new Change
 type CreateChangeModel struct {
-	AgentID          int       `json:"agent_id"`
-	Description      string    `json:"description"`
-	Subject          string    `json:"subject"`
-	GroupID          int       `json:"group_id"`
-	Priority         int       `json:"priority"`
-	Impact           int       `json:"impact"`
-	Status           int       `json:"status"`
-	Risk             int       `json:"risk"`
-	ChangeType       int       `json:"change_type"`
-	ApprovalStatus   int       `json:"approval_status"`
-	PlannedStartDate time.Time `json:"planned_start_date"`
-	PlannedEndDate   time.Time `json:"planned_end_date"`
-	DepartmentID     int       `json:"department_id"`
+	AgentID          int          `json:"agent_id"`
+	Description      string       `json:"description"`
+	Subject          string       `json:"subject"`
+	GroupID          int          `json:"group_id"`
+	Priority         Priority     `json:"priority"`
+	Impact           Impact       `json:"impact"`
+	Status           ChangeStatus `json:"status"`
+	Risk             ChangeRisk   `json:"risk"`
+	ChangeType       ChangeType   `json:"change_type"`
+	ApprovalStatus   int          `json:"approval_status"`
+	PlannedStartDate Time         `json:"planned_start_date"`
+	PlannedEndDate   Time         `json:"planned_end_date"`
+	DepartmentID     int          `json:"department_id"`
+	CustomFields     CustomFields `json:"custom_fields,omitempty"`
 }
 
 // UpdateChangeModel is a data struct for updating a Change
 type UpdateChangeModel struct {
-	AgentID          int       `json:"agent_id"`
-	Description      string    `json:"description"`
-	DescriptionText  string    `json:"description_text"`
-	RequesterID      int       `json:"requester_id"`
-	GroupID          int       `json:"group_id"`
-	Priority         int       `json:"priority"`
-	Impact           int       `json:"impact"`
-	Status           int       `json:"status"`
-	Risk             int       `json:"risk"`
-	ChangeType       int       `json:"change_type"`
-	ApprovalStatus   int       `json:"approval_status"`
-	PlannedStartDate time.Time `json:"planned_start_date"`
-	PlannedEndDate   time.Time `json:"planned_end_date"`
-	Subject          string    `json:"subject"`
-	DepartmentID     int       `json:"department_id"`
-	Category         string    `json:"category"`
-	SubCategory      string    `json:"sub_category"`
-	ItemCategory     string    `json:"item_category"`
+	AgentID          *int          `json:"agent_id,omitempty"`
+	Description      *string       `json:"description,omitempty"`
+	DescriptionText  *string       `json:"description_text,omitempty"`
+	RequesterID      *int          `json:"requester_id,omitempty"`
+	GroupID          *int          `json:"group_id,omitempty"`
+	Priority         *Priority     `json:"priority,omitempty"`
+	Impact           *Impact       `json:"impact,omitempty"`
+	Status           *ChangeStatus `json:"status,omitempty"`
+	Risk             *ChangeRisk   `json:"risk,omitempty"`
+	ChangeType       *ChangeType   `json:"change_type,omitempty"`
+	ApprovalStatus   *int          `json:"approval_status,omitempty"`
+	PlannedStartDate *Time         `json:"planned_start_date,omitempty"`
+	PlannedEndDate   *Time         `json:"planned_end_date,omitempty"`
+	Subject          *string       `json:"subject,omitempty"`
+	DepartmentID     *int          `json:"department_id,omitempty"`
+	Category         *string       `json:"category,omitempty"`
+	SubCategory      *string       `json:"sub_category,omitempty"`
+	ItemCategory     *string       `json:"item_category,omitempty"`
+	CustomFields     CustomFields  `json:"custom_fields,omitempty"`
+	NullFields       []string      `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateChangeModel which are set, along with the NullFields as null
+func (m UpdateChangeModel) MarshalJSON() ([]byte, error) {
+	type model UpdateChangeModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListChangesOptions represents filters/pagination for Changes
@@ -126,42 +112,72 @@ type ListChangesOptions struct {
 }
 
 // GetChange will return a single Change by id
-func (s *ChangeService) GetChange(id int) (*Change, *http.Response, error) {
+func (s *ChangeService) GetChange(ctx context.Context, id int) (*Change, *Response, error) {
 	o := new(changeWrapper)
-	res, err := s.client.Get(fmt.Sprintf(changeIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(changeIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListChanges will return paginated/filtered Change using ListChangesOptions
-func (s *ChangeService) ListChanges(opt *ListChangesOptions) (*Changes, *http.Response, error) {
+func (s *ChangeService) ListChanges(ctx context.Context, opt *ListChangesOptions) (*Changes, *Response, error) {
 	o := new(Changes)
-	res, err := s.client.List(changesUrl, opt, &o)
+	res, err := s.client.List(ctx, changesUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateChange will create and return a new Change based on CreateChangeModel
-func (s *ChangeService) CreateChange(newChange *CreateChangeModel) (*Change, *http.Response, error) {
+func (s *ChangeService) CreateChange(ctx context.Context, newChange *CreateChangeModel) (*Change, *Response, error) {
 	o := new(changeWrapper)
-	res, err := s.client.Post(changesUrl, newChange, &o)
+	res, err := s.client.Post(ctx, changesUrl, newChange, &o)
 	return &o.Details, res, err
 }
 
 // UpdateChange will update and return a Change matching id based on UpdateChangeModel
-func (s *ChangeService) UpdateChange(id int, ticket *UpdateChangeModel) (*Change, *http.Response, error) {
+func (s *ChangeService) UpdateChange(ctx context.Context, id int, ticket *UpdateChangeModel) (*Change, *Response, error) {
 	o := new(changeWrapper)
-	res, err := s.client.Put(fmt.Sprintf(changeIdUrl, id), ticket, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(changeIdUrl, id), ticket, &o)
 	return &o.Details, res, err
 }
 
 // DeleteChange will trash a Change from FreshService (Can be restored by RestoreChange)
-func (s *ChangeService) DeleteChange(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(changeIdUrl, id))
+func (s *ChangeService) DeleteChange(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(changeIdUrl, id))
 	return success, res, err
 }
 
 // RestoreChange will restore a previously trashed (deleted) Change
-func (s *ChangeService) RestoreChange(id int) (bool, *http.Response, error) {
-	res, err := s.client.Put(fmt.Sprintf(changeRestoreUrl, id), nil, nil)
-	success, _ := isSuccessful(res)
+func (s *ChangeService) RestoreChange(ctx context.Context, id int) (bool, *Response, error) {
+	res, err := s.client.Put(ctx, fmt.Sprintf(changeRestoreUrl, id), nil, nil)
+	success := err == nil
 	return success, res, err
 }
+
+// IterChanges will call fn for every Change matching ListChangesOptions, following pagination until fn returns false or a limit is reached
+func (s *ChangeService) IterChanges(ctx context.Context, opt *ListChangesOptions, limit *PaginationOptions, fn func(Change) bool) error {
+	o := ListChangesOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListChanges(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllChanges will return every Change matching ListChangesOptions by following pagination
+func (s *ChangeService) ListAllChanges(ctx context.Context, opt *ListChangesOptions, limit *PaginationOptions) ([]Change, error) {
+	var all []Change
+	err := s.IterChanges(ctx, opt, limit, func(i Change) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,10 +20,16 @@ const (
 )
 
 type Client struct {
+	ctx       context.Context
 	client    *retryHttp.Client
 	baseUrl   *url.URL
 	token     string
+	logger    Logger
+	limiter   *RateLimiter
 	UserAgent string
+	// transport and timeout are set by WithTransport and WithTimeout, then applied to the http.Client
+	transport http.RoundTripper
+	timeout   *time.Duration
 	// Add services
 	Agents                 *AgentService
 	Announcements          *AnnouncementService
@@ -31,12 +38,14 @@ type Client struct {
 	Changes                *ChangeService
 	Contracts              *ContractService
 	Departments            *DepartmentService
+	Groups                 *GroupService
 	Locations              *LocationService
 	Problems               *ProblemService
 	Products               *ProductService
 	PurchaseOrders         *PurchaseOrderService
 	Releases               *ReleaseService
 	Requesters             *RequesterService
+	RequesterGroups        *RequesterGroupService
 	Services               *ServiceCatalogService
 	ServiceLevelAgreements *SLAPoliciesService
 	Software               *SoftwareService
@@ -46,40 +55,66 @@ type Client struct {
 }
 
 // NewClient generates a new API client, requires the subdomain of your FreshService instance as well as an API key
-// ctx is optional and will default to context.Background() if nil is passed.
-func NewClient(ctx context.Context, subDomain string, apiKey string) (*Client, error) {
+// ctx is optional and will default to context.Background() if nil is passed, it is used for any request made with a nil context.
+// opts can be used to override the defaults of the client (see ClientOption), subDomain may be empty when WithBaseURL is used.
+func NewClient(ctx context.Context, subDomain string, apiKey string, opts ...ClientOption) (*Client, error) {
 
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	if subDomain == "" {
-		return nil, fmt.Errorf("sub-domain was not provided but is required")
-	}
-
 	if apiKey == "" {
 		return nil, fmt.Errorf("api key was not provided but is required")
 	}
 
-	baseUrl, err := buildBaseUrl(subDomain)
-	if err != nil {
-		return nil, err
-	}
-
 	fs := &Client{
-		baseUrl:   baseUrl,
+		ctx:       ctx,
 		token:     apiKey,
+		logger:    nopLogger{},
+		limiter:   NewRateLimiter(0),
 		UserAgent: userAgent,
 	}
 
 	fs.client = &retryHttp.Client{
-		Backoff:      fs.setBackoff,
-		CheckRetry:   fs.canRetry,
-		ErrorHandler: retryHttp.PassthroughErrorHandler,
-		HTTPClient:   cleanHttp.DefaultPooledClient(),
-		RetryMax:     5,
-		RetryWaitMin: 500 * time.Millisecond,
-		RetryWaitMax: time.Second,
+		Backoff:         fs.setBackoff,
+		CheckRetry:      fs.canRetry,
+		ErrorHandler:    retryHttp.PassthroughErrorHandler,
+		HTTPClient:      cleanHttp.DefaultPooledClient(),
+		Logger:          retryLogger{client: fs},
+		RequestLogHook:  fs.logRequestAttempt,
+		ResponseLogHook: fs.onResponse,
+		RetryMax:        5,
+		RetryWaitMin:    500 * time.Millisecond,
+		RetryWaitMax:    time.Second,
+	}
+
+	for _, opt := range opts {
+		if err := opt(fs); err != nil {
+			return nil, err
+		}
+	}
+
+	if fs.transport != nil || fs.timeout != nil {
+		hc := *fs.client.HTTPClient
+		if fs.transport != nil {
+			hc.Transport = fs.transport
+		}
+		if fs.timeout != nil {
+			hc.Timeout = *fs.timeout
+		}
+		fs.client.HTTPClient = &hc
+	}
+
+	if fs.baseUrl == nil {
+		if subDomain == "" {
+			return nil, fmt.Errorf("sub-domain was not provided but is required")
+		}
+
+		baseUrl, err := buildBaseUrl(subDomain)
+		if err != nil {
+			return nil, err
+		}
+		fs.baseUrl = baseUrl
 	}
 
 	// Add services
@@ -90,12 +125,14 @@ func NewClient(ctx context.Context, subDomain string, apiKey string) (*Client, e
 	fs.Changes = &ChangeService{client: fs}
 	fs.Contracts = &ContractService{client: fs}
 	fs.Departments = &DepartmentService{client: fs}
+	fs.Groups = &GroupService{client: fs}
 	fs.Locations = &LocationService{client: fs}
 	fs.Problems = &ProblemService{client: fs}
 	fs.Products = &ProductService{client: fs}
 	fs.PurchaseOrders = &PurchaseOrderService{client: fs}
 	fs.Releases = &ReleaseService{client: fs}
 	fs.Requesters = &RequesterService{client: fs}
+	fs.RequesterGroups = &RequesterGroupService{client: fs}
 	fs.Services = &ServiceCatalogService{client: fs}
 	fs.ServiceLevelAgreements = &SLAPoliciesService{client: fs}
 	fs.Software = &SoftwareService{client: fs}
@@ -108,14 +145,14 @@ func NewClient(ctx context.Context, subDomain string, apiKey string) (*Client, e
 
 // canRetry determines if retrying should happen (rate limit, temporary server issue)
 func (c *Client) canRetry(ctx context.Context, res *http.Response, err error) (bool, error) {
-	if err != nil {
-		return false, err
-	}
-
 	if ctx.Err() != nil {
 		return false, ctx.Err()
 	}
 
+	if err != nil {
+		return false, err
+	}
+
 	if res.StatusCode == 429 || res.StatusCode >= 500 {
 		return true, nil
 	}
@@ -123,6 +160,14 @@ func (c *Client) canRetry(ctx context.Context, res *http.Response, err error) (b
 	return false, nil
 }
 
+// onResponse is called for every response received (including those that will be retried) to tune the RateLimiter
+func (c *Client) onResponse(l retryHttp.Logger, res *http.Response) {
+	if c.limiter != nil {
+		c.limiter.update(res)
+	}
+	c.logResponseAttempt(l, res)
+}
+
 // setBackoff determines backoff for re-attempts due to rate-limits (take time from header) or transient failures.
 func (c *Client) setBackoff(min time.Duration, max time.Duration, attemptNum int, res *http.Response) time.Duration {
 	if res != nil && res.StatusCode == 429 {
@@ -138,7 +183,11 @@ func (c *Client) setBackoff(min time.Duration, max time.Duration, attemptNum int
 	return retryHttp.LinearJitterBackoff(min, max, attemptNum, res)
 }
 
-func (c *Client) buildRequest(method string, path string, opt interface{}) (*retryHttp.Request, error) {
+func (c *Client) buildRequest(ctx context.Context, method string, path string, opt interface{}) (*retryHttp.Request, error) {
+	if ctx == nil {
+		ctx = c.ctx
+	}
+
 	dest := *c.baseUrl
 
 	unescaped, err := url.PathUnescape(path)
@@ -150,10 +199,10 @@ func (c *Client) buildRequest(method string, path string, opt interface{}) (*ret
 	dest.Path = c.baseUrl.Path + unescaped
 
 	var content interface{}
-	setContentType := false
+	contentType := ""
 
 	switch method {
-	case http.MethodGet:
+	case http.MethodGet, http.MethodDelete:
 		if opt != nil {
 			q, err := query.Values(opt)
 			if err != nil {
@@ -162,15 +211,16 @@ func (c *Client) buildRequest(method string, path string, opt interface{}) (*ret
 			dest.RawQuery = q.Encode()
 		}
 	case http.MethodPost, http.MethodPut:
-		setContentType = true
-		if opt != nil {
+		contentType = "application/json"
+		if m, ok := opt.(*multipartBody); ok {
+			contentType = m.contentType()
+			content = retryHttp.ReaderFunc(m.reader)
+		} else if opt != nil {
 			content, err = json.Marshal(opt)
 			if err != nil {
 				return nil, fmt.Errorf("error formatting body for request: %v", err)
 			}
 		}
-	case http.MethodDelete:
-		break // no special behaviour required
 	default:
 		return nil, fmt.Errorf("method %s is not supported", method)
 	}
@@ -179,109 +229,113 @@ func (c *Client) buildRequest(method string, path string, opt interface{}) (*ret
 	if err != nil {
 		return nil, fmt.Errorf("unable to create request: %v", err)
 	}
+	req = req.WithContext(ctx)
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Cache-Control", "no-store, no-cache, must-revalidate, max-age=0, post-check=0, pre-check=0")
 	req.Header.Set("Strict-Transport-Security", "max-age=31536000 ; includeSubDomains")
 	req.Header.Set("User-Agent", c.UserAgent)
-	if setContentType {
-		req.Header.Set("Content-Type", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	return req, nil
 }
 
-func (c *Client) sendRequest(req *retryHttp.Request, o interface{}) (*http.Response, error) {
-	fmt.Printf("%s\n", req.URL.String())
+func (c *Client) sendRequest(req *retryHttp.Request, o interface{}) (*Response, error) {
 	req.SetBasicAuth(c.token, "X")
 
+	start := time.Now()
+	if c.limiter != nil {
+		if err := c.limiter.Wait(req.Context()); err != nil {
+			err = fmt.Errorf("error waiting for rate limit: %w", err)
+			c.logRequestComplete(req, nil, start, err)
+			return nil, err
+		}
+	}
+
 	res, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error sending request: %v", err)
+		err = fmt.Errorf("error sending request: %w", err)
+		c.logRequestComplete(req, nil, start, err)
+		return nil, err
 	}
 	defer res.Body.Close()
 
-	success, msg := isSuccessful(res)
-	if !success {
-		return res, fmt.Errorf(msg)
+	if success, _ := isSuccessful(res); !success {
+		err = newErrorResponse(res)
+		c.logRequestComplete(req, res, start, err)
+		return newResponse(res), err
 	}
+	c.logRequestComplete(req, res, start, nil)
 
-	if o != nil {
+	if o != nil && res.StatusCode != http.StatusNoContent {
 		if w, ok := o.(io.Writer); ok {
 			_, err = io.Copy(w, res.Body)
 		} else {
 			err = json.NewDecoder(res.Body).Decode(o)
+			if err == io.EOF {
+				err = nil // empty body
+			}
+		}
+		if err != nil {
+			return newResponse(res), fmt.Errorf("error reading response body: %w", err)
 		}
 	}
 
-	return res, err
+	return newResponse(res), nil
 }
 
-func (c *Client) Get(path string, out interface{}) (*http.Response, error) {
-	req, err := c.buildRequest(http.MethodGet, path, nil)
+func (c *Client) Get(ctx context.Context, path string, out interface{}) (*Response, error) {
+	req, err := c.buildRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating GET request for path '%s': %v", path, err)
 	}
 
-	res, err := c.sendRequest(req, &out)
-	if b, s := isSuccessful(res); !b {
-		return res, fmt.Errorf("%s: %v", s, err)
-	}
-
-	return res, nil
+	return c.sendRequest(req, out)
 }
 
-func (c *Client) List(path string, opt interface{}, out interface{}) (*http.Response, error) {
-	req, err := c.buildRequest(http.MethodGet, path, opt)
+func (c *Client) List(ctx context.Context, path string, opt interface{}, out interface{}) (*Response, error) {
+	req, err := c.buildRequest(ctx, http.MethodGet, path, opt)
 	if err != nil {
 		return nil, fmt.Errorf("error creating GET request for path '%s': %v", path, err)
 	}
 
-	res, err := c.sendRequest(req, &out)
-	if b, s := isSuccessful(res); !b {
-		return res, fmt.Errorf("%s: %v", s, err)
-	}
-
-	return res, nil
+	return c.sendRequest(req, out)
 }
 
-func (c *Client) Post(path string, body interface{}, out interface{}) (*http.Response, error) {
-	req, err := c.buildRequest(http.MethodPost, path, body)
+func (c *Client) Post(ctx context.Context, path string, body interface{}, out interface{}) (*Response, error) {
+	req, err := c.buildRequest(ctx, http.MethodPost, path, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating POST request for path '%s': %v", path, err)
 	}
 
-	res, err := c.sendRequest(req, &out)
-	if b, s := isSuccessful(res); !b {
-		return res, fmt.Errorf("%s: %v", s, err)
-	}
-
-	return res, nil
+	return c.sendRequest(req, out)
 }
 
-func (c *Client) Put(path string, body interface{}, out interface{}) (*http.Response, error) {
-	req, err := c.buildRequest(http.MethodPut, path, body)
+func (c *Client) Put(ctx context.Context, path string, body interface{}, out interface{}) (*Response, error) {
+	req, err := c.buildRequest(ctx, http.MethodPut, path, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating PUT request for path '%s': %v", path, err)
 	}
 
-	res, err := c.sendRequest(req, &out)
-	if b, s := isSuccessful(res); !b {
-		return res, fmt.Errorf("%s: %v", s, err)
-	}
+	return c.sendRequest(req, out)
+}
 
-	return res, nil
+func (c *Client) Delete(ctx context.Context, path string) (bool, *Response, error) {
+	return c.DeleteWithOptions(ctx, path, nil)
 }
 
-func (c *Client) Delete(path string) (bool, *http.Response, error) {
-	req, err := c.buildRequest(http.MethodDelete, path, nil)
+// DeleteWithOptions sends a DELETE request with opt encoded as the query string (e.g. the ids to delete)
+func (c *Client) DeleteWithOptions(ctx context.Context, path string, opt interface{}) (bool, *Response, error) {
+	req, err := c.buildRequest(ctx, http.MethodDelete, path, opt)
 	if err != nil {
 		return false, nil, fmt.Errorf("error creating DELETE request for path '%s': %v", path, err)
 	}
 
 	res, err := c.sendRequest(req, nil)
-	if b, s := isSuccessful(res); !b {
-		return false, res, fmt.Errorf("%s: %v", s, err)
+	if err != nil {
+		return false, res, err
 	}
 
 	return true, res, nil
@@ -290,14 +344,36 @@ func (c *Client) Delete(path string) (bool, *http.Response, error) {
 // buildBaseUrl sets the baseUrl based on provided subdomain
 func buildBaseUrl(subDomain string) (*url.URL, error) {
 	if strings.HasPrefix(subDomain, "http://") || strings.HasPrefix(subDomain, "https://") {
-		return url.Parse(subDomain)
+		return parseBaseUrl(subDomain)
 	}
 
 	return url.Parse(fmt.Sprintf("https://%s.freshservice.com/api/v2/", subDomain))
 }
 
+// parseBaseUrl parses a full base url, ensuring the path ends with a slash so resource paths can be appended
+func parseBaseUrl(rawUrl string) (*url.URL, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base url '%s': %v", rawUrl, err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid base url '%s': scheme and host are required", rawUrl)
+	}
+
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	return u, nil
+}
+
 // isSuccessful is a function to determine a http call executed successfully
 func isSuccessful(res *http.Response) (bool, string) {
+	if res == nil {
+		return false, "request did not return a response"
+	}
+
 	if res.StatusCode >= 200 && res.StatusCode <= 204 {
 		return true, ""
 	}
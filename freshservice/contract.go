@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -41,8 +40,8 @@ type Contract struct {
 	NotifyExpiry    bool             `json:"notify_expiry"`
 	NotifyBefore    int              `json:"notify_before"`
 	ApproverID      int              `json:"approver_id"`
-	StartDate       time.Time        `json:"start_date"`
-	EndDate         time.Time        `json:"end_date"`
+	StartDate       Date             `json:"start_date"`
+	EndDate         Date             `json:"end_date"`
 	Cost            float32          `json:"cost"`
 	Status          string           `json:"status"`
 	ContractNumber  string           `json:"contract_number"`
@@ -52,8 +51,8 @@ type Contract struct {
 	ExpiryNotified  bool             `json:"expiry_notified"`
 	RequesterID     int              `json:"requester_id"`
 	DelegateeID     int              `json:"delegatee_id"`
-	CreatedAt       time.Time        `json:"created_at"`
-	UpdatedAt       time.Time        `json:"updated_at"`
+	CreatedAt       Time             `json:"created_at"`
+	UpdatedAt       Time             `json:"updated_at"`
 	SoftwareID      int              `json:"software_id"`
 	LicenseType     string           `json:"license_type"`
 	BillingCycle    string           `json:"billing_cycle"`
@@ -63,58 +62,65 @@ type Contract struct {
 
 // CreateContractModel is the data structure required to create a new Contract
 type CreateContractModel struct {
-	Name           string    `json:"name"`
-	Description    string    `json:"description,omitempty"`
-	VendorID       int       `json:"vendor_id"`
-	AutoRenew      bool      `json:"auto_renew,omitempty"`
-	NotifyExpiry   bool      `json:"notify_expiry,omitempty"`
-	NotifyBefore   int       `json:"notify_before,omitempty"`
-	ApproverID     int       `json:"approver_id"`
-	StartDate      time.Time `json:"start_date"`
-	EndDate        time.Time `json:"end_date"`
-	Cost           float32   `json:"cost"`
-	ContractNumber string    `json:"contract_number"`
-	ContractTypeID int       `json:"contract_type_id"`
-	VisibleToID    int       `json:"visible_to_id"`
-	NotifyTo       []string  `json:"notify_to,omitempty"`
-	SoftwareID     int       `json:"software_id,omitempty"`
-	LicenseType    string    `json:"license_type,omitempty"`
-	BillingCycle   string    `json:"billing_cycle,omitempty"`
-	LicenseKey     string    `json:"license_key,omitempty"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	VendorID       int      `json:"vendor_id"`
+	AutoRenew      bool     `json:"auto_renew,omitempty"`
+	NotifyExpiry   bool     `json:"notify_expiry,omitempty"`
+	NotifyBefore   int      `json:"notify_before,omitempty"`
+	ApproverID     int      `json:"approver_id"`
+	StartDate      Date     `json:"start_date"`
+	EndDate        Date     `json:"end_date"`
+	Cost           float32  `json:"cost"`
+	ContractNumber string   `json:"contract_number"`
+	ContractTypeID int      `json:"contract_type_id"`
+	VisibleToID    int      `json:"visible_to_id"`
+	NotifyTo       []string `json:"notify_to,omitempty"`
+	SoftwareID     int      `json:"software_id,omitempty"`
+	LicenseType    string   `json:"license_type,omitempty"`
+	BillingCycle   string   `json:"billing_cycle,omitempty"`
+	LicenseKey     string   `json:"license_key,omitempty"`
 }
 
 // UpdateContractModel is the data structure required to update a Contract
 type UpdateContractModel struct {
-	Name           string    `json:"name"`
-	Description    string    `json:"description,omitempty"`
-	VendorID       int       `json:"vendor_id"`
-	AutoRenew      bool      `json:"auto_renew,omitempty"`
-	NotifyExpiry   bool      `json:"notify_expiry,omitempty"`
-	NotifyBefore   int       `json:"notify_before,omitempty"`
-	ApproverID     int       `json:"approver_id"`
-	StartDate      time.Time `json:"start_date"`
-	EndDate        time.Time `json:"end_date"`
-	Cost           float32   `json:"cost"`
-	ContractNumber string    `json:"contract_number"`
-	ContractTypeID int       `json:"contract_type_id"`
-	VisibleToID    int       `json:"visible_to_id"`
-	NotifyTo       []string  `json:"notify_to,omitempty"`
-	SoftwareID     int       `json:"software_id,omitempty"`
-	LicenseType    string    `json:"license_type,omitempty"`
-	BillingCycle   string    `json:"billing_cycle,omitempty"`
-	LicenseKey     string    `json:"license_key,omitempty"`
+	Name           *string  `json:"name,omitempty"`
+	Description    *string  `json:"description,omitempty"`
+	VendorID       *int     `json:"vendor_id,omitempty"`
+	AutoRenew      *bool    `json:"auto_renew,omitempty"`
+	NotifyExpiry   *bool    `json:"notify_expiry,omitempty"`
+	NotifyBefore   *int     `json:"notify_before,omitempty"`
+	ApproverID     *int     `json:"approver_id,omitempty"`
+	StartDate      *Date    `json:"start_date,omitempty"`
+	EndDate        *Date    `json:"end_date,omitempty"`
+	Cost           *float32 `json:"cost,omitempty"`
+	ContractNumber *string  `json:"contract_number,omitempty"`
+	ContractTypeID *int     `json:"contract_type_id,omitempty"`
+	VisibleToID    *int     `json:"visible_to_id,omitempty"`
+	NotifyTo       []string `json:"notify_to,omitempty"`
+	SoftwareID     *int     `json:"software_id,omitempty"`
+	LicenseType    *string  `json:"license_type,omitempty"`
+	BillingCycle   *string  `json:"billing_cycle,omitempty"`
+	LicenseKey     *string  `json:"license_key,omitempty"`
+	NullFields     []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateContractModel which are set, along with the NullFields as null
+func (m UpdateContractModel) MarshalJSON() ([]byte, error) {
+	type model UpdateContractModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ItemCostDetail represents a line-item cost for a Contract
 type ItemCostDetail struct {
-	ID           int       `json:"id"`
-	ItemName     string    `json:"item_name"`
-	PricingModel string    `json:"pricing_model"`
-	Cost         float32   `json:"cost"`
-	Count        int       `json:"count"`
-	Comments     string    `json:"comments"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           int     `json:"id"`
+	ItemName     string  `json:"item_name"`
+	PricingModel string  `json:"pricing_model"`
+	Cost         float32 `json:"cost"`
+	Count        int     `json:"count"`
+	Comments     string  `json:"comments"`
+	CreatedAt    Time    `json:"created_at"`
+	UpdatedAt    Time    `json:"updated_at"`
 }
 
 // ListContractsOptions is for filtering/pagination of Contracts
@@ -123,50 +129,80 @@ type ListContractsOptions struct {
 }
 
 // GetContract will return a single Contract by id
-func (s *ContractService) GetContract(id int) (*Contract, *http.Response, error) {
+func (s *ContractService) GetContract(ctx context.Context, id int) (*Contract, *Response, error) {
 	o := new(contractWrapper)
-	res, err := s.client.Get(fmt.Sprintf(contractIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(contractIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListContracts will return paginated/filtered Contracts using ListContractsOptions
-func (s *ContractService) ListContracts(opt *ListContractsOptions) (*Contracts, *http.Response, error) {
+func (s *ContractService) ListContracts(ctx context.Context, opt *ListContractsOptions) (*Contracts, *Response, error) {
 	o := new(Contracts)
-	res, err := s.client.List(contractsUrl, opt, &o)
+	res, err := s.client.List(ctx, contractsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateContract will create and return a new Contract based on CreateContractModel
-func (s *ContractService) CreateContract(contract *CreateContractModel) (*Contract, *http.Response, error) {
+func (s *ContractService) CreateContract(ctx context.Context, contract *CreateContractModel) (*Contract, *Response, error) {
 	o := new(contractWrapper)
-	res, err := s.client.Post(contractsUrl, contract, &o)
+	res, err := s.client.Post(ctx, contractsUrl, contract, &o)
 	return &o.Details, res, err
 }
 
 // UpdateContract will update and return a Contract matching id based on UpdateContractModel
-func (s *ContractService) UpdateContract(id int, contract *UpdateContractModel) (*Contract, *http.Response, error) {
+func (s *ContractService) UpdateContract(ctx context.Context, id int, contract *UpdateContractModel) (*Contract, *Response, error) {
 	o := new(contractWrapper)
-	res, err := s.client.Put(fmt.Sprintf(contractIdUrl, id), contract, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(contractIdUrl, id), contract, &o)
 	return &o.Details, res, err
 }
 
 // SubmitContractApproval allows for a Contract to be submitted for approval
-func (s *ContractService) SubmitContractApproval(id int) (bool, *http.Response, error) {
-	res, err := s.client.Put(fmt.Sprintf(contractSubmitApprovalUrl, id), nil, nil)
-	success, _ := isSuccessful(res)
+func (s *ContractService) SubmitContractApproval(ctx context.Context, id int) (bool, *Response, error) {
+	res, err := s.client.Put(ctx, fmt.Sprintf(contractSubmitApprovalUrl, id), nil, nil)
+	success := err == nil
 	return success, res, err
 }
 
 // ApproveContract allows for a Contract to be Approved
-func (s *ContractService) ApproveContract(id int) (bool, *http.Response, error) {
-	res, err := s.client.Put(fmt.Sprintf(contractApproveUrl, id), nil, nil)
-	success, _ := isSuccessful(res)
+func (s *ContractService) ApproveContract(ctx context.Context, id int) (bool, *Response, error) {
+	res, err := s.client.Put(ctx, fmt.Sprintf(contractApproveUrl, id), nil, nil)
+	success := err == nil
 	return success, res, err
 }
 
 // RejectContract rejects the Contract that was submitted for approval
-func (s *ContractService) RejectContract(id int) (bool, *http.Response, error) {
-	res, err := s.client.Put(fmt.Sprintf(contractRejectUrl, id), nil, nil)
-	success, _ := isSuccessful(res)
+func (s *ContractService) RejectContract(ctx context.Context, id int) (bool, *Response, error) {
+	res, err := s.client.Put(ctx, fmt.Sprintf(contractRejectUrl, id), nil, nil)
+	success := err == nil
 	return success, res, err
 }
+
+// IterContracts will call fn for every Contract matching ListContractsOptions, following pagination until fn returns false or a limit is reached
+func (s *ContractService) IterContracts(ctx context.Context, opt *ListContractsOptions, limit *PaginationOptions, fn func(Contract) bool) error {
+	o := ListContractsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListContracts(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllContracts will return every Contract matching ListContractsOptions by following pagination
+func (s *ContractService) ListAllContracts(ctx context.Context, opt *ListContractsOptions, limit *PaginationOptions) ([]Contract, error) {
+	var all []Contract
+	err := s.IterContracts(ctx, opt, limit, func(i Contract) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
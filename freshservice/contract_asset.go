@@ -1,8 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 )
 
 // AssociatedAssets contains Collection an array of Asset
@@ -10,8 +10,8 @@ type AssociatedAssets struct {
 	Collection []Asset `json:"associated_assets"`
 }
 
-func (s *ContractService) ListContractAssociatedAssets(id int) (*AssociatedAssets, *http.Response, error) {
+func (s *ContractService) ListContractAssociatedAssets(ctx context.Context, id int) (*AssociatedAssets, *Response, error) {
 	o := new(AssociatedAssets)
-	res, err := s.client.List(fmt.Sprintf(contractAssociatedAssetsUrl, id), nil, &o)
+	res, err := s.client.List(ctx, fmt.Sprintf(contractAssociatedAssetsUrl, id), nil, &o)
 	return o, res, err
 }
@@ -1,9 +1,6 @@
 package freshservice
 
-import (
-	"net/http"
-	"time"
-)
+import "context"
 
 // ContractTypes contains Collection an array of ContractType
 type ContractTypes struct {
@@ -12,18 +9,18 @@ type ContractTypes struct {
 
 // ContractType represents a type of Contract
 type ContractType struct {
-	ID            int       `json:"id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	NeedsApproval bool      `json:"needs_approval"`
-	IsDefault     bool      `json:"is_default"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	NeedsApproval bool   `json:"needs_approval"`
+	IsDefault     bool   `json:"is_default"`
+	CreatedAt     Time   `json:"created_at"`
+	UpdatedAt     Time   `json:"updated_at"`
 }
 
 // ListContractTypes will return ContractTypes
-func (s *ContractService) ListContractTypes() (*ContractTypes, *http.Response, error) {
+func (s *ContractService) ListContractTypes(ctx context.Context) (*ContractTypes, *Response, error) {
 	o := new(ContractTypes)
-	res, err := s.client.List(contractTypesUrl, nil, &o)
+	res, err := s.client.List(ctx, contractTypesUrl, nil, &o)
 	return o, res, err
 }
@@ -0,0 +1,180 @@
+package freshservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// CustomFields holds the instance specific fields of a resource (custom_fields / type_fields) keyed by field name
+type CustomFields map[string]interface{}
+
+// Get returns the raw value of a field, reporting whether it is present and not null
+func (c CustomFields) Get(name string) (interface{}, bool) {
+	v, ok := c[name]
+	return v, ok && v != nil
+}
+
+// GetString returns the value of a text field
+func (c CustomFields) GetString(name string) (string, bool) {
+	v, ok := c.Get(name)
+	if !ok {
+		return "", false
+	}
+
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case json.Number:
+		return s.String(), true
+	case float64, int, bool:
+		return fmt.Sprint(s), true
+	}
+
+	return "", false
+}
+
+// GetDropdown returns the selected choice of a dropdown field
+func (c CustomFields) GetDropdown(name string) (string, bool) {
+	return c.GetString(name)
+}
+
+// GetMultiSelect returns the selected choices of a multi-select field
+func (c CustomFields) GetMultiSelect(name string) ([]string, bool) {
+	v, ok := c.Get(name)
+	if !ok {
+		return nil, false
+	}
+
+	switch s := v.(type) {
+	case []string:
+		return s, true
+	case []interface{}:
+		choices := make([]string, 0, len(s))
+		for _, i := range s {
+			str, ok := i.(string)
+			if !ok {
+				return nil, false
+			}
+			choices = append(choices, str)
+		}
+		return choices, true
+	}
+
+	return nil, false
+}
+
+// GetInt returns the value of a number field
+func (c CustomFields) GetInt(name string) (int, bool) {
+	v, ok := c.Get(name)
+	if !ok {
+		return 0, false
+	}
+
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case float64:
+		return int(n), n == float64(int(n))
+	case json.Number:
+		i, err := n.Int64()
+		return int(i), err == nil
+	case string:
+		i, err := strconv.Atoi(n)
+		return i, err == nil
+	}
+
+	return 0, false
+}
+
+// GetFloat returns the value of a decimal field
+func (c CustomFields) GetFloat(name string) (float64, bool) {
+	v, ok := c.Get(name)
+	if !ok {
+		return 0, false
+	}
+
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// GetBool returns the value of a checkbox field
+func (c CustomFields) GetBool(name string) (bool, bool) {
+	v, ok := c.Get(name)
+	if !ok {
+		return false, false
+	}
+
+	switch b := v.(type) {
+	case bool:
+		return b, true
+	case string:
+		p, err := strconv.ParseBool(b)
+		return p, err == nil
+	}
+
+	return false, false
+}
+
+// GetTime returns the value of a date or date-time field
+func (c CustomFields) GetTime(name string) (time.Time, bool) {
+	v, ok := c.Get(name)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+			if p, err := time.Parse(layout, t); err == nil {
+				return p, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// Decode populates v (a pointer to a struct) from the fields, using the `json` tags of v to match field names
+func (c CustomFields) Decode(v interface{}) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("error reading custom fields: %v", err)
+	}
+
+	if err = json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("error decoding custom fields: %v", err)
+	}
+
+	return nil
+}
+
+// EncodeCustomFields creates CustomFields from v (a struct or map), using the `json` tags of v as the field names
+func EncodeCustomFields(v interface{}) (CustomFields, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding custom fields: %v", err)
+	}
+
+	c := CustomFields{}
+	if err = json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("error encoding custom fields: %v", err)
+	}
+
+	return c, nil
+}
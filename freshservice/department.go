@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -28,14 +27,14 @@ type departmentWrapper struct {
 
 // Department represents a FreshService Department (Company/Team)
 type Department struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	HeadUserId  int       `json:"head_user_id"`
-	PrimeUserId int       `json:"prime_user_id"`
-	Domains     []string  `json:"domains"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	HeadUserId  int      `json:"head_user_id"`
+	PrimeUserId int      `json:"prime_user_id"`
+	Domains     []string `json:"domains"`
+	CreatedAt   Time     `json:"created_at"`
+	UpdatedAt   Time     `json:"updated_at"`
 }
 
 // CreateDepartmentModel is the data structure required to create a new Department
@@ -49,11 +48,18 @@ type CreateDepartmentModel struct {
 
 // UpdateDepartmentModel is the data structure for updating a Department
 type UpdateDepartmentModel struct {
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	HeadUserId  int      `json:"head_user_id"`
-	PrimeUserId int      `json:"prime_user_id"`
-	Domains     []string `json:"domains"`
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	HeadUserId  *int     `json:"head_user_id,omitempty"`
+	PrimeUserId *int     `json:"prime_user_id,omitempty"`
+	Domains     []string `json:"domains,omitempty"`
+	NullFields  []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateDepartmentModel which are set, along with the NullFields as null
+func (m UpdateDepartmentModel) MarshalJSON() ([]byte, error) {
+	type model UpdateDepartmentModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListDepartmentsOptions represents filters/pagination for Departments
@@ -62,35 +68,65 @@ type ListDepartmentsOptions struct {
 }
 
 // GetDepartment will return a single Department by id
-func (s *DepartmentService) GetDepartment(id int) (*Department, *http.Response, error) {
+func (s *DepartmentService) GetDepartment(ctx context.Context, id int) (*Department, *Response, error) {
 	o := new(departmentWrapper)
-	res, err := s.client.Get(fmt.Sprintf(departmentIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(departmentIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListDepartments will return paginated/filtered Departments using ListDepartmentsOptions
-func (s *DepartmentService) ListDepartments(opt *ListDepartmentsOptions) (*Departments, *http.Response, error) {
+func (s *DepartmentService) ListDepartments(ctx context.Context, opt *ListDepartmentsOptions) (*Departments, *Response, error) {
 	o := new(Departments)
-	res, err := s.client.List(departmentsUrl, opt, &o)
+	res, err := s.client.List(ctx, departmentsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateDepartment will create and return a new Department based on CreateDepartmentModel
-func (s *DepartmentService) CreateDepartment(newDepartment *CreateDepartmentModel) (*Department, *http.Response, error) {
+func (s *DepartmentService) CreateDepartment(ctx context.Context, newDepartment *CreateDepartmentModel) (*Department, *Response, error) {
 	o := new(departmentWrapper)
-	res, err := s.client.Post(departmentsUrl, newDepartment, &o)
+	res, err := s.client.Post(ctx, departmentsUrl, newDepartment, &o)
 	return &o.Details, res, err
 }
 
 // UpdateDepartment will update and return a Department matching id based on UpdateDepartmentModel
-func (s *DepartmentService) UpdateDepartment(id int, department *UpdateDepartmentModel) (*Department, *http.Response, error) {
+func (s *DepartmentService) UpdateDepartment(ctx context.Context, id int, department *UpdateDepartmentModel) (*Department, *Response, error) {
 	o := new(departmentWrapper)
-	res, err := s.client.Put(fmt.Sprintf(departmentIdUrl, id), department, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(departmentIdUrl, id), department, &o)
 	return &o.Details, res, err
 }
 
 // DeleteDepartment will completely remove a Department from FreshService matching id
-func (s *DepartmentService) DeleteDepartment(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(departmentIdUrl, id))
+func (s *DepartmentService) DeleteDepartment(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(departmentIdUrl, id))
 	return success, res, err
 }
+
+// IterDepartments will call fn for every Department matching ListDepartmentsOptions, following pagination until fn returns false or a limit is reached
+func (s *DepartmentService) IterDepartments(ctx context.Context, opt *ListDepartmentsOptions, limit *PaginationOptions, fn func(Department) bool) error {
+	o := ListDepartmentsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListDepartments(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllDepartments will return every Department matching ListDepartmentsOptions by following pagination
+func (s *DepartmentService) ListAllDepartments(ctx context.Context, opt *ListDepartmentsOptions, limit *PaginationOptions) ([]Department, error) {
+	var all []Department
+	err := s.IterDepartments(ctx, opt, limit, func(i Department) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -0,0 +1,267 @@
+package freshservice
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// diffIgnored are the fields not reported by Diff, as they change with every update
+var diffIgnored = map[string]bool{"updated_at": true}
+
+// summaryValueLength is the length values are truncated to in a summary
+const summaryValueLength = 80
+
+var (
+	customFieldsType = reflect.TypeOf(CustomFields{})
+	diffTimeType     = reflect.TypeOf(Time{})
+	diffDateType     = reflect.TypeOf(Date{})
+)
+
+// FieldChange is a change of a field between two snapshots of a model.
+// Field is the JSON name, prefixed by the parent for custom fields (e.g. custom_fields.team) and nested structs.
+// For lists of ids or text (e.g. tags, cc_emails) Added and Removed hold the items which changed, ignoring order.
+type FieldChange struct {
+	Field   string
+	Old     interface{}
+	New     interface{}
+	Added   []interface{}
+	Removed []interface{}
+}
+
+// String describes the FieldChange e.g. "priority: Low → Urgent" or "tags: +vip -printer"
+func (c FieldChange) String() string {
+	if c.Added != nil || c.Removed != nil {
+		items := make([]string, 0, len(c.Added)+len(c.Removed))
+		for _, v := range c.Added {
+			items = append(items, "+"+summaryValue(v))
+		}
+		for _, v := range c.Removed {
+			items = append(items, "-"+summaryValue(v))
+		}
+		return fmt.Sprintf("%s: %s", c.Field, strings.Join(items, " "))
+	}
+	return fmt.Sprintf("%s: %s → %s", c.Field, summaryValue(c.Old), summaryValue(c.New))
+}
+
+// FieldChanges are the changes between two snapshots of a model, ordered by field
+type FieldChanges []FieldChange
+
+// Get returns the FieldChange of field (by JSON name)
+func (c FieldChanges) Get(field string) (FieldChange, bool) {
+	for _, fc := range c {
+		if fc.Field == field {
+			return fc, true
+		}
+	}
+	return FieldChange{}, false
+}
+
+// Has reports whether field (by JSON name) has changed
+func (c FieldChanges) Has(field string) bool {
+	_, ok := c.Get(field)
+	return ok
+}
+
+// Summary describes the changes with a line per field, suitable for notifications
+func (c FieldChanges) Summary() string {
+	lines := make([]string, 0, len(c))
+	for _, fc := range c {
+		lines = append(lines, fc.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diff returns the changes between two snapshots of a model (e.g. a Ticket, Change, Problem, Release, Asset or Agent),
+// old and new must be of the same struct type or pointers to it. updated_at is not reported.
+func Diff(old interface{}, new interface{}) (FieldChanges, error) {
+	ov, nv := reflect.ValueOf(old), reflect.ValueOf(new)
+	if !ov.IsValid() || !nv.IsValid() {
+		return nil, fmt.Errorf("unable to diff nil")
+	}
+	if ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("unable to diff %T with %T", old, new)
+	}
+
+	for ov.Kind() == reflect.Ptr {
+		if ov.IsNil() || nv.IsNil() {
+			return nil, fmt.Errorf("unable to diff nil %T", old)
+		}
+		ov, nv = ov.Elem(), nv.Elem()
+	}
+	if ov.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unable to diff %T, expected a struct", old)
+	}
+
+	var changes FieldChanges
+	diffStruct(&changes, "", ov, nv)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+// diffStruct adds the changes of the fields of two structs of the same type
+func diffStruct(changes *FieldChanges, prefix string, ov reflect.Value, nv reflect.Value) {
+	t := ov.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if prefix == "" && diffIgnored[name] {
+			continue
+		}
+
+		diffValue(changes, prefix+name, ov.Field(i), nv.Field(i))
+	}
+}
+
+// diffValue adds the changes of a field
+func diffValue(changes *FieldChanges, field string, ov reflect.Value, nv reflect.Value) {
+	switch {
+	case ov.Type() == diffTimeType:
+		o, n := ov.Interface().(Time), nv.Interface().(Time)
+		if !o.Equal(n.Time) {
+			*changes = append(*changes, FieldChange{Field: field, Old: o, New: n})
+		}
+		return
+
+	case ov.Type() == diffDateType:
+		o, n := ov.Interface().(Date), nv.Interface().(Date)
+		if !o.Equal(n.Time) {
+			*changes = append(*changes, FieldChange{Field: field, Old: o, New: n})
+		}
+		return
+
+	case ov.Type() == customFieldsType:
+		diffCustomFields(changes, field, ov.Interface().(CustomFields), nv.Interface().(CustomFields))
+		return
+	}
+
+	switch ov.Kind() {
+	case reflect.Struct:
+		diffStruct(changes, field+".", ov, nv)
+		return
+
+	case reflect.Slice:
+		if isScalar(ov.Type().Elem()) {
+			diffSet(changes, field, ov, nv)
+			return
+		}
+		if ov.Len() == 0 && nv.Len() == 0 {
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(ov.Interface(), nv.Interface()) {
+		*changes = append(*changes, FieldChange{Field: field, Old: ov.Interface(), New: nv.Interface()})
+	}
+}
+
+// diffCustomFields adds the changes of each custom field
+func diffCustomFields(changes *FieldChanges, field string, old CustomFields, new CustomFields) {
+	keys := map[string]bool{}
+	for k := range old {
+		keys[k] = true
+	}
+	for k := range new {
+		keys[k] = true
+	}
+
+	for k := range keys {
+		o, n := old[k], new[k]
+		if !reflect.DeepEqual(o, n) {
+			*changes = append(*changes, FieldChange{Field: field + "." + k, Old: o, New: n})
+		}
+	}
+}
+
+// diffSet adds the items added to and removed from a list, ignoring their order
+func diffSet(changes *FieldChanges, field string, ov reflect.Value, nv reflect.Value) {
+	added := missing(nv, ov)
+	removed := missing(ov, nv)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	*changes = append(*changes, FieldChange{
+		Field:   field,
+		Old:     ov.Interface(),
+		New:     nv.Interface(),
+		Added:   added,
+		Removed: removed,
+	})
+}
+
+// missing returns the items of a which are not in b
+func missing(a reflect.Value, b reflect.Value) []interface{} {
+	in := make(map[interface{}]bool, b.Len())
+	for i := 0; i < b.Len(); i++ {
+		in[b.Index(i).Interface()] = true
+	}
+
+	items := []interface{}{}
+	for i := 0; i < a.Len(); i++ {
+		if v := a.Index(i).Interface(); !in[v] {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// summaryValue formats a value for a summary, empty values are shown as "none" and long text is truncated
+func summaryValue(v interface{}) string {
+	var s string
+	switch val := v.(type) {
+	case nil:
+		return "none"
+	case Time:
+		if val.IsZero() {
+			return "none"
+		}
+		s = val.Format("2006-01-02 15:04 MST")
+	case Date:
+		if val.IsZero() {
+			return "none"
+		}
+		s = val.Format(dateLayout)
+	case fmt.Stringer:
+		s = val.String()
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Slice && rv.Len() == 0 {
+			return "none"
+		}
+		s = fmt.Sprint(v)
+	}
+
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return "none"
+	}
+	if utf8.RuneCountInString(s) > summaryValueLength {
+		s = string([]rune(s)[:summaryValueLength-1]) + "…"
+	}
+	return s
+}
@@ -0,0 +1,151 @@
+package freshservice_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+func TestDiff(t *testing.T) {
+	created := freshservice.NewTime(time.Date(2021, 7, 1, 9, 0, 0, 0, time.UTC))
+	base := freshservice.Ticket{
+		ID:           1,
+		Subject:      "Printer on fire",
+		Priority:     freshservice.PriorityLow,
+		Status:       freshservice.TicketOpen,
+		Tags:         []string{"printer", "office"},
+		CustomFields: freshservice.CustomFields{"team": "Ops"},
+		CreatedAt:    created,
+		UpdatedAt:    created,
+	}
+
+	tests := []struct {
+		name        string
+		update      func(t *freshservice.Ticket)
+		wantFields  []string
+		wantSummary string
+	}{
+		{name: "no changes", update: func(*freshservice.Ticket) {}},
+		{
+			name:        "updated_at is ignored",
+			update:      func(t *freshservice.Ticket) { t.UpdatedAt = freshservice.NewTime(time.Now()) },
+			wantFields:  nil,
+			wantSummary: "",
+		},
+		{
+			name:        "enum",
+			update:      func(t *freshservice.Ticket) { t.Priority = freshservice.PriorityUrgent },
+			wantFields:  []string{"priority"},
+			wantSummary: "priority: Low → Urgent",
+		},
+		{
+			name: "ordered by field",
+			update: func(t *freshservice.Ticket) {
+				t.Subject = "Printer still on fire"
+				t.Status = freshservice.TicketPending
+			},
+			wantFields:  []string{"status", "subject"},
+			wantSummary: "status: Open → Pending\nsubject: Printer on fire → Printer still on fire",
+		},
+		{
+			name:       "tags ignore order",
+			update:     func(t *freshservice.Ticket) { t.Tags = []string{"office", "printer"} },
+			wantFields: nil,
+		},
+		{
+			name:        "tags added and removed",
+			update:      func(t *freshservice.Ticket) { t.Tags = []string{"office", "vip"} },
+			wantFields:  []string{"tags"},
+			wantSummary: "tags: +vip -printer",
+		},
+		{
+			name:        "custom fields",
+			update:      func(t *freshservice.Ticket) { t.CustomFields = freshservice.CustomFields{"team": "Dev", "site": "HQ"} },
+			wantFields:  []string{"custom_fields.site", "custom_fields.team"},
+			wantSummary: "custom_fields.site: none → HQ\ncustom_fields.team: Ops → Dev",
+		},
+		{
+			name: "time",
+			update: func(t *freshservice.Ticket) {
+				t.DueBy = freshservice.NewTime(time.Date(2021, 7, 2, 17, 0, 0, 0, time.UTC))
+			},
+			wantFields:  []string{"due_by"},
+			wantSummary: "due_by: none → 2021-07-02 17:00 UTC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := base
+			old.Tags = append([]string(nil), base.Tags...)
+			old.CustomFields = freshservice.CustomFields{"team": "Ops"}
+			updated := old
+			tt.update(&updated)
+
+			changes, err := freshservice.Diff(&old, &updated)
+			if err != nil {
+				t.Fatalf("Diff: %v", err)
+			}
+
+			var fields []string
+			for _, c := range changes {
+				fields = append(fields, c.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("got changed fields %v, want %v", fields, tt.wantFields)
+			}
+			if got := changes.Summary(); got != tt.wantSummary {
+				t.Errorf("got summary %q, want %q", got, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	old := freshservice.Ticket{Tags: []string{"printer", "office"}}
+	updated := freshservice.Ticket{Tags: []string{"office", "vip"}}
+
+	changes, err := freshservice.Diff(old, updated)
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+
+	c, ok := changes.Get("tags")
+	if !ok {
+		t.Fatalf("tags are not reported as changed: %v", changes)
+	}
+	if !reflect.DeepEqual(c.Added, []interface{}{"vip"}) || !reflect.DeepEqual(c.Removed, []interface{}{"printer"}) {
+		t.Errorf("got added %v removed %v, want added [vip] removed [printer]", c.Added, c.Removed)
+	}
+	if changes.Has("subject") {
+		t.Errorf("subject is reported as changed")
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	var nilTicket *freshservice.Ticket
+
+	tests := []struct {
+		name    string
+		old     interface{}
+		new     interface{}
+		wantErr string
+	}{
+		{name: "nil", old: nil, new: freshservice.Ticket{}, wantErr: "unable to diff nil"},
+		{name: "nil pointer", old: nilTicket, new: &freshservice.Ticket{}, wantErr: "unable to diff nil"},
+		{name: "different types", old: freshservice.Ticket{}, new: freshservice.Change{}, wantErr: "unable to diff freshservice.Ticket with freshservice.Change"},
+		{name: "not a struct", old: 1, new: 2, wantErr: "expected a struct"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := freshservice.Diff(tt.old, tt.new)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
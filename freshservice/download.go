@@ -0,0 +1,123 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+
+	retryHttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// ProgressFunc is called as a download is written, total is -1 when the size is unknown
+type ProgressFunc func(written int64, total int64)
+
+// progressWriter reports the bytes written to the underlying io.Writer through a ProgressFunc
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	if p.progress != nil {
+		p.progress(p.written, p.total)
+	}
+	return n, err
+}
+
+// download streams the file at rawUrl to w, following any redirects. The API key is only sent when rawUrl is on the
+// same host as the API, as attachments are usually served from pre-signed storage URLs.
+// When expectedType or expectedSize are provided the response is verified against them.
+func (c *Client) download(ctx context.Context, rawUrl string, expectedType string, expectedSize int64, w io.Writer, progress ProgressFunc) (*Response, error) {
+	if ctx == nil {
+		ctx = c.ctx
+	}
+
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid download url: %v", err)
+	}
+
+	req, err := retryHttp.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	start := time.Now()
+	if u.Host == c.baseUrl.Host {
+		req.SetBasicAuth(c.token, "X")
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				err = fmt.Errorf("error waiting for rate limit: %w", err)
+				c.logRequestComplete(req, nil, start, err)
+				return nil, err
+			}
+		}
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		err = fmt.Errorf("error sending request: %w", err)
+		c.logRequestComplete(req, nil, start, err)
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if success, _ := isSuccessful(res); !success {
+		err = newErrorResponse(res)
+		c.logRequestComplete(req, res, start, err)
+		return newResponse(res), err
+	}
+
+	if err = checkContentType(expectedType, res.Header.Get("Content-Type")); err != nil {
+		c.logRequestComplete(req, res, start, err)
+		return newResponse(res), err
+	}
+
+	total := res.ContentLength
+	if expectedSize > 0 {
+		total = expectedSize
+	}
+
+	pw := &progressWriter{w: w, total: total, progress: progress}
+	if _, err = io.Copy(pw, res.Body); err != nil {
+		err = fmt.Errorf("error writing download: %w", err)
+	} else if expectedSize > 0 && pw.written != expectedSize {
+		err = fmt.Errorf("downloaded %d bytes but expected %d", pw.written, expectedSize)
+	}
+
+	c.logRequestComplete(req, res, start, err)
+	return newResponse(res), err
+}
+
+// checkContentType compares the media types (ignoring parameters), generic binary responses are accepted for any type
+func checkContentType(expected string, actual string) error {
+	if expected == "" || actual == "" {
+		return nil
+	}
+
+	e, _, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return nil
+	}
+
+	a, _, err := mime.ParseMediaType(actual)
+	if err != nil || a == "application/octet-stream" || a == "binary/octet-stream" {
+		return nil
+	}
+
+	if a != e {
+		return fmt.Errorf("downloaded content type %s but expected %s", a, e)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package freshservice_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+func TestDownloadAttachment(t *testing.T) {
+	png := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		status      int
+		attachment  freshservice.TicketAttachment
+		wantErr     string
+	}{
+		{name: "matching", contentType: "image/png", body: png, attachment: freshservice.TicketAttachment{Size: 8, ContentType: "image/png"}},
+		{name: "parameters are ignored", contentType: "image/png; name=logo.png", body: png, attachment: freshservice.TicketAttachment{Size: 8, ContentType: "image/png"}},
+		{name: "octet-stream is accepted", contentType: "application/octet-stream", body: png, attachment: freshservice.TicketAttachment{Size: 8, ContentType: "image/png"}},
+		{name: "unknown size and type", contentType: "image/png", body: png},
+		{
+			name:        "short download",
+			contentType: "image/png",
+			body:        png[:4],
+			attachment:  freshservice.TicketAttachment{Size: 8, ContentType: "image/png"},
+			wantErr:     "downloaded 4 bytes but expected 8",
+		},
+		{
+			name:        "other content type",
+			contentType: "text/html",
+			body:        []byte("<html>login</html>"),
+			attachment:  freshservice.TicketAttachment{Size: 18, ContentType: "image/png"},
+			wantErr:     "downloaded content type text/html but expected image/png",
+		},
+		{
+			name:       "expired url",
+			status:     http.StatusForbidden,
+			body:       []byte(`{"message": "Request has expired"}`),
+			attachment: freshservice.TicketAttachment{Size: 8, ContentType: "image/png"},
+			wantErr:    "Request has expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var auth bool
+			storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _, auth = r.BasicAuth()
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				w.Write(tt.body)
+			}))
+			defer storage.Close()
+
+			fs, err := freshservice.NewClient(nil, "acme", "key", freshservice.WithRetryPolicy(0, 0, 0))
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			attachment := tt.attachment
+			attachment.AttachmentUrl = storage.URL + "/logo.png?X-Amz-Signature=abc"
+
+			var buf bytes.Buffer
+			var progress []int64
+			_, err = fs.Tickets.DownloadAttachment(context.Background(), &attachment, &buf, func(written int64, total int64) {
+				progress = append(progress, written)
+			})
+			if auth {
+				t.Errorf("the API key was sent to another host")
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DownloadAttachment: %v", err)
+			}
+			if !bytes.Equal(buf.Bytes(), png) {
+				t.Errorf("got %x, want %x", buf.Bytes(), png)
+			}
+			if len(progress) == 0 || progress[len(progress)-1] != int64(len(png)) {
+				t.Errorf("got progress %v, want it to end at %d", progress, len(png))
+			}
+		})
+	}
+}
+
+func TestDownloadAttachmentAuthenticatesOnTheAPIHost(t *testing.T) {
+	var key string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, _, _ = r.BasicAuth()
+		w.Write([]byte("notes"))
+	}))
+	defer ts.Close()
+
+	fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL(ts.URL+"/api/v2"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	var buf bytes.Buffer
+	attachment := &freshservice.TicketAttachment{AttachmentUrl: ts.URL + "/api/v2/attachments/1"}
+	if _, err = fs.Tickets.DownloadAttachment(context.Background(), attachment, &buf, nil); err != nil {
+		t.Fatalf("DownloadAttachment: %v", err)
+	}
+	if key != "key" {
+		t.Errorf("got API key %q, want it to be sent to the API host", key)
+	}
+
+	if _, err = fs.Tickets.DownloadAttachment(context.Background(), &freshservice.TicketAttachment{}, &buf, nil); err == nil {
+		t.Errorf("downloaded an attachment without a url")
+	}
+}
@@ -0,0 +1,937 @@
+package freshservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The enums below are sent to and received from FreshService as numbers, their text form (used by MarshalText and
+// UnmarshalText, e.g. for config files) is the lower case name such as "urgent" or "in_progress".
+// Decoding from JSON accepts either form, any number is accepted so that custom values (e.g. custom ticket statuses)
+// can still be read, use Valid to check a value is one of the known constants. Names which are not known fail to decode.
+
+// enumNames maps the values of an enum to their display names
+type enumNames map[int]string
+
+// String returns the display name of v, or kind(v) when it is unknown
+func (n enumNames) String(kind string, v int) string {
+	if name, ok := n[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", kind, v)
+}
+
+// valid reports whether v is a known value
+func (n enumNames) valid(v int) bool {
+	_, ok := n[v]
+	return ok
+}
+
+// marshalText returns the text form of v, failing when it is unknown
+func (n enumNames) marshalText(kind string, v int) ([]byte, error) {
+	name, ok := n[v]
+	if !ok {
+		return nil, fmt.Errorf("invalid %s %d", kind, v)
+	}
+	return []byte(normalizeEnumName(name, "_")), nil
+}
+
+// parse returns the value matching s by name (ignoring case, spaces, dashes and underscores) or number
+func (n enumNames) parse(kind string, s string) (int, error) {
+	key := normalizeEnumName(s, "")
+	for v, name := range n {
+		if normalizeEnumName(name, "") == key {
+			return v, nil
+		}
+	}
+
+	if v, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && n.valid(v) {
+		return v, nil
+	}
+
+	return 0, fmt.Errorf("invalid %s %q, expected one of %s", kind, s, strings.Join(n.names(), ", "))
+}
+
+// unmarshalJSON accepts a number (also as a string), a name or null
+func (n enumNames) unmarshalJSON(kind string, b []byte) (int, error) {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		return 0, nil
+	}
+
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return 0, err
+		}
+		if v, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+			return v, nil
+		}
+		return n.parse(kind, s)
+	}
+
+	var v int
+	if err := json.Unmarshal(b, &v); err != nil {
+		return 0, fmt.Errorf("invalid %s %s", kind, b)
+	}
+	return v, nil
+}
+
+// names returns the text forms of the known values, ordered by value
+func (n enumNames) names() []string {
+	values := make([]int, 0, len(n))
+	for v := range n {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+
+	names := make([]string, 0, len(values))
+	for _, v := range values {
+		names = append(names, normalizeEnumName(n[v], "_"))
+	}
+	return names
+}
+
+// normalizeEnumName lower cases name, joining words with sep
+func normalizeEnumName(name string, sep string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), sep)
+}
+
+// TicketStatus represents the status of a Ticket, Tickets may also use custom statuses which are not Valid
+type TicketStatus int
+
+const (
+	TicketOpen     TicketStatus = 2
+	TicketPending  TicketStatus = 3
+	TicketResolved TicketStatus = 4
+	TicketClosed   TicketStatus = 5
+)
+
+var ticketStatusNames = enumNames{
+	int(TicketOpen):     "Open",
+	int(TicketPending):  "Pending",
+	int(TicketResolved): "Resolved",
+	int(TicketClosed):   "Closed",
+}
+
+// String returns the display name of the TicketStatus
+func (t TicketStatus) String() string {
+	return ticketStatusNames.String("TicketStatus", int(t))
+}
+
+// Valid reports whether the TicketStatus is one of the known values
+func (t TicketStatus) Valid() bool {
+	return ticketStatusNames.valid(int(t))
+}
+
+// Ptr returns a pointer to the TicketStatus, for setting a field of an update model
+func (t TicketStatus) Ptr() *TicketStatus {
+	return &t
+}
+
+// MarshalText returns the lower case name of the TicketStatus
+func (t TicketStatus) MarshalText() ([]byte, error) {
+	return ticketStatusNames.marshalText("TicketStatus", int(t))
+}
+
+// UnmarshalText parses a TicketStatus from its name or number
+func (t *TicketStatus) UnmarshalText(b []byte) error {
+	v, err := ticketStatusNames.parse("TicketStatus", string(b))
+	if err != nil {
+		return err
+	}
+	*t = TicketStatus(v)
+	return nil
+}
+
+// MarshalJSON sends the TicketStatus as a number, as expected by FreshService
+func (t TicketStatus) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(t))), nil
+}
+
+// UnmarshalJSON reads a TicketStatus from a number or name
+func (t *TicketStatus) UnmarshalJSON(b []byte) error {
+	v, err := ticketStatusNames.unmarshalJSON("TicketStatus", b)
+	if err != nil {
+		return err
+	}
+	*t = TicketStatus(v)
+	return nil
+}
+
+// TicketSource represents the channel through which a Ticket was created
+type TicketSource int
+
+const (
+	SourceEmail         TicketSource = 1
+	SourcePortal        TicketSource = 2
+	SourcePhone         TicketSource = 3
+	SourceChat          TicketSource = 4
+	SourceWidget        TicketSource = 5
+	SourceYammer        TicketSource = 6
+	SourceAwsCloudWatch TicketSource = 7
+	SourcePagerDuty     TicketSource = 8
+	SourceWalkUp        TicketSource = 9
+	SourceSlack         TicketSource = 10
+)
+
+var ticketSourceNames = enumNames{
+	int(SourceEmail):         "Email",
+	int(SourcePortal):        "Portal",
+	int(SourcePhone):         "Phone",
+	int(SourceChat):          "Chat",
+	int(SourceWidget):        "Widget",
+	int(SourceYammer):        "Yammer",
+	int(SourceAwsCloudWatch): "AWS CloudWatch",
+	int(SourcePagerDuty):     "PagerDuty",
+	int(SourceWalkUp):        "Walk-up",
+	int(SourceSlack):         "Slack",
+}
+
+// String returns the display name of the TicketSource
+func (t TicketSource) String() string {
+	return ticketSourceNames.String("TicketSource", int(t))
+}
+
+// Valid reports whether the TicketSource is one of the known values
+func (t TicketSource) Valid() bool {
+	return ticketSourceNames.valid(int(t))
+}
+
+// Ptr returns a pointer to the TicketSource, for setting a field of an update model
+func (t TicketSource) Ptr() *TicketSource {
+	return &t
+}
+
+// MarshalText returns the lower case name of the TicketSource
+func (t TicketSource) MarshalText() ([]byte, error) {
+	return ticketSourceNames.marshalText("TicketSource", int(t))
+}
+
+// UnmarshalText parses a TicketSource from its name or number
+func (t *TicketSource) UnmarshalText(b []byte) error {
+	v, err := ticketSourceNames.parse("TicketSource", string(b))
+	if err != nil {
+		return err
+	}
+	*t = TicketSource(v)
+	return nil
+}
+
+// MarshalJSON sends the TicketSource as a number, as expected by FreshService
+func (t TicketSource) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(t))), nil
+}
+
+// UnmarshalJSON reads a TicketSource from a number or name
+func (t *TicketSource) UnmarshalJSON(b []byte) error {
+	v, err := ticketSourceNames.unmarshalJSON("TicketSource", b)
+	if err != nil {
+		return err
+	}
+	*t = TicketSource(v)
+	return nil
+}
+
+// ConversationSource represents the kind of a Conversation of a Ticket
+type ConversationSource int
+
+const (
+	ConversationEmail        ConversationSource = 0
+	ConversationForm         ConversationSource = 1
+	ConversationNote         ConversationSource = 2
+	ConversationStatus       ConversationSource = 3
+	ConversationMeta         ConversationSource = 4
+	ConversationFeedback     ConversationSource = 5
+	ConversationForwardEmail ConversationSource = 6
+)
+
+var conversationSourceNames = enumNames{
+	int(ConversationEmail):        "Email",
+	int(ConversationForm):         "Form",
+	int(ConversationNote):         "Note",
+	int(ConversationStatus):       "Status",
+	int(ConversationMeta):         "Meta",
+	int(ConversationFeedback):     "Feedback",
+	int(ConversationForwardEmail): "Forward Email",
+}
+
+// String returns the display name of the ConversationSource
+func (c ConversationSource) String() string {
+	return conversationSourceNames.String("ConversationSource", int(c))
+}
+
+// Valid reports whether the ConversationSource is one of the known values
+func (c ConversationSource) Valid() bool {
+	return conversationSourceNames.valid(int(c))
+}
+
+// Ptr returns a pointer to the ConversationSource, for setting a field of an update model
+func (c ConversationSource) Ptr() *ConversationSource {
+	return &c
+}
+
+// MarshalText returns the lower case name of the ConversationSource
+func (c ConversationSource) MarshalText() ([]byte, error) {
+	return conversationSourceNames.marshalText("ConversationSource", int(c))
+}
+
+// UnmarshalText parses a ConversationSource from its name or number
+func (c *ConversationSource) UnmarshalText(b []byte) error {
+	v, err := conversationSourceNames.parse("ConversationSource", string(b))
+	if err != nil {
+		return err
+	}
+	*c = ConversationSource(v)
+	return nil
+}
+
+// MarshalJSON sends the ConversationSource as a number, as expected by FreshService
+func (c ConversationSource) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalJSON reads a ConversationSource from a number or name
+func (c *ConversationSource) UnmarshalJSON(b []byte) error {
+	v, err := conversationSourceNames.unmarshalJSON("ConversationSource", b)
+	if err != nil {
+		return err
+	}
+	*c = ConversationSource(v)
+	return nil
+}
+
+// Priority represents the priority of a Ticket, Change, Problem or Release
+type Priority int
+
+const (
+	PriorityLow           Priority = 1
+	PriorityMedium        Priority = 2
+	PriorityHigh          Priority = 3
+	PriorityUrgent        Priority = 4
+	ChangePriorityLow     Priority = PriorityLow
+	ChangePriorityMedium  Priority = PriorityMedium
+	ChangePriorityHigh    Priority = PriorityHigh
+	ChangePriorityUrgent  Priority = PriorityUrgent
+	ReleasePriorityLow    Priority = PriorityLow
+	ReleasePriorityMedium Priority = PriorityMedium
+	ReleasePriorityHigh   Priority = PriorityHigh
+	ReleasePriorityUrgent Priority = PriorityUrgent
+)
+
+var priorityNames = enumNames{
+	int(PriorityLow):    "Low",
+	int(PriorityMedium): "Medium",
+	int(PriorityHigh):   "High",
+	int(PriorityUrgent): "Urgent",
+}
+
+// String returns the display name of the Priority
+func (p Priority) String() string {
+	return priorityNames.String("Priority", int(p))
+}
+
+// Valid reports whether the Priority is one of the known values
+func (p Priority) Valid() bool {
+	return priorityNames.valid(int(p))
+}
+
+// Ptr returns a pointer to the Priority, for setting a field of an update model
+func (p Priority) Ptr() *Priority {
+	return &p
+}
+
+// MarshalText returns the lower case name of the Priority
+func (p Priority) MarshalText() ([]byte, error) {
+	return priorityNames.marshalText("Priority", int(p))
+}
+
+// UnmarshalText parses a Priority from its name or number
+func (p *Priority) UnmarshalText(b []byte) error {
+	v, err := priorityNames.parse("Priority", string(b))
+	if err != nil {
+		return err
+	}
+	*p = Priority(v)
+	return nil
+}
+
+// MarshalJSON sends the Priority as a number, as expected by FreshService
+func (p Priority) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(p))), nil
+}
+
+// UnmarshalJSON reads a Priority from a number or name
+func (p *Priority) UnmarshalJSON(b []byte) error {
+	v, err := priorityNames.unmarshalJSON("Priority", b)
+	if err != nil {
+		return err
+	}
+	*p = Priority(v)
+	return nil
+}
+
+// Urgency represents the urgency of a Ticket
+type Urgency int
+
+const (
+	UrgencyLow    Urgency = 1
+	UrgencyMedium Urgency = 2
+	UrgencyHigh   Urgency = 3
+)
+
+var urgencyNames = enumNames{
+	int(UrgencyLow):    "Low",
+	int(UrgencyMedium): "Medium",
+	int(UrgencyHigh):   "High",
+}
+
+// String returns the display name of the Urgency
+func (u Urgency) String() string {
+	return urgencyNames.String("Urgency", int(u))
+}
+
+// Valid reports whether the Urgency is one of the known values
+func (u Urgency) Valid() bool {
+	return urgencyNames.valid(int(u))
+}
+
+// Ptr returns a pointer to the Urgency, for setting a field of an update model
+func (u Urgency) Ptr() *Urgency {
+	return &u
+}
+
+// MarshalText returns the lower case name of the Urgency
+func (u Urgency) MarshalText() ([]byte, error) {
+	return urgencyNames.marshalText("Urgency", int(u))
+}
+
+// UnmarshalText parses a Urgency from its name or number
+func (u *Urgency) UnmarshalText(b []byte) error {
+	v, err := urgencyNames.parse("Urgency", string(b))
+	if err != nil {
+		return err
+	}
+	*u = Urgency(v)
+	return nil
+}
+
+// MarshalJSON sends the Urgency as a number, as expected by FreshService
+func (u Urgency) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(u))), nil
+}
+
+// UnmarshalJSON reads a Urgency from a number or name
+func (u *Urgency) UnmarshalJSON(b []byte) error {
+	v, err := urgencyNames.unmarshalJSON("Urgency", b)
+	if err != nil {
+		return err
+	}
+	*u = Urgency(v)
+	return nil
+}
+
+// Impact represents the impact of a Ticket, Change or Problem
+type Impact int
+
+const (
+	ImpactLow          Impact = 1
+	ImpactMedium       Impact = 2
+	ImpactHigh         Impact = 3
+	ChangeImpactLow    Impact = ImpactLow
+	ChangeImpactMedium Impact = ImpactMedium
+	ChangeImpactHigh   Impact = ImpactHigh
+)
+
+var impactNames = enumNames{
+	int(ImpactLow):    "Low",
+	int(ImpactMedium): "Medium",
+	int(ImpactHigh):   "High",
+}
+
+// String returns the display name of the Impact
+func (i Impact) String() string {
+	return impactNames.String("Impact", int(i))
+}
+
+// Valid reports whether the Impact is one of the known values
+func (i Impact) Valid() bool {
+	return impactNames.valid(int(i))
+}
+
+// Ptr returns a pointer to the Impact, for setting a field of an update model
+func (i Impact) Ptr() *Impact {
+	return &i
+}
+
+// MarshalText returns the lower case name of the Impact
+func (i Impact) MarshalText() ([]byte, error) {
+	return impactNames.marshalText("Impact", int(i))
+}
+
+// UnmarshalText parses a Impact from its name or number
+func (i *Impact) UnmarshalText(b []byte) error {
+	v, err := impactNames.parse("Impact", string(b))
+	if err != nil {
+		return err
+	}
+	*i = Impact(v)
+	return nil
+}
+
+// MarshalJSON sends the Impact as a number, as expected by FreshService
+func (i Impact) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(i))), nil
+}
+
+// UnmarshalJSON reads a Impact from a number or name
+func (i *Impact) UnmarshalJSON(b []byte) error {
+	v, err := impactNames.unmarshalJSON("Impact", b)
+	if err != nil {
+		return err
+	}
+	*i = Impact(v)
+	return nil
+}
+
+// ChangeStatus represents the status of a Change
+type ChangeStatus int
+
+const (
+	ChangeStatusOpen           ChangeStatus = 1
+	ChangeStatusPlanning       ChangeStatus = 2
+	ChangeStatusApproval       ChangeStatus = 3
+	ChangeStatusPendingRelease ChangeStatus = 4
+	ChangeStatusPendingReview  ChangeStatus = 5
+	ChangeStatusClosed         ChangeStatus = 6
+)
+
+var changeStatusNames = enumNames{
+	int(ChangeStatusOpen):           "Open",
+	int(ChangeStatusPlanning):       "Planning",
+	int(ChangeStatusApproval):       "Awaiting Approval",
+	int(ChangeStatusPendingRelease): "Pending Release",
+	int(ChangeStatusPendingReview):  "Pending Review",
+	int(ChangeStatusClosed):         "Closed",
+}
+
+// String returns the display name of the ChangeStatus
+func (c ChangeStatus) String() string {
+	return changeStatusNames.String("ChangeStatus", int(c))
+}
+
+// Valid reports whether the ChangeStatus is one of the known values
+func (c ChangeStatus) Valid() bool {
+	return changeStatusNames.valid(int(c))
+}
+
+// Ptr returns a pointer to the ChangeStatus, for setting a field of an update model
+func (c ChangeStatus) Ptr() *ChangeStatus {
+	return &c
+}
+
+// MarshalText returns the lower case name of the ChangeStatus
+func (c ChangeStatus) MarshalText() ([]byte, error) {
+	return changeStatusNames.marshalText("ChangeStatus", int(c))
+}
+
+// UnmarshalText parses a ChangeStatus from its name or number
+func (c *ChangeStatus) UnmarshalText(b []byte) error {
+	v, err := changeStatusNames.parse("ChangeStatus", string(b))
+	if err != nil {
+		return err
+	}
+	*c = ChangeStatus(v)
+	return nil
+}
+
+// MarshalJSON sends the ChangeStatus as a number, as expected by FreshService
+func (c ChangeStatus) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalJSON reads a ChangeStatus from a number or name
+func (c *ChangeStatus) UnmarshalJSON(b []byte) error {
+	v, err := changeStatusNames.unmarshalJSON("ChangeStatus", b)
+	if err != nil {
+		return err
+	}
+	*c = ChangeStatus(v)
+	return nil
+}
+
+// ChangeType represents the type of a Change
+type ChangeType int
+
+const (
+	ChangeTypeMinor     ChangeType = 1
+	ChangeTypeStandard  ChangeType = 2
+	ChangeTypeMajor     ChangeType = 3
+	ChangeTypeEmergency ChangeType = 4
+)
+
+var changeTypeNames = enumNames{
+	int(ChangeTypeMinor):     "Minor",
+	int(ChangeTypeStandard):  "Standard",
+	int(ChangeTypeMajor):     "Major",
+	int(ChangeTypeEmergency): "Emergency",
+}
+
+// String returns the display name of the ChangeType
+func (c ChangeType) String() string {
+	return changeTypeNames.String("ChangeType", int(c))
+}
+
+// Valid reports whether the ChangeType is one of the known values
+func (c ChangeType) Valid() bool {
+	return changeTypeNames.valid(int(c))
+}
+
+// Ptr returns a pointer to the ChangeType, for setting a field of an update model
+func (c ChangeType) Ptr() *ChangeType {
+	return &c
+}
+
+// MarshalText returns the lower case name of the ChangeType
+func (c ChangeType) MarshalText() ([]byte, error) {
+	return changeTypeNames.marshalText("ChangeType", int(c))
+}
+
+// UnmarshalText parses a ChangeType from its name or number
+func (c *ChangeType) UnmarshalText(b []byte) error {
+	v, err := changeTypeNames.parse("ChangeType", string(b))
+	if err != nil {
+		return err
+	}
+	*c = ChangeType(v)
+	return nil
+}
+
+// MarshalJSON sends the ChangeType as a number, as expected by FreshService
+func (c ChangeType) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalJSON reads a ChangeType from a number or name
+func (c *ChangeType) UnmarshalJSON(b []byte) error {
+	v, err := changeTypeNames.unmarshalJSON("ChangeType", b)
+	if err != nil {
+		return err
+	}
+	*c = ChangeType(v)
+	return nil
+}
+
+// ChangeRisk represents the risk of a Change
+type ChangeRisk int
+
+const (
+	ChangeRiskLow      ChangeRisk = 1
+	ChangeRiskMedium   ChangeRisk = 2
+	ChangeRiskHigh     ChangeRisk = 3
+	ChangeRiskVeryHigh ChangeRisk = 4
+)
+
+var changeRiskNames = enumNames{
+	int(ChangeRiskLow):      "Low",
+	int(ChangeRiskMedium):   "Medium",
+	int(ChangeRiskHigh):     "High",
+	int(ChangeRiskVeryHigh): "Very High",
+}
+
+// String returns the display name of the ChangeRisk
+func (c ChangeRisk) String() string {
+	return changeRiskNames.String("ChangeRisk", int(c))
+}
+
+// Valid reports whether the ChangeRisk is one of the known values
+func (c ChangeRisk) Valid() bool {
+	return changeRiskNames.valid(int(c))
+}
+
+// Ptr returns a pointer to the ChangeRisk, for setting a field of an update model
+func (c ChangeRisk) Ptr() *ChangeRisk {
+	return &c
+}
+
+// MarshalText returns the lower case name of the ChangeRisk
+func (c ChangeRisk) MarshalText() ([]byte, error) {
+	return changeRiskNames.marshalText("ChangeRisk", int(c))
+}
+
+// UnmarshalText parses a ChangeRisk from its name or number
+func (c *ChangeRisk) UnmarshalText(b []byte) error {
+	v, err := changeRiskNames.parse("ChangeRisk", string(b))
+	if err != nil {
+		return err
+	}
+	*c = ChangeRisk(v)
+	return nil
+}
+
+// MarshalJSON sends the ChangeRisk as a number, as expected by FreshService
+func (c ChangeRisk) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalJSON reads a ChangeRisk from a number or name
+func (c *ChangeRisk) UnmarshalJSON(b []byte) error {
+	v, err := changeRiskNames.unmarshalJSON("ChangeRisk", b)
+	if err != nil {
+		return err
+	}
+	*c = ChangeRisk(v)
+	return nil
+}
+
+// ProblemStatus represents the status of a Problem
+type ProblemStatus int
+
+const (
+	ProblemStatusOpen            ProblemStatus = 1
+	ProblemStatusChangeRequested ProblemStatus = 2
+	ProblemStatusClosed          ProblemStatus = 3
+)
+
+var problemStatusNames = enumNames{
+	int(ProblemStatusOpen):            "Open",
+	int(ProblemStatusChangeRequested): "Change Requested",
+	int(ProblemStatusClosed):          "Closed",
+}
+
+// String returns the display name of the ProblemStatus
+func (p ProblemStatus) String() string {
+	return problemStatusNames.String("ProblemStatus", int(p))
+}
+
+// Valid reports whether the ProblemStatus is one of the known values
+func (p ProblemStatus) Valid() bool {
+	return problemStatusNames.valid(int(p))
+}
+
+// Ptr returns a pointer to the ProblemStatus, for setting a field of an update model
+func (p ProblemStatus) Ptr() *ProblemStatus {
+	return &p
+}
+
+// MarshalText returns the lower case name of the ProblemStatus
+func (p ProblemStatus) MarshalText() ([]byte, error) {
+	return problemStatusNames.marshalText("ProblemStatus", int(p))
+}
+
+// UnmarshalText parses a ProblemStatus from its name or number
+func (p *ProblemStatus) UnmarshalText(b []byte) error {
+	v, err := problemStatusNames.parse("ProblemStatus", string(b))
+	if err != nil {
+		return err
+	}
+	*p = ProblemStatus(v)
+	return nil
+}
+
+// MarshalJSON sends the ProblemStatus as a number, as expected by FreshService
+func (p ProblemStatus) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(p))), nil
+}
+
+// UnmarshalJSON reads a ProblemStatus from a number or name
+func (p *ProblemStatus) UnmarshalJSON(b []byte) error {
+	v, err := problemStatusNames.unmarshalJSON("ProblemStatus", b)
+	if err != nil {
+		return err
+	}
+	*p = ProblemStatus(v)
+	return nil
+}
+
+// ReleaseStatus represents the status of a Release
+type ReleaseStatus int
+
+const (
+	ReleaseStatusOpen       ReleaseStatus = 1
+	ReleaseStatusOnHold     ReleaseStatus = 2
+	ReleaseStatusInProgress ReleaseStatus = 3
+	ReleaseStatusIncomplete ReleaseStatus = 4
+	ReleaseStatusCompleted  ReleaseStatus = 5
+)
+
+var releaseStatusNames = enumNames{
+	int(ReleaseStatusOpen):       "Open",
+	int(ReleaseStatusOnHold):     "On Hold",
+	int(ReleaseStatusInProgress): "In Progress",
+	int(ReleaseStatusIncomplete): "Incomplete",
+	int(ReleaseStatusCompleted):  "Completed",
+}
+
+// String returns the display name of the ReleaseStatus
+func (r ReleaseStatus) String() string {
+	return releaseStatusNames.String("ReleaseStatus", int(r))
+}
+
+// Valid reports whether the ReleaseStatus is one of the known values
+func (r ReleaseStatus) Valid() bool {
+	return releaseStatusNames.valid(int(r))
+}
+
+// Ptr returns a pointer to the ReleaseStatus, for setting a field of an update model
+func (r ReleaseStatus) Ptr() *ReleaseStatus {
+	return &r
+}
+
+// MarshalText returns the lower case name of the ReleaseStatus
+func (r ReleaseStatus) MarshalText() ([]byte, error) {
+	return releaseStatusNames.marshalText("ReleaseStatus", int(r))
+}
+
+// UnmarshalText parses a ReleaseStatus from its name or number
+func (r *ReleaseStatus) UnmarshalText(b []byte) error {
+	v, err := releaseStatusNames.parse("ReleaseStatus", string(b))
+	if err != nil {
+		return err
+	}
+	*r = ReleaseStatus(v)
+	return nil
+}
+
+// MarshalJSON sends the ReleaseStatus as a number, as expected by FreshService
+func (r ReleaseStatus) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(r))), nil
+}
+
+// UnmarshalJSON reads a ReleaseStatus from a number or name
+func (r *ReleaseStatus) UnmarshalJSON(b []byte) error {
+	v, err := releaseStatusNames.unmarshalJSON("ReleaseStatus", b)
+	if err != nil {
+		return err
+	}
+	*r = ReleaseStatus(v)
+	return nil
+}
+
+// ReleaseType represents the type of a Release
+type ReleaseType int
+
+const (
+	ReleaseTypeMinor     ReleaseType = 1
+	ReleaseTypeStandard  ReleaseType = 2
+	ReleaseTypeMajor     ReleaseType = 3
+	ReleaseTypeEmergency ReleaseType = 4
+)
+
+var releaseTypeNames = enumNames{
+	int(ReleaseTypeMinor):     "Minor",
+	int(ReleaseTypeStandard):  "Standard",
+	int(ReleaseTypeMajor):     "Major",
+	int(ReleaseTypeEmergency): "Emergency",
+}
+
+// String returns the display name of the ReleaseType
+func (r ReleaseType) String() string {
+	return releaseTypeNames.String("ReleaseType", int(r))
+}
+
+// Valid reports whether the ReleaseType is one of the known values
+func (r ReleaseType) Valid() bool {
+	return releaseTypeNames.valid(int(r))
+}
+
+// Ptr returns a pointer to the ReleaseType, for setting a field of an update model
+func (r ReleaseType) Ptr() *ReleaseType {
+	return &r
+}
+
+// MarshalText returns the lower case name of the ReleaseType
+func (r ReleaseType) MarshalText() ([]byte, error) {
+	return releaseTypeNames.marshalText("ReleaseType", int(r))
+}
+
+// UnmarshalText parses a ReleaseType from its name or number
+func (r *ReleaseType) UnmarshalText(b []byte) error {
+	v, err := releaseTypeNames.parse("ReleaseType", string(b))
+	if err != nil {
+		return err
+	}
+	*r = ReleaseType(v)
+	return nil
+}
+
+// MarshalJSON sends the ReleaseType as a number, as expected by FreshService
+func (r ReleaseType) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(r))), nil
+}
+
+// UnmarshalJSON reads a ReleaseType from a number or name
+func (r *ReleaseType) UnmarshalJSON(b []byte) error {
+	v, err := releaseTypeNames.unmarshalJSON("ReleaseType", b)
+	if err != nil {
+		return err
+	}
+	*r = ReleaseType(v)
+	return nil
+}
+
+// TaskStatus represents the status of a Task
+type TaskStatus int
+
+const (
+	TaskStatusOpen       TaskStatus = 1
+	TaskStatusInProgress TaskStatus = 2
+	TaskStatusCompleted  TaskStatus = 3
+)
+
+var taskStatusNames = enumNames{
+	int(TaskStatusOpen):       "Open",
+	int(TaskStatusInProgress): "In Progress",
+	int(TaskStatusCompleted):  "Completed",
+}
+
+// String returns the display name of the TaskStatus
+func (t TaskStatus) String() string {
+	return taskStatusNames.String("TaskStatus", int(t))
+}
+
+// Valid reports whether the TaskStatus is one of the known values
+func (t TaskStatus) Valid() bool {
+	return taskStatusNames.valid(int(t))
+}
+
+// Ptr returns a pointer to the TaskStatus, for setting a field of an update model
+func (t TaskStatus) Ptr() *TaskStatus {
+	return &t
+}
+
+// MarshalText returns the lower case name of the TaskStatus
+func (t TaskStatus) MarshalText() ([]byte, error) {
+	return taskStatusNames.marshalText("TaskStatus", int(t))
+}
+
+// UnmarshalText parses a TaskStatus from its name or number
+func (t *TaskStatus) UnmarshalText(b []byte) error {
+	v, err := taskStatusNames.parse("TaskStatus", string(b))
+	if err != nil {
+		return err
+	}
+	*t = TaskStatus(v)
+	return nil
+}
+
+// MarshalJSON sends the TaskStatus as a number, as expected by FreshService
+func (t TaskStatus) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(t))), nil
+}
+
+// UnmarshalJSON reads a TaskStatus from a number or name
+func (t *TaskStatus) UnmarshalJSON(b []byte) error {
+	v, err := taskStatusNames.unmarshalJSON("TaskStatus", b)
+	if err != nil {
+		return err
+	}
+	*t = TaskStatus(v)
+	return nil
+}
@@ -0,0 +1,84 @@
+package freshservice_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+func TestEnumUnmarshalJSON(t *testing.T) {
+	type model struct {
+		Priority freshservice.Priority           `json:"priority"`
+		Status   freshservice.TicketStatus       `json:"status"`
+		Source   freshservice.ConversationSource `json:"source"`
+	}
+
+	tests := []struct {
+		name    string
+		json    string
+		want    model
+		wantErr string
+	}{
+		{name: "numbers", json: `{"priority": 4, "status": 2, "source": 2}`, want: model{freshservice.PriorityUrgent, freshservice.TicketOpen, freshservice.ConversationNote}},
+		{name: "names", json: `{"priority": "Urgent", "status": "open", "source": "forward_email"}`, want: model{freshservice.PriorityUrgent, freshservice.TicketOpen, freshservice.ConversationForwardEmail}},
+		{name: "numbers as strings", json: `{"priority": "4", "status": "7"}`, want: model{Priority: freshservice.PriorityUrgent, Status: freshservice.TicketStatus(7)}},
+		{name: "custom status number", json: `{"status": 7}`, want: model{Status: freshservice.TicketStatus(7)}},
+		{name: "null", json: `{"priority": null}`, want: model{}},
+		{name: "misspelled name", json: `{"priority": "Urgnet"}`, wantErr: `invalid Priority "Urgnet"`},
+		{name: "custom status name", json: `{"status": "Awaiting Vendor"}`, wantErr: `invalid TicketStatus "Awaiting Vendor"`},
+		{name: "not a number", json: `{"priority": true}`, wantErr: "invalid Priority true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got model
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumText(t *testing.T) {
+	tests := []struct {
+		value    freshservice.TicketStatus
+		wantText string
+		wantErr  bool
+	}{
+		{value: freshservice.TicketOpen, wantText: "open"},
+		{value: freshservice.TicketResolved, wantText: "resolved"},
+		{value: freshservice.TicketStatus(7), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			b, err := tt.value.MarshalText()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %q, want an error", b)
+				}
+				return
+			}
+			if err != nil || string(b) != tt.wantText {
+				t.Fatalf("got %q (%v), want %q", b, err, tt.wantText)
+			}
+
+			var back freshservice.TicketStatus
+			if err = back.UnmarshalText(b); err != nil || back != tt.value {
+				t.Errorf("got %s (%v) back, want %s", back, err, tt.value)
+			}
+		})
+	}
+}
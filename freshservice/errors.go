@@ -0,0 +1,132 @@
+package freshservice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// maxErrorBodySize is the most that will be read from the body of a failed response
+const maxErrorBodySize = 1 << 20
+
+// ErrorResponse is returned when FreshService responds with a non-success status code
+type ErrorResponse struct {
+	Response    *http.Response `json:"-"`
+	StatusCode  int            `json:"-"`
+	Method      string         `json:"-"`
+	URL         string         `json:"-"`
+	Description string         `json:"description"`
+	Code        string         `json:"code"`
+	Message     string         `json:"message"`
+	Errors      []FieldError   `json:"errors"`
+}
+
+// FieldError represents a single validation failure reported by FreshService
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// Error implements the error interface
+func (e *ErrorResponse) Error() string {
+	msg := fmt.Sprintf("%s %s: request returned non-success status %d", e.Method, e.URL, e.StatusCode)
+
+	switch {
+	case e.Description != "":
+		msg = fmt.Sprintf("%s: %s", msg, e.Description)
+	case e.Message != "":
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+
+	if len(e.Errors) > 0 {
+		fields := make([]string, 0, len(e.Errors))
+		for _, f := range e.Errors {
+			fields = append(fields, f.Error())
+		}
+		msg = fmt.Sprintf("%s [%s]", msg, strings.Join(fields, ", "))
+	}
+
+	return msg
+}
+
+// Error implements the error interface
+func (e FieldError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("%s: %s (%s)", e.Field, e.Message, e.Code)
+}
+
+// newErrorResponse builds an ErrorResponse from a failed response, parsing the body where possible
+func newErrorResponse(res *http.Response) *ErrorResponse {
+	e := &ErrorResponse{
+		Response:   res,
+		StatusCode: res.StatusCode,
+	}
+
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.URL = redactUrl(res.Request.URL.String())
+	}
+
+	if res.Body != nil {
+		body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		if err == nil && len(body) > 0 {
+			if json.Unmarshal(body, e) != nil {
+				e.Message = strings.TrimSpace(string(body))
+			}
+		}
+	}
+
+	return e
+}
+
+// queryPattern matches the query string of any URL within a string
+var queryPattern = regexp.MustCompile(`\?[^\s"]*`)
+
+// redactUrl strips query strings from any URL in s as these can contain personal information (emails, etc)
+func redactUrl(s string) string {
+	return queryPattern.ReplaceAllString(s, "")
+}
+
+// IsNotFound reports whether err is an ErrorResponse for a resource that does not exist
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsRateLimited reports whether err is an ErrorResponse caused by exceeding the API rate limit
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsValidation reports whether err is an ErrorResponse caused by invalid input
+func IsValidation(err error) bool {
+	return hasStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
+// IsUnauthorized reports whether err is an ErrorResponse caused by a missing or invalid API key or insufficient access
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized, http.StatusForbidden)
+}
+
+// hasStatus reports whether err is an ErrorResponse with one of the given status codes
+func hasStatus(err error, codes ...int) bool {
+	var e *ErrorResponse
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	for _, c := range codes {
+		if e.StatusCode == c {
+			return true
+		}
+	}
+
+	return false
+}
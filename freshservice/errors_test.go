@@ -0,0 +1,123 @@
+package freshservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+func TestErrorResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantErr    string
+		wantFields []freshservice.FieldError
+		wantIs     string
+	}{
+		{
+			name:    "not found",
+			status:  http.StatusNotFound,
+			body:    `{"code": "access_denied", "message": "Record not found"}`,
+			wantErr: "/api/v2/requesters: request returned non-success status 404: Record not found",
+			wantIs:  "not found",
+		},
+		{
+			name:   "validation",
+			status: http.StatusBadRequest,
+			body:   `{"description": "Validation failed", "errors": [{"field": "primary_email", "message": "It should be a valid email", "code": "invalid_value"}, {"field": "first_name", "message": "Missing"}]}`,
+			wantErr: "/api/v2/requesters: request returned non-success status 400: Validation failed " +
+				"[primary_email: It should be a valid email (invalid_value), first_name: Missing]",
+			wantFields: []freshservice.FieldError{
+				{Field: "primary_email", Message: "It should be a valid email", Code: "invalid_value"},
+				{Field: "first_name", Message: "Missing"},
+			},
+			wantIs: "validation",
+		},
+		{name: "unprocessable", status: http.StatusUnprocessableEntity, body: `{}`, wantIs: "validation"},
+		{name: "unauthorized", status: http.StatusUnauthorized, body: `{"code": "invalid_credentials"}`, wantIs: "unauthorized"},
+		{name: "forbidden", status: http.StatusForbidden, wantIs: "unauthorized"},
+		{name: "rate limited", status: http.StatusTooManyRequests, wantIs: "rate limited"},
+		{
+			name:    "text body",
+			status:  http.StatusBadGateway,
+			body:    "upstream unavailable\n",
+			wantErr: "/api/v2/requesters: request returned non-success status 502: upstream unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer ts.Close()
+
+			fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL(ts.URL+"/api/v2"), freshservice.WithRetryPolicy(0, 0, 0))
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			_, _, err = fs.Requesters.ListRequesters(context.Background(), &freshservice.ListRequestersOptions{Email: freshservice.String("jane@acme.test")})
+
+			var e *freshservice.ErrorResponse
+			if !errors.As(err, &e) {
+				t.Fatalf("got error %v, want an ErrorResponse", err)
+			}
+			if e.StatusCode != tt.status {
+				t.Errorf("got status %d, want %d", e.StatusCode, tt.status)
+			}
+			if strings.Contains(err.Error(), "jane") {
+				t.Errorf("error leaks the query string: %v", err)
+			}
+			if tt.wantErr != "" && !strings.HasSuffix(err.Error(), tt.wantErr) {
+				t.Errorf("got error %q, want it to end with %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(e.Errors, tt.wantFields) {
+				t.Errorf("got field errors %v, want %v", e.Errors, tt.wantFields)
+			}
+
+			is := map[string]bool{
+				"not found":    freshservice.IsNotFound(err),
+				"validation":   freshservice.IsValidation(err),
+				"unauthorized": freshservice.IsUnauthorized(err),
+				"rate limited": freshservice.IsRateLimited(err),
+			}
+			for name, got := range is {
+				if want := name == tt.wantIs; got != want {
+					t.Errorf("Is %s: got %t, want %t", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIsHelpersOnOtherErrors(t *testing.T) {
+	wrapped := fmt.Errorf("unable to escalate: %w", &freshservice.ErrorResponse{StatusCode: http.StatusNotFound})
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil},
+		{name: "other error", err: errors.New("boom")},
+		{name: "wrapped", err: wrapped, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := freshservice.IsNotFound(tt.err); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
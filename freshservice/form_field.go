@@ -0,0 +1,335 @@
+package freshservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	ticketFormFieldsUrl  = "ticket_form_fields"
+	changeFormFieldsUrl  = "change_form_fields"
+	problemFormFieldsUrl = "problem_form_fields"
+	releaseFormFieldsUrl = "release_form_fields"
+	requesterFieldsUrl   = "requester_fields"
+)
+
+// FormFields contains Collection an array of FormField
+type FormFields struct {
+	Collection []FormField
+	// ClosingStatuses are the status values of the form which require the required_for_closure fields, they default to
+	// the built-in resolved/closed statuses of the form and custom statuses which close a record can be appended
+	ClosingStatuses []int
+}
+
+// FormField represents the definition of a (default or custom) field on a form in FreshService
+type FormField struct {
+	ID                   int               `json:"id"`
+	Name                 string            `json:"name"`
+	Label                string            `json:"label"`
+	Description          string            `json:"description"`
+	FieldType            string            `json:"field_type"`
+	Position             int               `json:"position"`
+	Required             bool              `json:"required"`
+	RequiredForClosure   bool              `json:"required_for_closure"`
+	RequiredForAgents    bool              `json:"required_for_agents"`
+	RequiredForCustomers bool              `json:"required_for_customers"`
+	DefaultField         bool              `json:"default_field"`
+	Editable             bool              `json:"editable"`
+	Choices              []FormFieldChoice `json:"choices"`
+	NestedFields         []NestedFormField `json:"nested_fields"`
+	CreatedAt            Time              `json:"created_at"`
+	UpdatedAt            Time              `json:"updated_at"`
+}
+
+// FormFieldChoice represents a choice of a dropdown FormField, NestedOptions are the choices of the dependent field
+type FormFieldChoice struct {
+	ID            int               `json:"id"`
+	Value         string            `json:"value"`
+	DisplayID     int               `json:"display_id"`
+	Position      int               `json:"position"`
+	NestedOptions []FormFieldChoice `json:"nested_options"`
+}
+
+// NestedFormField represents a dependent level of a nested (dependent dropdown) FormField
+type NestedFormField struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Level int    `json:"level"`
+}
+
+// FormValidationError is returned by FormFields.ValidateCreate / ValidateUpdate with every problem found
+type FormValidationError struct {
+	Errors []FieldError
+}
+
+// Error implements the error interface
+func (e *FormValidationError) Error() string {
+	fields := make([]string, 0, len(e.Errors))
+	for _, f := range e.Errors {
+		fields = append(fields, f.Error())
+	}
+	return fmt.Sprintf("validation failed [%s]", strings.Join(fields, ", "))
+}
+
+// defaultFieldKeys maps the names of default form fields to the keys used by the models, when they differ
+var defaultFieldKeys = map[string][]string{
+	"requester":   {"requester_id", "email", "phone"},
+	"agent":       {"responder_id", "agent_id"},
+	"group":       {"group_id"},
+	"department":  {"department_id"},
+	"ticket_type": {"type"},
+	"change_type": {"change_type"},
+	"email":       {"primary_email"},
+}
+
+// built-in closing statuses of each form, used as the default FormFields.ClosingStatuses
+var (
+	ticketClosingStatuses  = []int{4, 5} // resolved, closed
+	changeClosingStatuses  = []int{6}    // closed
+	problemClosingStatuses = []int{3}    // closed
+	releaseClosingStatuses = []int{5}    // completed
+)
+
+// ValidateCreate checks the model (e.g. CreateTicketModel) against the FormFields, reporting all missing required fields
+// and invalid choices at once through a FormValidationError.
+func (f *FormFields) ValidateCreate(model interface{}) error {
+	return f.validate(model, false)
+}
+
+// ValidateUpdate checks the model (e.g. UpdateTicketModel) against the FormFields, only the fields being set (or cleared
+// using NullFields) are checked. As the current values of the record are unknown, the required_for_closure fields are
+// only reported when the update resolves/closes the record while clearing them.
+func (f *FormFields) ValidateUpdate(model interface{}) error {
+	return f.validate(model, true)
+}
+
+func (f *FormFields) validate(model interface{}, partial bool) error {
+	values, err := modelValues(model)
+	if err != nil {
+		return err
+	}
+
+	custom, _ := values["custom_fields"].(map[string]interface{})
+	closing := f.isClosing(values["status"])
+
+	var errs []FieldError
+	for _, field := range f.Collection {
+		key, value, present := field.lookup(values, custom)
+
+		if !isSet(value) {
+			// an update only has to provide the required fields it clears
+			required := field.Required || (field.RequiredForClosure && closing)
+			if required && (!partial || present) {
+				errs = append(errs, FieldError{Field: key, Message: fmt.Sprintf("%s is required", field.Label), Code: "missing_field"})
+			}
+			continue
+		}
+
+		errs = append(errs, field.checkChoices(key, value, values, custom)...)
+	}
+
+	if len(errs) > 0 {
+		return &FormValidationError{Errors: errs}
+	}
+
+	return nil
+}
+
+// isClosing reports whether status is one of the ClosingStatuses
+func (f *FormFields) isClosing(status interface{}) bool {
+	if status == nil {
+		return false
+	}
+	for _, s := range f.ClosingStatuses {
+		if fmt.Sprint(status) == fmt.Sprint(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the key and value of the FormField within the model values, and whether the key is present at all
+// (a null value is present when the field is being cleared)
+func (field FormField) lookup(values map[string]interface{}, custom map[string]interface{}) (string, interface{}, bool) {
+	if !field.DefaultField {
+		// custom fields may be listed with the cf_ prefix but are set without it
+		name := strings.TrimPrefix(field.Name, "cf_")
+		if v, ok := custom[field.Name]; ok {
+			return field.Name, v, true
+		}
+		v, ok := custom[name]
+		return name, v, ok
+	}
+
+	keys, ok := defaultFieldKeys[field.Name]
+	if !ok {
+		keys = []string{field.Name}
+	}
+
+	present := false
+	for _, k := range keys {
+		if isSet(values[k]) {
+			return k, values[k], true
+		}
+		if _, ok := values[k]; ok {
+			present = true
+		}
+	}
+
+	return keys[0], nil, present
+}
+
+// checkChoices ensures value is one of the choices of the FormField, walking any nested (dependent) fields
+func (field FormField) checkChoices(key string, value interface{}, values map[string]interface{}, custom map[string]interface{}) []FieldError {
+	if len(field.Choices) == 0 {
+		return nil
+	}
+
+	// multi-select fields hold a list of choices
+	if list, ok := value.([]interface{}); ok {
+		for _, v := range list {
+			if _, ok := findChoice(field.Choices, v); !ok {
+				return []FieldError{invalidChoice(key, v)}
+			}
+		}
+		return nil
+	}
+
+	choice, ok := findChoice(field.Choices, value)
+	if !ok {
+		return []FieldError{invalidChoice(key, value)}
+	}
+
+	for _, nested := range field.NestedFields {
+		n := FormField{Name: nested.Name, DefaultField: field.DefaultField}
+		nestedKey, nestedValue, _ := n.lookup(values, custom)
+		if !isSet(nestedValue) {
+			return nil
+		}
+
+		choice, ok = findChoice(choice.NestedOptions, nestedValue)
+		if !ok {
+			return []FieldError{invalidChoice(nestedKey, nestedValue)}
+		}
+	}
+
+	return nil
+}
+
+// findChoice matches numeric values against the choice id and text against the choice value
+func findChoice(choices []FormFieldChoice, value interface{}) (FormFieldChoice, bool) {
+	for _, c := range choices {
+		switch v := value.(type) {
+		case json.Number:
+			if v.String() == fmt.Sprint(c.ID) {
+				return c, true
+			}
+		case string:
+			if v == c.Value {
+				return c, true
+			}
+		}
+	}
+	return FormFieldChoice{}, false
+}
+
+func invalidChoice(key string, value interface{}) FieldError {
+	return FieldError{Field: key, Message: fmt.Sprintf("%v is not a valid choice", value), Code: "invalid_value"}
+}
+
+// isSet reports whether a model value has been provided (not null, empty or zero)
+func isSet(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case string:
+		return val != ""
+	case json.Number:
+		return val.String() != "0"
+	case []interface{}:
+		return len(val) > 0
+	case map[string]interface{}:
+		return len(val) > 0
+	}
+	return true
+}
+
+// modelValues returns the JSON representation of a model as a map
+func modelValues(model interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("error reading model: %v", err)
+	}
+
+	values := map[string]interface{}{}
+	d := json.NewDecoder(strings.NewReader(string(b)))
+	d.UseNumber()
+	if err = d.Decode(&values); err != nil {
+		return nil, fmt.Errorf("error reading model: %v", err)
+	}
+
+	return values, nil
+}
+
+// ticketFormFields is the response of ticket_form_fields
+type ticketFormFields struct {
+	Collection []FormField `json:"ticket_fields"`
+}
+
+// changeFormFields is the response of change_form_fields
+type changeFormFields struct {
+	Collection []FormField `json:"change_fields"`
+}
+
+// problemFormFields is the response of problem_form_fields
+type problemFormFields struct {
+	Collection []FormField `json:"problem_fields"`
+}
+
+// releaseFormFields is the response of release_form_fields
+type releaseFormFields struct {
+	Collection []FormField `json:"release_fields"`
+}
+
+// requesterFields is the response of requester_fields
+type requesterFields struct {
+	Collection []FormField `json:"requester_fields"`
+}
+
+// ListTicketFormFields will return the FormFields of the Ticket form
+func (s *TicketService) ListTicketFormFields(ctx context.Context) (*FormFields, *Response, error) {
+	o := new(ticketFormFields)
+	res, err := s.client.List(ctx, ticketFormFieldsUrl, nil, &o)
+	return &FormFields{Collection: o.Collection, ClosingStatuses: append([]int(nil), ticketClosingStatuses...)}, res, err
+}
+
+// ListChangeFormFields will return the FormFields of the Change form
+func (s *ChangeService) ListChangeFormFields(ctx context.Context) (*FormFields, *Response, error) {
+	o := new(changeFormFields)
+	res, err := s.client.List(ctx, changeFormFieldsUrl, nil, &o)
+	return &FormFields{Collection: o.Collection, ClosingStatuses: append([]int(nil), changeClosingStatuses...)}, res, err
+}
+
+// ListProblemFormFields will return the FormFields of the Problem form
+func (s *ProblemService) ListProblemFormFields(ctx context.Context) (*FormFields, *Response, error) {
+	o := new(problemFormFields)
+	res, err := s.client.List(ctx, problemFormFieldsUrl, nil, &o)
+	return &FormFields{Collection: o.Collection, ClosingStatuses: append([]int(nil), problemClosingStatuses...)}, res, err
+}
+
+// ListReleaseFormFields will return the FormFields of the Release form
+func (s *ReleaseService) ListReleaseFormFields(ctx context.Context) (*FormFields, *Response, error) {
+	o := new(releaseFormFields)
+	res, err := s.client.List(ctx, releaseFormFieldsUrl, nil, &o)
+	return &FormFields{Collection: o.Collection, ClosingStatuses: append([]int(nil), releaseClosingStatuses...)}, res, err
+}
+
+// ListRequesterFields will return the FormFields of Requesters
+func (s *RequesterService) ListRequesterFields(ctx context.Context) (*FormFields, *Response, error) {
+	o := new(requesterFields)
+	res, err := s.client.List(ctx, requesterFieldsUrl, nil, &o)
+	return &FormFields{Collection: o.Collection}, res, err
+}
@@ -0,0 +1,109 @@
+package freshservice_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicetest"
+)
+
+func TestValidateUpdate(t *testing.T) {
+	fields := []freshservice.FormField{
+		{Name: "subject", DefaultField: true, Required: true},
+		{Name: "category", DefaultField: true, RequiredForClosure: true},
+		{Name: "status", DefaultField: true},
+	}
+
+	tests := []struct {
+		name       string
+		form       string
+		closing    []int
+		create     bool
+		model      interface{}
+		wantFields []string
+	}{
+		{name: "unset required fields are not checked", form: "ticket_form_fields", model: &freshservice.UpdateTicketModel{Status: freshservice.TicketPending.Ptr()}},
+		{name: "cleared required field", form: "ticket_form_fields", model: &freshservice.UpdateTicketModel{NullFields: []string{"Subject"}}, wantFields: []string{"subject"}},
+		{name: "blank required field", form: "ticket_form_fields", model: &freshservice.UpdateTicketModel{Subject: freshservice.String("")}, wantFields: []string{"subject"}},
+		{name: "resolving a ticket", form: "ticket_form_fields", model: &freshservice.UpdateTicketModel{Status: freshservice.TicketResolved.Ptr()}},
+		{
+			name:       "resolving a ticket while clearing a closure field",
+			form:       "ticket_form_fields",
+			model:      &freshservice.UpdateTicketModel{Status: freshservice.TicketResolved.Ptr(), NullFields: []string{"Category"}},
+			wantFields: []string{"category"},
+		},
+		{name: "clearing a closure field without closing", form: "ticket_form_fields", model: &freshservice.UpdateTicketModel{NullFields: []string{"Category"}}},
+		{
+			name:       "custom closing status",
+			form:       "ticket_form_fields",
+			closing:    []int{7},
+			model:      &freshservice.UpdateTicketModel{Status: freshservice.TicketStatus(7).Ptr(), NullFields: []string{"Category"}},
+			wantFields: []string{"category"},
+		},
+		{
+			name:  "resolving a ticket with the closure fields",
+			form:  "ticket_form_fields",
+			model: &freshservice.UpdateTicketModel{Status: freshservice.TicketResolved.Ptr(), Category: freshservice.String("Hardware")},
+		},
+		{name: "change status 5 is not closing", form: "change_form_fields", model: &freshservice.UpdateChangeModel{Status: freshservice.ChangeStatus(5).Ptr(), NullFields: []string{"Category"}}},
+		{name: "closing a change", form: "change_form_fields", model: &freshservice.UpdateChangeModel{Status: freshservice.ChangeStatus(6).Ptr(), NullFields: []string{"Category"}}, wantFields: []string{"category"}},
+		{name: "closing a problem", form: "problem_form_fields", model: &freshservice.UpdateProblemModel{Status: freshservice.ProblemStatus(3).Ptr(), NullFields: []string{"Category"}}, wantFields: []string{"category"}},
+		{name: "completing a release", form: "release_form_fields", model: &freshservice.UpdateReleaseModel{Status: freshservice.ReleaseStatus(5).Ptr(), NullFields: []string{"Category"}}, wantFields: []string{"category"}},
+		{name: "release status 4 is not closing", form: "release_form_fields", model: &freshservice.UpdateReleaseModel{Status: freshservice.ReleaseStatus(4).Ptr(), NullFields: []string{"Category"}}},
+		{name: "creating a resolved ticket", form: "ticket_form_fields", create: true, model: &freshservice.CreateTicketModel{Subject: "Printer on fire", Status: freshservice.TicketResolved}, wantFields: []string{"category"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, srv := freshservicetest.New(t)
+			for _, f := range fields {
+				if _, err := srv.Seed(tt.form, f); err != nil {
+					t.Fatalf("unable to seed form field: %v", err)
+				}
+			}
+
+			var form *freshservice.FormFields
+			var err error
+			ctx := context.Background()
+			switch tt.form {
+			case "ticket_form_fields":
+				form, _, err = fs.Tickets.ListTicketFormFields(ctx)
+			case "change_form_fields":
+				form, _, err = fs.Changes.ListChangeFormFields(ctx)
+			case "problem_form_fields":
+				form, _, err = fs.Problems.ListProblemFormFields(ctx)
+			case "release_form_fields":
+				form, _, err = fs.Releases.ListReleaseFormFields(ctx)
+			}
+			if err != nil {
+				t.Fatalf("unable to list form fields: %v", err)
+			}
+			if len(form.Collection) != len(fields) {
+				t.Fatalf("got %d form fields, want %d", len(form.Collection), len(fields))
+			}
+
+			form.ClosingStatuses = append(form.ClosingStatuses, tt.closing...)
+			if tt.create {
+				err = form.ValidateCreate(tt.model)
+			} else {
+				err = form.ValidateUpdate(tt.model)
+			}
+
+			var got []string
+			var ve *freshservice.FormValidationError
+			if errors.As(err, &ve) {
+				for _, fe := range ve.Errors {
+					got = append(got, fe.Field)
+				}
+			} else if err != nil {
+				t.Fatalf("ValidateUpdate: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("got invalid fields %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package freshservice
+
+//go:generate go run ../internal/apigen
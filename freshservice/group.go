@@ -0,0 +1,155 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	groupsUrl  = "groups"
+	groupIdUrl = "groups/%d"
+)
+
+// GroupService API Docs: https://api.freshservice.com/#agent-groups
+type GroupService struct {
+	client *Client
+}
+
+// Groups contains Collection an array of Group
+type Groups struct {
+	Collection []Group `json:"groups"`
+}
+
+// groupWrapper contains Details of one Group
+type groupWrapper struct {
+	Details Group `json:"group"`
+}
+
+// Group represents a FreshService Agent Group
+type Group struct {
+	ID                       int    `json:"id"`
+	Name                     string `json:"name"`
+	Description              string `json:"description"`
+	EscalateTo               int    `json:"escalate_to"`
+	UnassignedFor            string `json:"unassigned_for"`
+	BusinessHoursID          int    `json:"business_hours_id"`
+	AutoTicketAssign         bool   `json:"auto_ticket_assign"`
+	Restricted               bool   `json:"restricted"`
+	ApprovalRequired         bool   `json:"approval_required"`
+	OCSScheduleID            int    `json:"ocs_schedule_id"`
+	Members                  []int  `json:"members"`
+	Observers                []int  `json:"observers"`
+	Leaders                  []int  `json:"leaders"`
+	MembersPendingApproval   []int  `json:"members_pending_approval"`
+	LeadersPendingApproval   []int  `json:"leaders_pending_approval"`
+	ObserversPendingApproval []int  `json:"observers_pending_approval"`
+	CreatedAt                Time   `json:"created_at"`
+	UpdatedAt                Time   `json:"updated_at"`
+}
+
+// CreateGroupModel is the data structure required to create a new Group
+// UnassignedFor is the time after which an escalation is sent (e.g. 30m, 1h, 2h, 4h, 8h, 12h, 1d, 2d, 3d)
+type CreateGroupModel struct {
+	Name             string `json:"name"`
+	Description      string `json:"description,omitempty"`
+	EscalateTo       int    `json:"escalate_to,omitempty"`
+	UnassignedFor    string `json:"unassigned_for,omitempty"`
+	BusinessHoursID  int    `json:"business_hours_id,omitempty"`
+	AutoTicketAssign bool   `json:"auto_ticket_assign,omitempty"`
+	Restricted       bool   `json:"restricted,omitempty"`
+	ApprovalRequired bool   `json:"approval_required,omitempty"`
+	Members          []int  `json:"members,omitempty"`
+	Observers        []int  `json:"observers,omitempty"`
+	Leaders          []int  `json:"leaders,omitempty"`
+}
+
+// UpdateGroupModel is the data structure for updating a Group
+type UpdateGroupModel struct {
+	Name             *string  `json:"name,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	EscalateTo       *int     `json:"escalate_to,omitempty"`
+	UnassignedFor    *string  `json:"unassigned_for,omitempty"`
+	BusinessHoursID  *int     `json:"business_hours_id,omitempty"`
+	AutoTicketAssign *bool    `json:"auto_ticket_assign,omitempty"`
+	Restricted       *bool    `json:"restricted,omitempty"`
+	ApprovalRequired *bool    `json:"approval_required,omitempty"`
+	Members          []int    `json:"members,omitempty"`
+	Observers        []int    `json:"observers,omitempty"`
+	Leaders          []int    `json:"leaders,omitempty"`
+	NullFields       []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateGroupModel which are set, along with the NullFields as null
+func (m UpdateGroupModel) MarshalJSON() ([]byte, error) {
+	type model UpdateGroupModel
+	return marshalUpdate(model(m), m.NullFields)
+}
+
+// ListGroupsOptions represents pagination for Groups
+type ListGroupsOptions struct {
+	ListOptions
+}
+
+// GetGroup will return a single Group by id
+func (s *GroupService) GetGroup(ctx context.Context, id int) (*Group, *Response, error) {
+	o := new(groupWrapper)
+	res, err := s.client.Get(ctx, fmt.Sprintf(groupIdUrl, id), &o)
+	return &o.Details, res, err
+}
+
+// ListGroups will return paginated Groups using ListGroupsOptions
+func (s *GroupService) ListGroups(ctx context.Context, opt *ListGroupsOptions) (*Groups, *Response, error) {
+	o := new(Groups)
+	res, err := s.client.List(ctx, groupsUrl, opt, &o)
+	return o, res, err
+}
+
+// CreateGroup will create and return a new Group based on CreateGroupModel
+func (s *GroupService) CreateGroup(ctx context.Context, newGroup *CreateGroupModel) (*Group, *Response, error) {
+	o := new(groupWrapper)
+	res, err := s.client.Post(ctx, groupsUrl, newGroup, &o)
+	return &o.Details, res, err
+}
+
+// UpdateGroup will update and return a Group matching id based on UpdateGroupModel
+func (s *GroupService) UpdateGroup(ctx context.Context, id int, group *UpdateGroupModel) (*Group, *Response, error) {
+	o := new(groupWrapper)
+	res, err := s.client.Put(ctx, fmt.Sprintf(groupIdUrl, id), group, &o)
+	return &o.Details, res, err
+}
+
+// DeleteGroup will completely remove a Group from FreshService matching id
+func (s *GroupService) DeleteGroup(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(groupIdUrl, id))
+	return success, res, err
+}
+
+// IterGroups will call fn for every Group, following pagination until fn returns false or a limit is reached
+func (s *GroupService) IterGroups(ctx context.Context, opt *ListGroupsOptions, limit *PaginationOptions, fn func(Group) bool) error {
+	o := ListGroupsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListGroups(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllGroups will return every Group by following pagination
+func (s *GroupService) ListAllGroups(ctx context.Context, opt *ListGroupsOptions, limit *PaginationOptions) ([]Group, error) {
+	var all []Group
+	err := s.IterGroups(ctx, opt, limit, func(i Group) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -28,13 +27,13 @@ type locationWrapper struct {
 
 // Location represents a FreshService Location (Physical Location)
 type Location struct {
-	ID               int       `json:"id"`
-	Name             string    `json:"name"`
-	ParentLocationID int       `json:"parent_location_id"`
-	PrimaryContactID int       `json:"primary_contact_id"`
-	Address          Address   `json:"address"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID               int     `json:"id"`
+	Name             string  `json:"name"`
+	ParentLocationID int     `json:"parent_location_id"`
+	PrimaryContactID int     `json:"primary_contact_id"`
+	Address          Address `json:"address"`
+	CreatedAt        Time    `json:"created_at"`
+	UpdatedAt        Time    `json:"updated_at"`
 }
 
 // CreateLocationModel is a data struct for creating a new Location
@@ -47,10 +46,17 @@ type CreateLocationModel struct {
 
 // UpdateLocationModel is a data struct for updating a Location
 type UpdateLocationModel struct {
-	Name             string  `json:"name"`
-	ParentLocationID int     `json:"parent_location_id"`
-	PrimaryContactID int     `json:"primary_contact_id"`
-	Address          Address `json:"address"`
+	Name             *string  `json:"name,omitempty"`
+	ParentLocationID *int     `json:"parent_location_id,omitempty"`
+	PrimaryContactID *int     `json:"primary_contact_id,omitempty"`
+	Address          *Address `json:"address,omitempty"`
+	NullFields       []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateLocationModel which are set, along with the NullFields as null
+func (m UpdateLocationModel) MarshalJSON() ([]byte, error) {
+	type model UpdateLocationModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // Address representation of a physical address
@@ -69,35 +75,65 @@ type ListLocationsOptions struct {
 }
 
 // GetLocation will return a Location by id
-func (s *LocationService) GetLocation(id int) (*Location, *http.Response, error) {
+func (s *LocationService) GetLocation(ctx context.Context, id int) (*Location, *Response, error) {
 	o := new(locationWrapper)
-	res, err := s.client.Get(fmt.Sprintf(locationIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(locationIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListLocations will return paginated/filtered Locations using ListLocationsOptions
-func (s *LocationService) ListLocations(opt *ListLocationsOptions) (*Locations, *http.Response, error) {
+func (s *LocationService) ListLocations(ctx context.Context, opt *ListLocationsOptions) (*Locations, *Response, error) {
 	o := new(Locations)
-	res, err := s.client.List(locationsUrl, opt, &o)
+	res, err := s.client.List(ctx, locationsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateLocation will create and return a new Location based on CreateLocationModel
-func (s *LocationService) CreateLocation(newLocation *CreateLocationModel) (*Location, *http.Response, error) {
+func (s *LocationService) CreateLocation(ctx context.Context, newLocation *CreateLocationModel) (*Location, *Response, error) {
 	o := new(locationWrapper)
-	res, err := s.client.Post(locationsUrl, newLocation, &o)
+	res, err := s.client.Post(ctx, locationsUrl, newLocation, &o)
 	return &o.Details, res, err
 }
 
 // UpdateLocation will update and return a Location matching id based UpdateLocationModel
-func (s *LocationService) UpdateLocation(id int, location *UpdateLocationModel) (*Location, *http.Response, error) {
+func (s *LocationService) UpdateLocation(ctx context.Context, id int, location *UpdateLocationModel) (*Location, *Response, error) {
 	o := new(locationWrapper)
-	res, err := s.client.Put(fmt.Sprintf(locationIdUrl, id), location, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(locationIdUrl, id), location, &o)
 	return &o.Details, res, err
 }
 
 // DeleteLocation will completely remove a Location from FreshService matching id
-func (s *LocationService) DeleteLocation(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(locationIdUrl, id))
+func (s *LocationService) DeleteLocation(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(locationIdUrl, id))
 	return success, res, err
 }
+
+// IterLocations will call fn for every Location matching ListLocationsOptions, following pagination until fn returns false or a limit is reached
+func (s *LocationService) IterLocations(ctx context.Context, opt *ListLocationsOptions, limit *PaginationOptions, fn func(Location) bool) error {
+	o := ListLocationsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListLocations(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllLocations will return every Location matching ListLocationsOptions by following pagination
+func (s *LocationService) ListAllLocations(ctx context.Context, opt *ListLocationsOptions, limit *PaginationOptions) ([]Location, error) {
+	var all []Location
+	err := s.IterLocations(ctx, opt, limit, func(i Location) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -0,0 +1,165 @@
+package freshservice
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	retryHttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// LogEventKind identifies the stage of a request a LogEvent was emitted for
+type LogEventKind string
+
+const (
+	LogRequestAttempt  LogEventKind = "request_attempt"
+	LogResponseAttempt LogEventKind = "response_attempt"
+	LogRequestComplete LogEventKind = "request_complete"
+	LogMessage         LogEventKind = "message"
+)
+
+// LogEvent is a structured event emitted by the Client whilst performing requests.
+// Path never contains the query string, as this can contain personal information (emails, etc).
+type LogEvent struct {
+	Kind               LogEventKind
+	Level              string
+	Message            string
+	Method             string
+	Path               string
+	StatusCode         int
+	Attempt            int
+	Duration           time.Duration
+	RateLimitTotal     int
+	RateLimitRemaining int
+	RateLimitUsed      int
+	Err                error
+}
+
+// Logger receives LogEvents from the Client, implementations must be safe for concurrent use
+type Logger interface {
+	Log(event LogEvent)
+}
+
+// LoggerFunc allows a plain function to be used as a Logger
+type LoggerFunc func(event LogEvent)
+
+// Log implements Logger
+func (f LoggerFunc) Log(event LogEvent) {
+	f(event)
+}
+
+// nopLogger is the default Logger, it discards all events
+type nopLogger struct{}
+
+func (nopLogger) Log(LogEvent) {}
+
+// SetLogger sets the Logger that will receive events for all requests, passing nil silences logging
+func (c *Client) SetLogger(l Logger) {
+	if l == nil {
+		l = nopLogger{}
+	}
+	c.logger = l
+}
+
+// logRequestAttempt is used as the retryablehttp RequestLogHook
+func (c *Client) logRequestAttempt(_ retryHttp.Logger, req *http.Request, retry int) {
+	c.logger.Log(LogEvent{
+		Kind:    LogRequestAttempt,
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Attempt: retry + 1,
+	})
+}
+
+// logResponseAttempt is used as the retryablehttp ResponseLogHook
+func (c *Client) logResponseAttempt(_ retryHttp.Logger, res *http.Response) {
+	e := LogEvent{
+		Kind:       LogResponseAttempt,
+		StatusCode: res.StatusCode,
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.Path = res.Request.URL.Path
+	}
+	setRateLimitFields(&e, res.Header)
+	c.logger.Log(e)
+}
+
+// logRequestComplete emits the final outcome of a request after any retries have taken place
+func (c *Client) logRequestComplete(req *retryHttp.Request, res *http.Response, start time.Time, err error) {
+	e := LogEvent{
+		Kind:     LogRequestComplete,
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if res != nil {
+		e.StatusCode = res.StatusCode
+		setRateLimitFields(&e, res.Header)
+	}
+	c.logger.Log(e)
+}
+
+// setRateLimitFields copies the FreshService rate-limit headers onto the LogEvent
+func setRateLimitFields(e *LogEvent, h http.Header) {
+	e.RateLimitTotal = headerInt(h, "X-RateLimit-Total")
+	e.RateLimitRemaining = headerInt(h, "X-RateLimit-Remaining")
+	e.RateLimitUsed = headerInt(h, "X-RateLimit-Used-CurrentRequest")
+}
+
+// headerInt returns the integer value of a header or 0 if missing/invalid
+func headerInt(h http.Header, key string) int {
+	v, err := strconv.Atoi(strings.TrimSpace(h.Get(key)))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// retryLogger adapts the Client Logger to the retryablehttp LeveledLogger so its retry messages are visible
+type retryLogger struct {
+	client *Client
+}
+
+func (l retryLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log("error", msg, keysAndValues)
+}
+
+func (l retryLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log("info", msg, keysAndValues)
+}
+
+func (l retryLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log("debug", msg, keysAndValues)
+}
+
+func (l retryLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log("warn", msg, keysAndValues)
+}
+
+func (l retryLogger) log(level string, msg string, keysAndValues []interface{}) {
+	var sb strings.Builder
+	sb.WriteString(msg)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		v := keysAndValues[i+1]
+		switch u := v.(type) {
+		case *url.URL:
+			v = u.Scheme + "://" + u.Host + u.Path
+		case string:
+			v = redactUrl(u)
+		case error:
+			v = redactUrl(u.Error())
+		}
+		sb.WriteString(fmt.Sprintf(" %v=%v", keysAndValues[i], v))
+	}
+
+	l.client.logger.Log(LogEvent{
+		Kind:    LogMessage,
+		Level:   level,
+		Message: sb.String(),
+	})
+}
@@ -0,0 +1,104 @@
+package freshservice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicetest"
+)
+
+// recordingLogger keeps the LogEvents it receives
+type recordingLogger struct {
+	mu     sync.Mutex
+	events []freshservice.LogEvent
+}
+
+func (l *recordingLogger) Log(e freshservice.LogEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = append(l.events, e)
+}
+
+func (l *recordingLogger) kinds(kind freshservice.LogEventKind) []freshservice.LogEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var events []freshservice.LogEvent
+	for _, e := range l.events {
+		if e.Kind == kind {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func TestLoggerRedaction(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "success", wantAttempts: 1},
+		{name: "retried", failures: 2, wantAttempts: 3},
+		{name: "failed", failures: 10, wantAttempts: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, srv := freshservicetest.New(t, freshservicetest.WithAPIKey("s3cret-key"))
+			logger := &recordingLogger{}
+			fs, err := srv.Client(freshservice.WithLogger(logger))
+			if err != nil {
+				t.Fatalf("Client: %v", err)
+			}
+			srv.FailNext(tt.failures, http.StatusBadGateway)
+
+			_, _, err = fs.Requesters.ListRequesters(context.Background(), &freshservice.ListRequestersOptions{Email: freshservice.String("jane.doe@acme.test")})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+
+			for _, e := range logger.events {
+				s := fmt.Sprintf("%+v", e)
+				if strings.Contains(s, "jane") || strings.Contains(s, "acme.test") || strings.Contains(s, "s3cret-key") {
+					t.Errorf("event leaks personal information or the API key: %s", s)
+				}
+			}
+
+			if tt.failures > 0 && len(logger.kinds(freshservice.LogMessage)) == 0 {
+				t.Errorf("the retries were not logged")
+			}
+			if got := len(logger.kinds(freshservice.LogRequestAttempt)); got != tt.wantAttempts {
+				t.Errorf("got %d request attempts, want %d", got, tt.wantAttempts)
+			}
+
+			complete := logger.kinds(freshservice.LogRequestComplete)
+			if len(complete) != 1 {
+				t.Fatalf("got %d completed requests, want 1", len(complete))
+			}
+			c := complete[0]
+			if c.Method != http.MethodGet || c.Path != "/api/v2/requesters" || (c.Err != nil) != tt.wantErr {
+				t.Errorf("got completion %s %s with error %v", c.Method, c.Path, c.Err)
+			}
+			if !tt.wantErr && (c.StatusCode != http.StatusOK || c.RateLimitTotal == 0) {
+				t.Errorf("got status %d and rate limit total %d, want 200 and the rate limit headers", c.StatusCode, c.RateLimitTotal)
+			}
+		})
+	}
+}
+
+func TestSetLoggerNil(t *testing.T) {
+	fs, _ := freshservicetest.New(t)
+	fs.SetLogger(nil)
+
+	if _, _, err := fs.Tickets.ListTickets(context.Background(), nil); err != nil {
+		t.Fatalf("ListTickets: %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+package freshservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// attachmentsFormKey is the form field name FreshService expects attachments to be uploaded as
+const attachmentsFormKey = "attachments[]"
+
+// MaxAttachmentsSize is the largest combined size of attachments FreshService accepts on a single request (15 MB)
+const MaxAttachmentsSize = 15 * 1024 * 1024
+
+// ErrAttachmentsTooLarge is returned (wrapped) when the combined attachments exceed MaxAttachmentsSize
+var ErrAttachmentsTooLarge = errors.New("attachments exceed the 15 MB limit")
+
+// namedReader allows a name to be given to an io.Reader used as an attachment
+type namedReader struct {
+	io.Reader
+	name string
+}
+
+// Name returns the file name of the attachment
+func (n namedReader) Name() string {
+	return n.name
+}
+
+// Seek allows for the attachment to be re-sent on retry when the underlying io.Reader supports it
+func (n namedReader) Seek(offset int64, whence int) (int64, error) {
+	if s, ok := n.Reader.(io.Seeker); ok {
+		return s.Seek(offset, whence)
+	}
+	return 0, fmt.Errorf("attachment %s cannot be re-read", n.name)
+}
+
+// NamedReader gives r a file name to be used when it is uploaded as an attachment.
+// Readers with a Name method (such as *os.File) do not need this.
+func NamedReader(name string, r io.Reader) io.Reader {
+	return namedReader{Reader: r, name: name}
+}
+
+// multipartBody is a multipart/form-data request body made up of form fields and attachments,
+// the attachments are streamed rather than buffered in memory.
+type multipartBody struct {
+	fields   [][2]string
+	fileKey  string
+	files    []io.Reader
+	boundary string
+
+	mu       sync.Mutex
+	attempts int
+	// pr and done are the pipe and completion of the writer of the latest attempt
+	pr   *io.PipeReader
+	done chan struct{}
+}
+
+// newMultipartBody flattens the fields of body into form fields and checks the size of the attachments where known
+func newMultipartBody(body interface{}, fileKey string, files []io.Reader) (*multipartBody, error) {
+	fields, err := formFields(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	for _, f := range files {
+		if f == nil {
+			return nil, fmt.Errorf("attachment must not be nil")
+		}
+		total += readerSize(f)
+	}
+	if total > MaxAttachmentsSize {
+		return nil, fmt.Errorf("%w: %d bytes provided", ErrAttachmentsTooLarge, total)
+	}
+
+	return &multipartBody{
+		fields:   fields,
+		fileKey:  fileKey,
+		files:    files,
+		boundary: multipart.NewWriter(io.Discard).Boundary(),
+	}, nil
+}
+
+// contentType returns the Content-Type header value including the boundary
+func (m *multipartBody) contentType() string {
+	return "multipart/form-data; boundary=" + m.boundary
+}
+
+// reader is used as the retryablehttp ReaderFunc, it is called once per attempt
+func (m *multipartBody) reader() (io.Reader, error) {
+	return &lazyReader{open: m.open}, nil
+}
+
+// open starts streaming the body, rewinding the attachments if this is a retry
+func (m *multipartBody) open() (io.Reader, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.attempts++
+	retry := m.attempts > 1
+
+	// the writer of the previous attempt may still be copying the attachments (the body can be closed asynchronously),
+	// stop it and wait for it to exit before rewinding them
+	if m.done != nil {
+		m.pr.CloseWithError(errors.New("request is being retried"))
+		<-m.done
+	}
+
+	if retry {
+		for i, f := range m.files {
+			s, ok := f.(io.Seeker)
+			if !ok {
+				return nil, fmt.Errorf("attachment %s cannot be re-read to retry the request", fileName(f, i))
+			}
+			if _, err := s.Seek(0, io.SeekStart); err != nil {
+				return nil, fmt.Errorf("unable to rewind attachment %s: %v", fileName(f, i), err)
+			}
+		}
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pw.CloseWithError(m.write(pw))
+	}()
+	m.pr, m.done = pr, done
+
+	return pr, nil
+}
+
+// write produces the multipart body, failing once more than MaxAttachmentsSize of attachments has been written
+func (m *multipartBody) write(w io.Writer) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(m.boundary); err != nil {
+		return err
+	}
+
+	for _, f := range m.fields {
+		if err := mw.WriteField(f[0], f[1]); err != nil {
+			return err
+		}
+	}
+
+	var total int64
+	for i, f := range m.files {
+		part, err := mw.CreateFormFile(m.fileKey, fileName(f, i))
+		if err != nil {
+			return err
+		}
+
+		remaining := MaxAttachmentsSize - total
+		n, err := io.Copy(part, io.LimitReader(f, remaining+1))
+		if err != nil {
+			return fmt.Errorf("unable to read attachment %s: %v", fileName(f, i), err)
+		}
+		total += n
+		if total > MaxAttachmentsSize {
+			return fmt.Errorf("%w: more than %d bytes provided", ErrAttachmentsTooLarge, int64(MaxAttachmentsSize))
+		}
+	}
+
+	return mw.Close()
+}
+
+// lazyReader defers opening the body until it is first read, as retryablehttp creates (and discards) a reader upfront
+type lazyReader struct {
+	open func() (io.Reader, error)
+	r    io.Reader
+}
+
+func (l *lazyReader) Read(p []byte) (int, error) {
+	if l.r == nil {
+		r, err := l.open()
+		if err != nil {
+			return 0, err
+		}
+		l.r = r
+	}
+	return l.r.Read(p)
+}
+
+// Close stops streaming the body if it was started
+func (l *lazyReader) Close() error {
+	if c, ok := l.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// readerSize returns the size of r where it can be determined without reading it, otherwise 0
+func readerSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case namedReader:
+		return readerSize(v.Reader)
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case interface{ Stat() (os.FileInfo, error) }:
+		if fi, err := v.Stat(); err == nil {
+			return fi.Size()
+		}
+	}
+	return 0
+}
+
+// fileName returns the name of the attachment, falling back to a generated one
+func fileName(r io.Reader, i int) string {
+	if n, ok := r.(interface{ Name() string }); ok && n.Name() != "" {
+		return filepath.Base(n.Name())
+	}
+	return fmt.Sprintf("attachment-%d", i+1)
+}
+
+// formFields flattens a model into form fields using its JSON representation, arrays use the `name[]` form and
+// objects the `name[key]` form as expected by FreshService. Null values are skipped.
+func formFields(v interface{}) ([][2]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error formatting body for request: %v", err)
+	}
+
+	var m map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&m); err != nil {
+		return nil, fmt.Errorf("error formatting body for request: %v", err)
+	}
+
+	var fields [][2]string
+	appendFormFields(&fields, "", m)
+	return fields, nil
+}
+
+func appendFormFields(fields *[][2]string, key string, v interface{}) {
+	switch val := v.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			name := k
+			if key != "" {
+				name = fmt.Sprintf("%s[%s]", key, k)
+			}
+			appendFormFields(fields, name, val[k])
+		}
+	case []interface{}:
+		for _, i := range val {
+			appendFormFields(fields, key+"[]", i)
+		}
+	case string:
+		*fields = append(*fields, [2]string{key, val})
+	default:
+		*fields = append(*fields, [2]string{key, fmt.Sprint(val)})
+	}
+}
@@ -0,0 +1,204 @@
+package freshservice_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+// upload is an attempt received by the multipart test server
+type upload struct {
+	subject string
+	files   map[string][]byte
+}
+
+// onlyReader hides any io.Seeker of the underlying reader
+type onlyReader struct {
+	io.Reader
+}
+
+func TestCreateTicketWithAttachments(t *testing.T) {
+	logo := bytes.Repeat([]byte{0x89, 'P', 'N', 'G'}, 1024)
+	large := bytes.Repeat([]byte("log line\n"), 512*1024)
+
+	tests := []struct {
+		name     string
+		files    func() []io.Reader
+		failures int
+		// unread fails the attempts without reading the body, so the writer is still streaming when the request is retried
+		unread       bool
+		wantAttempts int
+		wantFiles    map[string][]byte
+		wantErr      string
+	}{
+		{
+			name:         "single attempt",
+			files:        func() []io.Reader { return []io.Reader{freshservice.NamedReader("logo.png", bytes.NewReader(logo))} },
+			wantAttempts: 1,
+			wantFiles:    map[string][]byte{"logo.png": logo},
+		},
+		{
+			name: "attachments are rewound on retry",
+			files: func() []io.Reader {
+				return []io.Reader{freshservice.NamedReader("logo.png", bytes.NewReader(logo)), bytes.NewReader([]byte("notes"))}
+			},
+			failures:     2,
+			wantAttempts: 3,
+			wantFiles:    map[string][]byte{"logo.png": logo, "attachment-2": []byte("notes")},
+		},
+		{
+			name:         "retry while the previous attempt is streaming",
+			files:        func() []io.Reader { return []io.Reader{freshservice.NamedReader("server.log", bytes.NewReader(large))} },
+			failures:     2,
+			unread:       true,
+			wantAttempts: 3,
+			wantFiles:    map[string][]byte{"server.log": large},
+		},
+		{
+			name: "attachments which can't be rewound",
+			files: func() []io.Reader {
+				return []io.Reader{freshservice.NamedReader("logo.png", onlyReader{bytes.NewReader(logo)})}
+			},
+			failures:     1,
+			wantAttempts: 1,
+			wantErr:      "unable to rewind attachment logo.png: attachment logo.png cannot be re-read",
+		},
+		{
+			name:         "unnamed attachments which can't be rewound",
+			files:        func() []io.Reader { return []io.Reader{onlyReader{bytes.NewReader(logo)}} },
+			failures:     1,
+			wantAttempts: 1,
+			wantErr:      "attachment attachment-1 cannot be re-read to retry the request",
+		},
+		{
+			name: "too large",
+			files: func() []io.Reader {
+				return []io.Reader{bytes.NewReader(make([]byte, freshservice.MaxAttachmentsSize+1))}
+			},
+			wantAttempts: 0,
+			wantErr:      freshservice.ErrAttachmentsTooLarge.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var attempts []upload
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				attempt := len(attempts) + 1
+				if tt.unread && attempt <= tt.failures {
+					attempts = append(attempts, upload{})
+					mu.Unlock()
+					w.Header().Set("Connection", "close")
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				mu.Unlock()
+
+				u := upload{files: map[string][]byte{}}
+				// a retry whose attachments can't be rewound is aborted mid-body, bodies which can't be read are not
+				// recorded so the attempts and files checks catch the real failures
+				mr, err := r.MultipartReader()
+				if err != nil {
+					return
+				}
+				for {
+					part, err := mr.NextPart()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						return
+					}
+					b, err := ioutil.ReadAll(part)
+					if err != nil {
+						return
+					}
+					switch {
+					case part.FormName() == "subject":
+						u.subject = string(b)
+					case part.FormName() == "attachments[]":
+						u.files[part.FileName()] = b
+					}
+				}
+
+				mu.Lock()
+				attempts = append(attempts, u)
+				mu.Unlock()
+
+				if attempt <= tt.failures {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"ticket": {"id": 1, "subject": "Printer on fire"}}`))
+			}))
+			defer ts.Close()
+
+			fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL(ts.URL), freshservice.WithRetryPolicy(3, 0, 0))
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			model := &freshservice.CreateTicketModel{Subject: "Printer on fire", Email: "jane@acme.test", Priority: freshservice.PriorityLow, Status: freshservice.TicketOpen}
+			_, _, err = fs.Tickets.CreateTicketWithAttachments(context.Background(), model, tt.files()...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("CreateTicketWithAttachments: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(attempts) != tt.wantAttempts {
+				t.Fatalf("got %d attempts, want %d", len(attempts), tt.wantAttempts)
+			}
+			if tt.wantFiles == nil {
+				return
+			}
+
+			// every attempt which was read must carry the whole body
+			for i, u := range attempts {
+				if tt.unread && i < tt.failures {
+					continue
+				}
+				if u.subject != "Printer on fire" {
+					t.Errorf("attempt %d: got subject %q", i+1, u.subject)
+				}
+				if len(u.files) != len(tt.wantFiles) {
+					t.Errorf("attempt %d: got %d attachments, want %d", i+1, len(u.files), len(tt.wantFiles))
+				}
+				for name, want := range tt.wantFiles {
+					if !bytes.Equal(u.files[name], want) {
+						t.Errorf("attempt %d: got %d bytes for %s, want %d", i+1, len(u.files[name]), name, len(want))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestAttachmentsTooLargeIsWrapped(t *testing.T) {
+	fs, err := freshservice.NewClient(nil, "acme", "key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, _, err = fs.Tickets.ReplyWithAttachments(context.Background(), 1, &freshservice.CreateReplyModel{Body: "logs"},
+		bytes.NewReader(make([]byte, freshservice.MaxAttachmentsSize/2+1)), strings.NewReader(strings.Repeat("x", freshservice.MaxAttachmentsSize/2+1)))
+	if !errors.Is(err, freshservice.ErrAttachmentsTooLarge) {
+		t.Errorf("got error %v, want ErrAttachmentsTooLarge", err)
+	}
+}
@@ -1,7 +1,5 @@
 package freshservice
 
-import "time"
-
 // Notes contains Collection an array of Note
 type Notes struct {
 	Collection []Note `json:"notes"`
@@ -14,13 +12,13 @@ type noteWrapper struct {
 
 // Note represents a Note attached to a Change
 type Note struct {
-	ID           int       `json:"id"`
-	UserID       int       `json:"user_id"`
-	Body         string    `json:"body"`
-	BodyText     string    `json:"body_text"`
-	NotifyEmails []string  `json:"notify_emails"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           int      `json:"id"`
+	UserID       int      `json:"user_id"`
+	Body         string   `json:"body"`
+	BodyText     string   `json:"body_text"`
+	NotifyEmails []string `json:"notify_emails"`
+	CreatedAt    Time     `json:"created_at"`
+	UpdatedAt    Time     `json:"updated_at"`
 }
 
 // UpsertNoteModel is a data struct for creating/updating Note
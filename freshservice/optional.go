@@ -0,0 +1,79 @@
+package freshservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// The fields of update models are pointers so that only the fields which are set are sent, leaving everything else
+// untouched (enums, Time and Date have a Ptr method for this). Fields can be cleared by listing them (by Go or JSON
+// name) in the NullFields of the model, e.g.
+//
+//	&UpdateTicketModel{Status: TicketResolved.Ptr(), Subject: String("Printer on fire"), NullFields: []string{"ResponderID"}}
+
+// String returns a pointer to v, for setting a field of an update model
+func String(v string) *string {
+	return &v
+}
+
+// Int returns a pointer to v, for setting a field of an update model
+func Int(v int) *int {
+	return &v
+}
+
+// Bool returns a pointer to v, for setting a field of an update model
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Float32 returns a pointer to v, for setting a field of an update model
+func Float32(v float32) *float32 {
+	return &v
+}
+
+// TimePtr returns a pointer to v as a Time, for setting a field of an update model
+func TimePtr(v time.Time) *Time {
+	return NewTime(v).Ptr()
+}
+
+// marshalUpdate marshals an update model, v must not implement json.Marshaler itself (use a local type).
+// The fields listed in nullFields are sent as null, whether or not they have been set.
+func marshalUpdate(v interface{}, nullFields []string) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(nullFields) == 0 {
+		return b, err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	for _, name := range nullFields {
+		key, ok := jsonFieldName(reflect.TypeOf(v), name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %s in NullFields", name)
+		}
+		m[key] = json.RawMessage("null")
+	}
+
+	return json.Marshal(m)
+}
+
+// jsonFieldName returns the JSON name of the field of struct t matching name by Go or JSON name
+func jsonFieldName(t reflect.Type, name string) (string, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key := strings.Split(f.Tag.Get("json"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+		if f.Name == name || key == name {
+			return key, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,90 @@
+package freshservice_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+func TestUpdateTicketModelMarshalJSON(t *testing.T) {
+	due := time.Date(2021, 7, 1, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		model   freshservice.UpdateTicketModel
+		want    string
+		wantErr string
+	}{
+		{name: "nothing set", model: freshservice.UpdateTicketModel{}, want: `{}`},
+		{
+			name:  "only set fields",
+			model: freshservice.UpdateTicketModel{Status: freshservice.TicketResolved.Ptr(), Subject: freshservice.String("Printer on fire")},
+			want:  `{"status": 4, "subject": "Printer on fire"}`,
+		},
+		{
+			name:  "zero values are sent when set",
+			model: freshservice.UpdateTicketModel{GroupID: freshservice.Int(0), Subject: freshservice.String("")},
+			want:  `{"group_id": 0, "subject": ""}`,
+		},
+		{
+			name:  "time",
+			model: freshservice.UpdateTicketModel{DueBy: freshservice.TimePtr(due)},
+			want:  `{"due_by": "2021-07-01T15:04:05Z"}`,
+		},
+		{
+			name:  "null fields by Go name",
+			model: freshservice.UpdateTicketModel{Priority: freshservice.PriorityHigh.Ptr(), NullFields: []string{"ResponderID"}},
+			want:  `{"priority": 3, "responder_id": null}`,
+		},
+		{
+			name:  "null fields by JSON name",
+			model: freshservice.UpdateTicketModel{NullFields: []string{"group_id", "due_by"}},
+			want:  `{"group_id": null, "due_by": null}`,
+		},
+		{
+			name:  "null fields win over set fields",
+			model: freshservice.UpdateTicketModel{GroupID: freshservice.Int(3), NullFields: []string{"GroupID"}},
+			want:  `{"group_id": null}`,
+		},
+		{
+			name:  "custom fields",
+			model: freshservice.UpdateTicketModel{CustomFields: freshservice.CustomFields{"team": "Ops"}, NullFields: []string{"Category"}},
+			want:  `{"custom_fields": {"team": "Ops"}, "category": null}`,
+		},
+		{
+			name:    "unknown null field",
+			model:   freshservice.UpdateTicketModel{NullFields: []string{"Nope"}},
+			wantErr: "unknown field Nope in NullFields",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.model)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+
+			var got, want interface{}
+			if err = json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("invalid JSON %s: %v", b, err)
+			}
+			if err = json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatalf("invalid want %s: %v", tt.want, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
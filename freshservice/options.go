@@ -0,0 +1,113 @@
+package freshservice
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ClientOption allows for the defaults of the Client to be overridden when calling NewClient
+type ClientOption func(*Client) error
+
+// WithHTTPClient sets the http.Client used to perform requests (retries are still handled by the Client)
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return fmt.Errorf("http client must not be nil")
+		}
+		c.client.HTTPClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the http.RoundTripper of the http.Client, useful for proxies and mTLS.
+// It is applied once all options have run, to a copy of the http.Client (including one given to WithHTTPClient).
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		if transport == nil {
+			return fmt.Errorf("transport must not be nil")
+		}
+		c.transport = transport
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of each individual request attempt made by the http.Client.
+// It is applied once all options have run, to a copy of the http.Client (including one given to WithHTTPClient).
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative")
+		}
+		c.timeout = &timeout
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the maximum number of retries along with the minimum and maximum wait between them.
+// Rate-limited requests will still wait for the duration given by the Retry-After header.
+func WithRetryPolicy(retryMax int, waitMin time.Duration, waitMax time.Duration) ClientOption {
+	return func(c *Client) error {
+		if retryMax < 0 {
+			return fmt.Errorf("retry max must not be negative")
+		}
+		if waitMin < 0 || waitMax < waitMin {
+			return fmt.Errorf("retry wait min must not be negative or greater than wait max")
+		}
+		c.client.RetryMax = retryMax
+		c.client.RetryWaitMin = waitMin
+		c.client.RetryWaitMax = waitMax
+		return nil
+	}
+}
+
+// WithBaseURL sets the full url of the API (e.g. https://fresh.my.corp/api/v2/), replacing the one derived from the sub-domain
+func WithBaseURL(baseUrl string) ClientOption {
+	return func(c *Client) error {
+		u, err := parseBaseUrl(baseUrl)
+		if err != nil {
+			return err
+		}
+		c.baseUrl = u
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each request
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) error {
+		if ua == "" {
+			return fmt.Errorf("user agent must not be empty")
+		}
+		c.UserAgent = ua
+		return nil
+	}
+}
+
+// WithLogger sets the Logger that will receive events for all requests
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) error {
+		c.SetLogger(l)
+		return nil
+	}
+}
+
+// WithRateLimit paces requests to perMinute (e.g. RateLimitPro) from the start, rather than from the first response
+func WithRateLimit(perMinute int) ClientOption {
+	return func(c *Client) error {
+		if perMinute < 0 {
+			return fmt.Errorf("rate limit must not be negative")
+		}
+		c.limiter = NewRateLimiter(perMinute)
+		return nil
+	}
+}
+
+// WithRateLimiter sets the RateLimiter used by the Client, allowing it to be shared between Clients for the same account.
+// Passing nil disables client-side rate limiting.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) error {
+		c.limiter = limiter
+		return nil
+	}
+}
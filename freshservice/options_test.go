@@ -0,0 +1,107 @@
+package freshservice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+// countingTransport counts the requests sent through it
+type countingTransport struct {
+	n int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.n, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientOptionsOrder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/tickets/2" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ticket": {"id": 1}}`))
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name  string
+		order func(hc *http.Client, rt http.RoundTripper) []freshservice.ClientOption
+	}{
+		{
+			name: "http client first",
+			order: func(hc *http.Client, rt http.RoundTripper) []freshservice.ClientOption {
+				return []freshservice.ClientOption{freshservice.WithHTTPClient(hc), freshservice.WithTransport(rt), freshservice.WithTimeout(50 * time.Millisecond)}
+			},
+		},
+		{
+			name: "http client last",
+			order: func(hc *http.Client, rt http.RoundTripper) []freshservice.ClientOption {
+				return []freshservice.ClientOption{freshservice.WithTransport(rt), freshservice.WithTimeout(50 * time.Millisecond), freshservice.WithHTTPClient(hc)}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := &http.Client{}
+			rt := &countingTransport{}
+			opts := append([]freshservice.ClientOption{
+				freshservice.WithBaseURL(ts.URL + "/api/v2"),
+				freshservice.WithRetryPolicy(0, 0, 0),
+			}, tt.order(hc, rt)...)
+
+			fs, err := freshservice.NewClient(nil, "", "key", opts...)
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			if _, _, err = fs.Tickets.GetTicket(context.Background(), 1); err != nil {
+				t.Fatalf("GetTicket: %v", err)
+			}
+			if rt.n != 1 {
+				t.Errorf("got %d requests through the transport, want 1", rt.n)
+			}
+
+			if _, _, err = fs.Tickets.GetTicket(context.Background(), 2); err == nil || !strings.Contains(err.Error(), "Timeout") {
+				t.Errorf("got error %v, want the request to time out", err)
+			}
+			if hc.Timeout != 0 || hc.Transport != nil {
+				t.Errorf("the http.Client given to WithHTTPClient was modified")
+			}
+		})
+	}
+}
+
+func TestClientOptionsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opt     freshservice.ClientOption
+		wantErr string
+	}{
+		{name: "nil http client", opt: freshservice.WithHTTPClient(nil), wantErr: "http client must not be nil"},
+		{name: "nil transport", opt: freshservice.WithTransport(nil), wantErr: "transport must not be nil"},
+		{name: "negative timeout", opt: freshservice.WithTimeout(-time.Second), wantErr: "timeout must not be negative"},
+		{name: "negative retries", opt: freshservice.WithRetryPolicy(-1, 0, 0), wantErr: "retry max must not be negative"},
+		{name: "wait min over max", opt: freshservice.WithRetryPolicy(1, time.Second, time.Millisecond), wantErr: "retry wait min"},
+		{name: "empty user agent", opt: freshservice.WithUserAgent(""), wantErr: "user agent must not be empty"},
+		{name: "negative rate limit", opt: freshservice.WithRateLimit(-1), wantErr: "rate limit must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := freshservice.NewClient(nil, "acme", "key", tt.opt)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
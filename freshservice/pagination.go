@@ -0,0 +1,129 @@
+package freshservice
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const maxPerPage = 100
+
+// PaginationOptions limits how far the Iter/ListAll helpers will follow the pagination links.
+// A zero value for any field means no limit (or the API default for PerPage).
+type PaginationOptions struct {
+	PerPage  int
+	MaxItems int
+	MaxPages int
+}
+
+// paginator keeps track of the position of an Iter helper whilst it walks the pages of a List endpoint
+type paginator struct {
+	opt   *ListOptions
+	limit PaginationOptions
+	items int
+	pages int
+}
+
+// newPaginator prepares the ListOptions for the first request based on the PaginationOptions
+func newPaginator(opt *ListOptions, limit *PaginationOptions) *paginator {
+	p := &paginator{opt: opt}
+	if limit != nil {
+		p.limit = *limit
+	}
+
+	if p.limit.PerPage > 0 {
+		opt.PerPage = p.limit.PerPage
+	}
+	if opt.PerPage > maxPerPage {
+		opt.PerPage = maxPerPage
+	}
+	if opt.Page < 1 {
+		opt.Page = 1
+	}
+
+	return p
+}
+
+// yield reports whether another item may be handed to the caller, counting it towards MaxItems
+func (p *paginator) yield() bool {
+	if p.limit.MaxItems > 0 && p.items >= p.limit.MaxItems {
+		return false
+	}
+	p.items++
+	return true
+}
+
+// next moves the ListOptions on to the page referenced by the Link header, reporting false when finished
+func (p *paginator) next(res *Response) bool {
+	p.pages++
+	if p.limit.MaxPages > 0 && p.pages >= p.limit.MaxPages {
+		return false
+	}
+	if p.limit.MaxItems > 0 && p.items >= p.limit.MaxItems {
+		return false
+	}
+
+	if res == nil || res.NextPage == 0 {
+		return false
+	}
+
+	p.opt.Page = res.NextPage
+	return true
+}
+
+// pageFunc fetches the page currently set in the ListOptions, passing each item to the caller while yield allows it.
+// It reports whether the caller wants more items.
+type pageFunc func(yield func() bool) (res *Response, more bool, err error)
+
+// paginate follows the pagination of a List endpoint for the Iter helpers, calling page until the caller stops,
+// a limit is reached or there are no more pages
+func paginate(opt *ListOptions, limit *PaginationOptions, page pageFunc) error {
+	p := newPaginator(opt, limit)
+	for {
+		res, more, err := page(p.yield)
+		if err != nil {
+			return err
+		}
+		if !more || !p.next(res) {
+			return nil
+		}
+	}
+}
+
+// nextPage returns the page number of the rel="next" entry of the Link header, or 0 when there is none
+func nextPage(res *http.Response) int {
+	if res == nil {
+		return 0
+	}
+
+	for _, link := range strings.Split(res.Header.Get("Link"), ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		isNext := false
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				isNext = true
+			}
+		}
+		if !isNext {
+			continue
+		}
+
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(parts[0]), "<>"))
+		if err != nil {
+			return 0
+		}
+
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			return 0
+		}
+		return page
+	}
+
+	return 0
+}
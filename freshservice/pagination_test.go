@@ -0,0 +1,166 @@
+package freshservice_test
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicetest"
+)
+
+func seedTickets(t *testing.T, srv *freshservicetest.Server, n int, fields map[string]interface{}) {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		ticket := map[string]interface{}{"subject": "Printer on fire", "status": 2, "priority": 1}
+		for k, v := range fields {
+			ticket[k] = v
+		}
+		if _, err := srv.Seed("tickets", ticket); err != nil {
+			t.Fatalf("unable to seed ticket: %v", err)
+		}
+	}
+}
+
+func TestIterTicketsPagination(t *testing.T) {
+	tests := []struct {
+		name         string
+		limit        *freshservice.PaginationOptions
+		stopAfter    int
+		wantItems    int
+		wantRequests int
+		wantPerPage  string
+	}{
+		{name: "follows every page", wantItems: 120, wantRequests: 4, wantPerPage: ""},
+		{name: "per page", limit: &freshservice.PaginationOptions{PerPage: 50}, wantItems: 120, wantRequests: 3, wantPerPage: "50"},
+		{name: "per page capped at 100", limit: &freshservice.PaginationOptions{PerPage: 500}, wantItems: 120, wantRequests: 2, wantPerPage: "100"},
+		{name: "max items within a page", limit: &freshservice.PaginationOptions{PerPage: 100, MaxItems: 10}, wantItems: 10, wantRequests: 1, wantPerPage: "100"},
+		{name: "max items at a page boundary", limit: &freshservice.PaginationOptions{PerPage: 25, MaxItems: 50}, wantItems: 50, wantRequests: 2, wantPerPage: "25"},
+		{name: "max pages", limit: &freshservice.PaginationOptions{MaxPages: 2}, wantItems: 60, wantRequests: 2, wantPerPage: ""},
+		{name: "stopped by fn", stopAfter: 5, wantItems: 5, wantRequests: 1, wantPerPage: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, srv := freshservicetest.New(t)
+			seedTickets(t, srv, 120, nil)
+
+			items := 0
+			err := fs.Tickets.IterTickets(context.Background(), nil, tt.limit, func(freshservice.Ticket) bool {
+				items++
+				return tt.stopAfter == 0 || items < tt.stopAfter
+			})
+			if err != nil {
+				t.Fatalf("IterTickets: %v", err)
+			}
+
+			if items != tt.wantItems {
+				t.Errorf("got %d tickets, want %d", items, tt.wantItems)
+			}
+
+			requests := srv.Requests()
+			if len(requests) != tt.wantRequests {
+				t.Fatalf("got %d requests, want %d", len(requests), tt.wantRequests)
+			}
+			for i, r := range requests {
+				q, _ := url.ParseQuery(r.Query)
+				if got := q.Get("page"); got != strconv.Itoa(i+1) {
+					t.Errorf("request %d: got page %q, want %d", i+1, got, i+1)
+				}
+				if got := q.Get("per_page"); got != tt.wantPerPage {
+					t.Errorf("request %d: got per_page %q, want %q", i+1, got, tt.wantPerPage)
+				}
+			}
+		})
+	}
+}
+
+func TestListTicketsNextPage(t *testing.T) {
+	fs, srv := freshservicetest.New(t)
+	seedTickets(t, srv, 45, nil)
+
+	tests := []struct {
+		page     int
+		wantLen  int
+		wantNext int
+	}{
+		{page: 1, wantLen: 30, wantNext: 2},
+		{page: 2, wantLen: 15, wantNext: 0},
+	}
+
+	for _, tt := range tests {
+		list, res, err := fs.Tickets.ListTickets(context.Background(), &freshservice.ListTicketsOptions{
+			ListOptions: freshservice.ListOptions{Page: tt.page},
+		})
+		if err != nil {
+			t.Fatalf("ListTickets page %d: %v", tt.page, err)
+		}
+		if len(list.Collection) != tt.wantLen {
+			t.Errorf("page %d: got %d tickets, want %d", tt.page, len(list.Collection), tt.wantLen)
+		}
+		if res.NextPage != tt.wantNext {
+			t.Errorf("page %d: got NextPage %d, want %d", tt.page, res.NextPage, tt.wantNext)
+		}
+	}
+}
+
+func TestListAllFilterTickets(t *testing.T) {
+	tests := []struct {
+		name         string
+		limit        *freshservice.PaginationOptions
+		wantItems    int
+		wantRequests int
+	}{
+		{name: "every match", wantItems: 70, wantRequests: 3},
+		{name: "max items", limit: &freshservice.PaginationOptions{MaxItems: 30}, wantItems: 30, wantRequests: 1},
+		{name: "max pages", limit: &freshservice.PaginationOptions{MaxPages: 2}, wantItems: 60, wantRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, srv := freshservicetest.New(t)
+			seedTickets(t, srv, 70, map[string]interface{}{"priority": 4})
+			seedTickets(t, srv, 20, map[string]interface{}{"priority": 1})
+
+			q := freshservice.Q.Eq("priority", freshservice.PriorityUrgent).And(freshservice.Q.Eq("status", freshservice.TicketOpen))
+			tickets, err := fs.Tickets.ListAllFilterTickets(context.Background(), q, tt.limit)
+			if err != nil {
+				t.Fatalf("ListAllFilterTickets: %v", err)
+			}
+
+			if len(tickets) != tt.wantItems {
+				t.Errorf("got %d tickets, want %d", len(tickets), tt.wantItems)
+			}
+			for _, ticket := range tickets {
+				if ticket.Priority != freshservice.PriorityUrgent {
+					t.Fatalf("ticket %d does not match the filter: priority %s", ticket.ID, ticket.Priority)
+				}
+			}
+			if got := len(srv.Requests()); got != tt.wantRequests {
+				t.Errorf("got %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestListAllVendors(t *testing.T) {
+	fs, srv := freshservicetest.New(t)
+	for i := 0; i < 35; i++ {
+		if _, err := srv.Seed("vendors", map[string]interface{}{"name": "Vendor " + strconv.Itoa(i)}); err != nil {
+			t.Fatalf("unable to seed vendor: %v", err)
+		}
+	}
+
+	vendors, err := fs.Vendors.ListAllVendors(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatalf("ListAllVendors: %v", err)
+	}
+	if len(vendors) != 35 || vendors[34].Name != "Vendor 34" {
+		t.Errorf("got %d vendors, want 35 ending with Vendor 34", len(vendors))
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
@@ -1,8 +1,8 @@
 package freshservice
 
 import (
+    "context"
     "fmt"
-    "net/http"
     "time"
 )
 
@@ -41,20 +41,21 @@ type Problem struct {
     GroupID          int             `json:"group_id"`
     Description      string          `json:"description"`
     DescriptionText  string          `json:"description_text"`
-    Priority         int             `json:"priority"`
-    Status           int             `json:"status"`
-    Impact           int             `json:"impact"`
+    Priority         Priority        `json:"priority"`
+    Status           ProblemStatus   `json:"status"`
+    Impact           Impact          `json:"impact"`
     KnownError       bool            `json:"known_error"`
     Subject          string          `json:"subject"`
-    DueBy            time.Time       `json:"due_by"`
+    DueBy            Time            `json:"due_by"`
     DepartmentID     int             `json:"department_id"`
     Category         string          `json:"category"`
     SubCategory      string          `json:"sub_category"`
     ItemCategory     string          `json:"item_category"`
     AssociatedChange int             `json:"associated_change"`
     AnalysisFields   ProblemAnalysis `json:"analysis_fields,omitempty"`
-    CreatedAt        time.Time       `json:"created_at"`
-    UpdatedAt        time.Time       `json:"updated_at"`
+    CreatedAt        Time            `json:"created_at"`
+    UpdatedAt        Time            `json:"updated_at"`
+    CustomFields     CustomFields    `json:"custom_fields"`
 }
 
 // ProblemAnalysis is a data structure
@@ -69,77 +70,117 @@ type CreateProblemModel struct {
     AgentID        int             `json:"agent_id"`
     GroupID        int             `json:"group_id"`
     Description    string          `json:"description"`
-    Priority       int             `json:"priority"`
-    Status         int             `json:"status"`
-    Impact         int             `json:"impact"`
+    Priority       Priority        `json:"priority"`
+    Status         ProblemStatus   `json:"status"`
+    Impact         Impact          `json:"impact"`
     Subject        string          `json:"subject"`
-    DueBy          time.Time       `json:"due_by"`
+    DueBy          Time            `json:"due_by"`
     DepartmentID   int             `json:"department_id"`
     Category       string          `json:"category"`
     SubCategory    string          `json:"sub_category"`
     ItemCategory   string          `json:"item_category"`
     AnalysisFields ProblemAnalysis `json:"analysis_fields,omitempty"`
+    CustomFields   CustomFields    `json:"custom_fields,omitempty"`
 }
 
 // UpdateProblemModel is the data structure required for updating a Problem
 type UpdateProblemModel struct {
-    AgentID        int             `json:"agent_id"`
-    GroupID        int             `json:"group_id"`
-    Description    string          `json:"description"`
-    Priority       int             `json:"priority"`
-    Status         int             `json:"status"`
-    Impact         int             `json:"impact"`
-    Subject        string          `json:"subject"`
-    DueBy          time.Time       `json:"due_by"`
-    DepartmentID   int             `json:"department_id"`
-    Category       string          `json:"category"`
-    SubCategory    string          `json:"sub_category"`
-    ItemCategory   string          `json:"item_category"`
-    AnalysisFields ProblemAnalysis `json:"analysis_fields,omitempty"`
+    AgentID        *int             `json:"agent_id,omitempty"`
+    GroupID        *int             `json:"group_id,omitempty"`
+    Description    *string          `json:"description,omitempty"`
+    Priority       *Priority        `json:"priority,omitempty"`
+    Status         *ProblemStatus   `json:"status,omitempty"`
+    Impact         *Impact          `json:"impact,omitempty"`
+    Subject        *string          `json:"subject,omitempty"`
+    DueBy          *Time            `json:"due_by,omitempty"`
+    DepartmentID   *int             `json:"department_id,omitempty"`
+    Category       *string          `json:"category,omitempty"`
+    SubCategory    *string          `json:"sub_category,omitempty"`
+    ItemCategory   *string          `json:"item_category,omitempty"`
+    AnalysisFields *ProblemAnalysis `json:"analysis_fields,omitempty"`
+    CustomFields   CustomFields     `json:"custom_fields,omitempty"`
+    NullFields     []string         `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateProblemModel which are set, along with the NullFields as null
+func (m UpdateProblemModel) MarshalJSON() ([]byte, error) {
+    type model UpdateProblemModel
+    return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListProblemsOptions represents filters/pagination for Problems
 type ListProblemsOptions struct {
     ListOptions
+    UpdatedSince *time.Time `json:"updated_since,omitempty" url:"updated_since,omitempty"`
 }
 
 // GetProblem will return a single Problem by id
-func (s *ProblemService) GetProblem(id int) (*Problem, *http.Response, error) {
+func (s *ProblemService) GetProblem(ctx context.Context, id int) (*Problem, *Response, error) {
     o := new(problemWrapper)
-    res, err := s.client.Get(fmt.Sprintf(problemIdUrl, id), &o)
+    res, err := s.client.Get(ctx, fmt.Sprintf(problemIdUrl, id), &o)
     return &o.Details, res, err
 }
 
 // ListProblems will return paginated/filtered Problems using ListProblemsOptions
-func (s *ProblemService) ListProblems(opt *ListProblemsOptions) (*Problems, *http.Response, error) {
+func (s *ProblemService) ListProblems(ctx context.Context, opt *ListProblemsOptions) (*Problems, *Response, error) {
     o := new(Problems)
-    res, err := s.client.List(problemsUrl, opt, &o)
+    res, err := s.client.List(ctx, problemsUrl, opt, &o)
     return o, res, err
 }
 
 // CreateProblem will create and return a new Problem based on CreateProblemModel
-func (s *ProblemService) CreateProblem(problem *CreateProblemModel) (*Problem, *http.Response, error) {
+func (s *ProblemService) CreateProblem(ctx context.Context, problem *CreateProblemModel) (*Problem, *Response, error) {
     o := new(problemWrapper)
-    res, err := s.client.Post(assetsUrl, problem, &o)
+    res, err := s.client.Post(ctx, assetsUrl, problem, &o)
     return &o.Details, res, err
 }
 
 // UpdateProblem will update and return a Problem matching id based on UpdateProblemModel
-func (s *ProblemService) UpdateProblem(id int, problem *UpdateProblemModel) (*Problem, *http.Response, error) {
+func (s *ProblemService) UpdateProblem(ctx context.Context, id int, problem *UpdateProblemModel) (*Problem, *Response, error) {
     o := new(problemWrapper)
-    res, err := s.client.Put(fmt.Sprintf(problemIdUrl, id), problem, &o)
+    res, err := s.client.Put(ctx, fmt.Sprintf(problemIdUrl, id), problem, &o)
     return &o.Details, res, err
 }
 
 // DeleteProblem will delete a Problem matching the id (non-permanent delete)
-func (s *ProblemService) DeleteProblem(id int) (bool, *http.Response, error) {
-    success, res, err := s.client.Delete(fmt.Sprintf(problemIdUrl, id))
+func (s *ProblemService) DeleteProblem(ctx context.Context, id int) (bool, *Response, error) {
+    success, res, err := s.client.Delete(ctx, fmt.Sprintf(problemIdUrl, id))
     return success, res, err
 }
 
 // RestoreProblem will restore a previously deleted Problem by id
-func (s *ProblemService) RestoreProblem(id int) (bool, *http.Response, error) {
-    res, err := s.client.Put(fmt.Sprintf(problemRestoreUrl, id), nil, nil)
-    success, _ := isSuccessful(res)
+func (s *ProblemService) RestoreProblem(ctx context.Context, id int) (bool, *Response, error) {
+    res, err := s.client.Put(ctx, fmt.Sprintf(problemRestoreUrl, id), nil, nil)
+    success := err == nil
     return success, res, err
 }
+
+// IterProblems will call fn for every Problem matching ListProblemsOptions, following pagination until fn returns false or a limit is reached
+func (s *ProblemService) IterProblems(ctx context.Context, opt *ListProblemsOptions, limit *PaginationOptions, fn func(Problem) bool) error {
+    o := ListProblemsOptions{}
+    if opt != nil {
+        o = *opt
+    }
+    return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+        page, res, err := s.ListProblems(ctx, &o)
+        if err != nil {
+            return nil, false, err
+        }
+        for _, i := range page.Collection {
+            if !yield() || !fn(i) {
+                return res, false, nil
+            }
+        }
+        return res, true, nil
+    })
+}
+
+// ListAllProblems will return every Problem matching ListProblemsOptions by following pagination
+func (s *ProblemService) ListAllProblems(ctx context.Context, opt *ListProblemsOptions, limit *PaginationOptions) ([]Problem, error) {
+    var all []Problem
+    err := s.IterProblems(ctx, opt, limit, func(i Problem) bool {
+        all = append(all, i)
+        return true
+    })
+    return all, err
+}
@@ -1,40 +1,70 @@
 package freshservice
 
 import (
+    "context"
     "fmt"
-    "net/http"
 )
 
 // GetTask will return a single Task from a Problem by the id
-func (s *ProblemService) GetTask(problemId int, taskId int) (*Task, *http.Response, error) {
+func (s *ProblemService) GetTask(ctx context.Context, problemId int, taskId int) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Get(fmt.Sprintf(problemTaskIdUrl, problemId, taskId), &o)
+    res, err := s.client.Get(ctx, fmt.Sprintf(problemTaskIdUrl, problemId, taskId), &o)
     return &o.Details, res, err
 }
 
 // ListTasks will return paginated/filtered Tasks using ListTasksOptions
-func (s *ProblemService) ListTasks(problemId int, opt *ListTasksOptions) (*Tasks, *http.Response, error) {
+func (s *ProblemService) ListTasks(ctx context.Context, problemId int, opt *ListTasksOptions) (*Tasks, *Response, error) {
     o := new(Tasks)
-    res, err := s.client.List(fmt.Sprintf(problemTasksUrl, problemId), opt, &o)
+    res, err := s.client.List(ctx, fmt.Sprintf(problemTasksUrl, problemId), opt, &o)
     return o, res, err
 }
 
 // CreateTask will create and return a new Task based on CreateTaskModel
-func (s *ProblemService) CreateTask(problemId int, newTask *CreateTaskModel) (*Task, *http.Response, error) {
+func (s *ProblemService) CreateTask(ctx context.Context, problemId int, newTask *CreateTaskModel) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Post(fmt.Sprintf(problemTasksUrl, problemId), newTask, &o)
+    res, err := s.client.Post(ctx, fmt.Sprintf(problemTasksUrl, problemId), newTask, &o)
     return &o.Details, res, err
 }
 
 // UpdateTask will update and return a Task matching id based on UpdateTaskModel
-func (s *ProblemService) UpdateTask(problemId int, taskId int, task *UpdateTaskModel) (*Task, *http.Response, error) {
+func (s *ProblemService) UpdateTask(ctx context.Context, problemId int, taskId int, task *UpdateTaskModel) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Put(fmt.Sprintf(problemTaskIdUrl, problemId, taskId), task, &o)
+    res, err := s.client.Put(ctx, fmt.Sprintf(problemTaskIdUrl, problemId, taskId), task, &o)
     return &o.Details, res, err
 }
 
 // DeleteTask deletes the Task on a Problem with the given ID
-func (s *ProblemService) DeleteTask(problemId int, taskId int) (bool, *http.Response, error) {
-    success, res, err := s.client.Delete(fmt.Sprintf(problemTaskIdUrl, problemId, taskId))
+func (s *ProblemService) DeleteTask(ctx context.Context, problemId int, taskId int) (bool, *Response, error) {
+    success, res, err := s.client.Delete(ctx, fmt.Sprintf(problemTaskIdUrl, problemId, taskId))
     return success, res, err
 }
+
+// IterProblemTasks will call fn for every Task matching ListTasksOptions, following pagination until fn returns false or a limit is reached
+func (s *ProblemService) IterProblemTasks(ctx context.Context, problemId int, opt *ListTasksOptions, limit *PaginationOptions, fn func(Task) bool) error {
+    o := ListTasksOptions{}
+    if opt != nil {
+        o = *opt
+    }
+    return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+        page, res, err := s.ListTasks(ctx, problemId, &o)
+        if err != nil {
+            return nil, false, err
+        }
+        for _, i := range page.Collection {
+            if !yield() || !fn(i) {
+                return res, false, nil
+            }
+        }
+        return res, true, nil
+    })
+}
+
+// ListAllProblemTasks will return every Task matching ListTasksOptions by following pagination
+func (s *ProblemService) ListAllProblemTasks(ctx context.Context, problemId int, opt *ListTasksOptions, limit *PaginationOptions) ([]Task, error) {
+    var all []Task
+    err := s.IterProblemTasks(ctx, problemId, opt, limit, func(i Task) bool {
+        all = append(all, i)
+        return true
+    })
+    return all, err
+}
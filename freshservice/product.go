@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -28,21 +27,7 @@ type productWrapper struct {
 
 // Product represents a FreshService Product
 type Product struct {
-	ID                 int       `json:"id"`
-	Name               string    `json:"name"`
-	Description        string    `json:"description"`
-	AssetTypeID        int       `json:"asset_type_id"`
-	Manufacturer       string    `json:"manufacturer"`
-	Status             string    `json:"status"`
-	ModeOfProcurement  string    `json:"mode_of_procurement"`
-	DepreciationTypeID int       `json:"depreciation_type_id"`
-	DescriptionText    string    `json:"description_text"`
-	CreatedAt          time.Time `json:"created_at"`
-	UpdatedAt          time.Time `json:"updated_at"`
-}
-
-// CreateProductModel is a data struct for creating a new Product
-type CreateProductModel struct {
+	ID                 int    `json:"id"`
 	Name               string `json:"name"`
 	Description        string `json:"description"`
 	AssetTypeID        int    `json:"asset_type_id"`
@@ -51,10 +36,12 @@ type CreateProductModel struct {
 	ModeOfProcurement  string `json:"mode_of_procurement"`
 	DepreciationTypeID int    `json:"depreciation_type_id"`
 	DescriptionText    string `json:"description_text"`
+	CreatedAt          Time   `json:"created_at"`
+	UpdatedAt          Time   `json:"updated_at"`
 }
 
-// UpdateProductModel is a data struct for updating a Product
-type UpdateProductModel struct {
+// CreateProductModel is a data struct for creating a new Product
+type CreateProductModel struct {
 	Name               string `json:"name"`
 	Description        string `json:"description"`
 	AssetTypeID        int    `json:"asset_type_id"`
@@ -65,41 +52,90 @@ type UpdateProductModel struct {
 	DescriptionText    string `json:"description_text"`
 }
 
+// UpdateProductModel is a data struct for updating a Product
+type UpdateProductModel struct {
+	Name               *string  `json:"name,omitempty"`
+	Description        *string  `json:"description,omitempty"`
+	AssetTypeID        *int     `json:"asset_type_id,omitempty"`
+	Manufacturer       *string  `json:"manufacturer,omitempty"`
+	Status             *string  `json:"status,omitempty"`
+	ModeOfProcurement  *string  `json:"mode_of_procurement,omitempty"`
+	DepreciationTypeID *int     `json:"depreciation_type_id,omitempty"`
+	DescriptionText    *string  `json:"description_text,omitempty"`
+	NullFields         []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateProductModel which are set, along with the NullFields as null
+func (m UpdateProductModel) MarshalJSON() ([]byte, error) {
+	type model UpdateProductModel
+	return marshalUpdate(model(m), m.NullFields)
+}
+
 // ListProductsOptions represents filters/pagination for Products
 type ListProductsOptions struct {
 	ListOptions
 }
 
 // GetProduct will return a Product by id
-func (s *ProductService) GetProduct(id int) (*Product, *http.Response, error) {
+func (s *ProductService) GetProduct(ctx context.Context, id int) (*Product, *Response, error) {
 	o := new(productWrapper)
-	res, err := s.client.Get(fmt.Sprintf(productIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(productIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListProducts will return paginated/filtered Products using ListProductsOptions
-func (s *ProductService) ListProducts(opt *ListProductsOptions) (*Products, *http.Response, error) {
+func (s *ProductService) ListProducts(ctx context.Context, opt *ListProductsOptions) (*Products, *Response, error) {
 	o := new(Products)
-	res, err := s.client.List(productsUrl, opt, &o)
+	res, err := s.client.List(ctx, productsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateProduct will create and return a new Product based on CreateProductModel
-func (s *ProductService) CreateProduct(newProduct *CreateProductModel) (*Product, *http.Response, error) {
+func (s *ProductService) CreateProduct(ctx context.Context, newProduct *CreateProductModel) (*Product, *Response, error) {
 	o := new(productWrapper)
-	res, err := s.client.Post(productsUrl, newProduct, &o)
+	res, err := s.client.Post(ctx, productsUrl, newProduct, &o)
 	return &o.Details, res, err
 }
 
 // UpdateProduct will update and return a Product matching id based UpdateProductModel
-func (s *ProductService) UpdateProduct(id int, product *UpdateLocationModel) (*Product, *http.Response, error) {
+func (s *ProductService) UpdateProduct(ctx context.Context, id int, product *UpdateProductModel) (*Product, *Response, error) {
 	o := new(productWrapper)
-	res, err := s.client.Put(fmt.Sprintf(productIdUrl, id), product, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(productIdUrl, id), product, &o)
 	return &o.Details, res, err
 }
 
 // DeleteProduct will completely remove a Product from FreshService matching id
-func (s *ProductService) DeleteProduct(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(productIdUrl, id))
+func (s *ProductService) DeleteProduct(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(productIdUrl, id))
 	return success, res, err
 }
+
+// IterProducts will call fn for every Product matching ListProductsOptions, following pagination until fn returns false or a limit is reached
+func (s *ProductService) IterProducts(ctx context.Context, opt *ListProductsOptions, limit *PaginationOptions, fn func(Product) bool) error {
+	o := ListProductsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListProducts(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllProducts will return every Product matching ListProductsOptions by following pagination
+func (s *ProductService) ListAllProducts(ctx context.Context, opt *ListProductsOptions, limit *PaginationOptions) ([]Product, error) {
+	var all []Product
+	err := s.IterProducts(ctx, opt, limit, func(i Product) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -33,7 +32,7 @@ type PurchaseOrder struct {
 	Name                  string         `json:"name"`
 	PurchaseOrderNumber   string         `json:"po_number"`
 	VendorDetails         string         `json:"vendor_details"`
-	ExpectedDeliveryDate  time.Time      `json:"expected_delivery_date"`
+	ExpectedDeliveryDate  Time           `json:"expected_delivery_date"`
 	CreatedBy             int            `json:"created_by"`
 	Status                int            `json:"status"`
 	ShippingAddress       string         `json:"shipping_address"`
@@ -46,8 +45,8 @@ type PurchaseOrder struct {
 	TaxPercentage         float32        `json:"tax_percentage"`
 	ShoppingCost          float32        `json:"shopping_cost"`
 	PurchaseItems         []PurchaseItem `json:"purchase_items"`
-	CreatedAt             time.Time      `json:"created_at"`
-	UpdatedAt             time.Time      `json:"updated_at"`
+	CreatedAt             Time           `json:"created_at"`
+	UpdatedAt             Time           `json:"updated_at"`
 }
 
 // PurchaseItem represents a line item on a PurchaseOrder
@@ -66,7 +65,7 @@ type CreatePurchaseOrderModel struct {
 	Name                  string         `json:"name"`
 	PurchaseOrderNumber   string         `json:"po_number"`
 	VendorDetails         string         `json:"vendor_details"`
-	ExpectedDeliveryDate  time.Time      `json:"expected_delivery_date"`
+	ExpectedDeliveryDate  Time           `json:"expected_delivery_date"`
 	ShippingAddress       string         `json:"shipping_address"`
 	BillingAddress        string         `json:"billing_address"`
 	BillingSameAsShipping bool           `json:"billing_same_as_shipping"`
@@ -81,21 +80,28 @@ type CreatePurchaseOrderModel struct {
 
 // UpdatePurchaseOrderModel is a data struct for updating a PurchaseOrder
 type UpdatePurchaseOrderModel struct {
-	VendorID              int            `json:"vendor_id"`
-	Name                  string         `json:"name"`
-	PurchaseOrderNumber   string         `json:"po_number"`
-	VendorDetails         string         `json:"vendor_details"`
-	ExpectedDeliveryDate  time.Time      `json:"expected_delivery_date"`
-	ShippingAddress       string         `json:"shipping_address"`
-	BillingAddress        string         `json:"billing_address"`
-	BillingSameAsShipping bool           `json:"billing_same_as_shipping"`
-	CurrencyCode          string         `json:"currency_code"`
-	ConversionRate        float32        `json:"conversion_rate"`
-	DepartmentID          int            `json:"department_id"`
-	DiscountPercentage    float32        `json:"discount_percentage"`
-	TaxPercentage         float32        `json:"tax_percentage"`
-	ShoppingCost          float32        `json:"shopping_cost"`
-	PurchaseItems         []PurchaseItem `json:"purchase_items"`
+	VendorID              *int           `json:"vendor_id,omitempty"`
+	Name                  *string        `json:"name,omitempty"`
+	PurchaseOrderNumber   *string        `json:"po_number,omitempty"`
+	VendorDetails         *string        `json:"vendor_details,omitempty"`
+	ExpectedDeliveryDate  *Time          `json:"expected_delivery_date,omitempty"`
+	ShippingAddress       *string        `json:"shipping_address,omitempty"`
+	BillingAddress        *string        `json:"billing_address,omitempty"`
+	BillingSameAsShipping *bool          `json:"billing_same_as_shipping,omitempty"`
+	CurrencyCode          *string        `json:"currency_code,omitempty"`
+	ConversionRate        *float32       `json:"conversion_rate,omitempty"`
+	DepartmentID          *int           `json:"department_id,omitempty"`
+	DiscountPercentage    *float32       `json:"discount_percentage,omitempty"`
+	TaxPercentage         *float32       `json:"tax_percentage,omitempty"`
+	ShoppingCost          *float32       `json:"shopping_cost,omitempty"`
+	PurchaseItems         []PurchaseItem `json:"purchase_items,omitempty"`
+	NullFields            []string       `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdatePurchaseOrderModel which are set, along with the NullFields as null
+func (m UpdatePurchaseOrderModel) MarshalJSON() ([]byte, error) {
+	type model UpdatePurchaseOrderModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListPurchaseOrdersOptions represents filters/pagination for PurchaseOrders
@@ -104,35 +110,65 @@ type ListPurchaseOrdersOptions struct {
 }
 
 // GetPurchaseOrder will return a single PurchaseOrder by id
-func (s *PurchaseOrderService) GetPurchaseOrder(id int) (*PurchaseOrder, *http.Response, error) {
+func (s *PurchaseOrderService) GetPurchaseOrder(ctx context.Context, id int) (*PurchaseOrder, *Response, error) {
 	o := new(poWrapper)
-	res, err := s.client.Get(fmt.Sprintf(purchaseOrderIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(purchaseOrderIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListPurchaseOrders will return paginated/filtered PurchaseOrders using ListPurchaseOrdersOptions
-func (s *PurchaseOrderService) ListPurchaseOrders(opt *ListPurchaseOrdersOptions) (*PurchaseOrders, *http.Response, error) {
+func (s *PurchaseOrderService) ListPurchaseOrders(ctx context.Context, opt *ListPurchaseOrdersOptions) (*PurchaseOrders, *Response, error) {
 	o := new(PurchaseOrders)
-	res, err := s.client.List(purchaseOrdersUrl, opt, &o)
+	res, err := s.client.List(ctx, purchaseOrdersUrl, opt, &o)
 	return o, res, err
 }
 
 // CreatePurchaseOrder will create and return a new PurchaseOrder based on CreatePurchaseOrderModel
-func (s *PurchaseOrderService) CreatePurchaseOrder(newPurchaseOrder *CreatePurchaseOrderModel) (*PurchaseOrder, *http.Response, error) {
+func (s *PurchaseOrderService) CreatePurchaseOrder(ctx context.Context, newPurchaseOrder *CreatePurchaseOrderModel) (*PurchaseOrder, *Response, error) {
 	o := new(poWrapper)
-	res, err := s.client.Post(purchaseOrdersUrl, newPurchaseOrder, &o)
+	res, err := s.client.Post(ctx, purchaseOrdersUrl, newPurchaseOrder, &o)
 	return &o.Details, res, err
 }
 
 // UpdatePurchaseOrder will update and return an PurchaseOrder matching id based on UpdatePurchaseOrderModel
-func (s *PurchaseOrderService) UpdatePurchaseOrder(id int, purchaseOrder *UpdatePurchaseOrderModel) (*PurchaseOrder, *http.Response, error) {
+func (s *PurchaseOrderService) UpdatePurchaseOrder(ctx context.Context, id int, purchaseOrder *UpdatePurchaseOrderModel) (*PurchaseOrder, *Response, error) {
 	o := new(poWrapper)
-	res, err := s.client.Put(fmt.Sprintf(purchaseOrderIdUrl, id), purchaseOrder, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(purchaseOrderIdUrl, id), purchaseOrder, &o)
 	return &o.Details, res, err
 }
 
 // DeletePurchaseOrder will completely remove an PurchaseOrder from FreshService
-func (s *PurchaseOrderService) DeletePurchaseOrder(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(purchaseOrderIdUrl, id))
+func (s *PurchaseOrderService) DeletePurchaseOrder(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(purchaseOrderIdUrl, id))
 	return success, res, err
 }
+
+// IterPurchaseOrders will call fn for every PurchaseOrder matching ListPurchaseOrdersOptions, following pagination until fn returns false or a limit is reached
+func (s *PurchaseOrderService) IterPurchaseOrders(ctx context.Context, opt *ListPurchaseOrdersOptions, limit *PaginationOptions, fn func(PurchaseOrder) bool) error {
+	o := ListPurchaseOrdersOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListPurchaseOrders(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllPurchaseOrders will return every PurchaseOrder matching ListPurchaseOrdersOptions by following pagination
+func (s *PurchaseOrderService) ListAllPurchaseOrders(ctx context.Context, opt *ListPurchaseOrdersOptions, limit *PaginationOptions) ([]PurchaseOrder, error) {
+	var all []PurchaseOrder
+	err := s.IterPurchaseOrders(ctx, opt, limit, func(i PurchaseOrder) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
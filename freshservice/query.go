@@ -0,0 +1,168 @@
+package freshservice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// maxQueryLength is the longest query FreshService accepts for filter endpoints
+const maxQueryLength = 512
+
+// Query represents a FreshService filter query (e.g. "priority:4 AND status:2"), built using Q
+type Query struct {
+	expr string
+	op   string
+	raw  bool
+	err  error
+}
+
+// QueryBuilder creates the conditions of a Query, use the package level Q
+type QueryBuilder struct{}
+
+// Q is used to build a Query e.g. Q.Eq("priority", PriorityUrgent).And(Q.Gt("due_by", t))
+var Q QueryBuilder
+
+// Eq matches when field equals value
+func (QueryBuilder) Eq(field string, value interface{}) Query {
+	return condition(field, ":", value)
+}
+
+// Gt matches when field is greater than or equal to value (FreshService filters are inclusive)
+func (QueryBuilder) Gt(field string, value interface{}) Query {
+	return condition(field, ":>", value)
+}
+
+// Lt matches when field is less than or equal to value (FreshService filters are inclusive)
+func (QueryBuilder) Lt(field string, value interface{}) Query {
+	return condition(field, ":<", value)
+}
+
+// Raw allows an already formatted condition to be used as part of a Query, it is wrapped in parentheses when combined
+func (QueryBuilder) Raw(expr string) Query {
+	return Query{expr: strings.TrimSpace(expr), raw: true}
+}
+
+// And combines the Query with others, all of which must match
+func (q Query) And(others ...Query) Query {
+	return q.combine("AND", others)
+}
+
+// Or combines the Query with others, any of which must match
+func (q Query) Or(others ...Query) Query {
+	return q.combine("OR", others)
+}
+
+// String returns the Query as sent to FreshService, without the surrounding quotes
+func (q Query) String() string {
+	return q.expr
+}
+
+// Build validates the Query and returns it surrounded by double quotes, as required by the filter endpoints
+func (q Query) Build() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+
+	if q.expr == "" {
+		return "", fmt.Errorf("query must not be empty")
+	}
+
+	if len(q.expr) > maxQueryLength {
+		return "", fmt.Errorf("query is %d characters long, the maximum is %d", len(q.expr), maxQueryLength)
+	}
+
+	return fmt.Sprintf("\"%s\"", q.expr), nil
+}
+
+// combine joins the queries with op, grouping any operands combined with a different operator. Empty operands are skipped.
+func (q Query) combine(op string, others []Query) Query {
+	var operands []Query
+	var err error
+	for _, o := range append([]Query{q}, others...) {
+		if err == nil {
+			err = o.err
+		}
+		if o.expr != "" {
+			operands = append(operands, o)
+		}
+	}
+
+	switch len(operands) {
+	case 0:
+		return Query{err: err}
+	case 1:
+		operands[0].err = err
+		return operands[0]
+	}
+
+	parts := make([]string, 0, len(operands))
+	for _, o := range operands {
+		parts = append(parts, o.group(op))
+	}
+
+	return Query{
+		expr: strings.Join(parts, fmt.Sprintf(" %s ", op)),
+		op:   op,
+		err:  err,
+	}
+}
+
+// group wraps the Query in parentheses when it is combined with another operator, or is Raw
+func (q Query) group(op string) string {
+	if q.raw || (q.op != "" && q.op != op) {
+		return fmt.Sprintf("(%s)", q.expr)
+	}
+	return q.expr
+}
+
+// condition builds a single field condition
+func condition(field string, op string, value interface{}) Query {
+	v, err := formatQueryValue(value)
+	if err != nil {
+		return Query{err: fmt.Errorf("invalid value for field '%s': %v", field, err)}
+	}
+	return Query{expr: fmt.Sprintf("%s%s%s", field, op, v)}
+}
+
+// formatQueryValue formats a value as expected by FreshService: strings and dates quoted, numbers and booleans bare
+func formatQueryValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		if strings.ContainsAny(v, `'"`) {
+			return "", fmt.Errorf("quotes are not supported in query values")
+		}
+		return fmt.Sprintf("'%s'", v), nil
+	case time.Time:
+		return fmt.Sprintf("'%s'", v.Format("2006-01-02")), nil
+	case *time.Time:
+		if v == nil {
+			return "null", nil
+		}
+		return fmt.Sprintf("'%s'", v.Format("2006-01-02")), nil
+	case Time:
+		return formatQueryValue(v.Time)
+	case Date:
+		return formatQueryValue(v.Time)
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	}
+
+	// named types (e.g. constants) are formatted by their underlying kind
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%d", rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%d", rv.Uint()), nil
+	case reflect.String:
+		return formatQueryValue(rv.String())
+	case reflect.Bool:
+		return formatQueryValue(rv.Bool())
+	}
+
+	return "", fmt.Errorf("unsupported type %T", value)
+}
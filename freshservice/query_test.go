@@ -0,0 +1,79 @@
+package freshservice_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+func TestQueryBuild(t *testing.T) {
+	q := freshservice.Q
+	due := time.Date(2021, 7, 1, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		query   freshservice.Query
+		want    string
+		wantErr string
+	}{
+		{name: "number", query: q.Eq("priority", 4), want: `"priority:4"`},
+		{name: "enum", query: q.Eq("priority", freshservice.PriorityUrgent), want: `"priority:4"`},
+		{name: "string", query: q.Eq("name", "Laptop"), want: `"name:'Laptop'"`},
+		{name: "bool", query: q.Eq("is_escalated", true), want: `"is_escalated:true"`},
+		{name: "null", query: q.Eq("agent_id", nil), want: `"agent_id:null"`},
+		{name: "greater than date", query: q.Gt("due_by", due), want: `"due_by:>'2021-07-01'"`},
+		{name: "less than Time", query: q.Lt("created_at", freshservice.NewTime(due)), want: `"created_at:<'2021-07-01'"`},
+		{
+			name:  "and",
+			query: q.Eq("priority", 4).And(q.Eq("status", 2), q.Eq("group_id", 7)),
+			want:  `"priority:4 AND status:2 AND group_id:7"`,
+		},
+		{
+			name:  "chained and is not grouped",
+			query: q.Eq("priority", 4).And(q.Eq("status", 2)).And(q.Eq("group_id", 7)),
+			want:  `"priority:4 AND status:2 AND group_id:7"`,
+		},
+		{
+			name:  "or within and is grouped",
+			query: q.Eq("status", 2).Or(q.Eq("status", 3)).And(q.Eq("priority", 4)),
+			want:  `"(status:2 OR status:3) AND priority:4"`,
+		},
+		{
+			name:  "and within or is grouped",
+			query: q.Eq("priority", 4).Or(q.Eq("status", 2).And(q.Eq("group_id", 7))),
+			want:  `"priority:4 OR (status:2 AND group_id:7)"`,
+		},
+		{
+			name:  "raw is grouped",
+			query: q.Raw("a:1 OR b:2").And(q.Eq("c", 3)),
+			want:  `"(a:1 OR b:2) AND c:3"`,
+		},
+		{name: "raw alone", query: q.Raw(" a:1 OR b:2 "), want: `"a:1 OR b:2"`},
+		{name: "empty operands are skipped", query: freshservice.Query{}.And(q.Eq("c", 3), freshservice.Query{}), want: `"c:3"`},
+		{name: "empty", query: freshservice.Query{}.And(freshservice.Query{}), wantErr: "query must not be empty"},
+		{name: "quotes", query: q.Eq("name", "O'Brien"), wantErr: "quotes are not supported"},
+		{name: "unsupported type", query: q.Eq("agent_id", struct{}{}), wantErr: "unsupported type"},
+		{name: "error of an operand", query: q.Eq("priority", 4).And(q.Eq("name", `"`)), wantErr: "invalid value for field 'name'"},
+		{name: "too long", query: q.Eq("name", strings.Repeat("x", 600)), wantErr: "the maximum is 512"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Build()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Build: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package freshservice
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Requests per minute allowed by each FreshService plan, see https://api.freshservice.com/#rate_limit
+const (
+	RateLimitStarter    = 100
+	RateLimitGrowth     = 200
+	RateLimitPro        = 400
+	RateLimitEnterprise = 500
+)
+
+// RateLimiter is a token bucket used to pace requests before they hit the FreshService rate limit.
+// A single RateLimiter is shared by all services of a Client and can be shared between Clients using the same account.
+// The limit is tuned from the X-RateLimit-* headers of each response, so a limit of 0 (unknown) only paces requests
+// once the first response has been received.
+type RateLimiter struct {
+	mu         sync.Mutex
+	limit      int
+	tokens     float64
+	last       time.Time
+	remaining  int
+	blockUntil time.Time
+	updatedAt  time.Time
+}
+
+// RateLimitStatus is a snapshot of the RateLimiter, suitable for dashboards
+type RateLimitStatus struct {
+	Limit     int
+	Remaining int
+	UpdatedAt time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing perMinute requests (e.g. RateLimitPro), 0 if the plan is unknown
+func NewRateLimiter(perMinute int) *RateLimiter {
+	if perMinute < 0 {
+		perMinute = 0
+	}
+
+	return &RateLimiter{
+		limit:     perMinute,
+		tokens:    float64(perMinute),
+		last:      time.Now(),
+		remaining: -1,
+	}
+}
+
+// Wait blocks until a request can be made without exceeding the rate limit or ctx is done
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := r.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// reserve takes a token when one is available, otherwise it returns how long to wait before trying again
+func (r *RateLimiter) reserve() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(r.blockUntil) {
+		return r.blockUntil.Sub(now)
+	}
+
+	if r.limit == 0 {
+		return 0
+	}
+
+	r.refill(now)
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - r.tokens) * float64(time.Minute) / float64(r.limit))
+}
+
+// refill adds the tokens accrued since the last refill, capped at the limit
+func (r *RateLimiter) refill(now time.Time) {
+	r.tokens += now.Sub(r.last).Minutes() * float64(r.limit)
+	if r.tokens > float64(r.limit) {
+		r.tokens = float64(r.limit)
+	}
+	r.last = now
+}
+
+// Status returns the last known limit and remaining quota
+func (r *RateLimiter) Status() RateLimitStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return RateLimitStatus{
+		Limit:     r.limit,
+		Remaining: r.remaining,
+		UpdatedAt: r.updatedAt,
+	}
+}
+
+// update tunes the RateLimiter using the rate-limit headers of a response
+func (r *RateLimiter) update(res *http.Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if r.limit > 0 {
+		r.refill(now)
+	}
+
+	if total := headerInt(res.Header, "X-RateLimit-Total"); total > 0 && total != r.limit {
+		if r.limit == 0 {
+			r.tokens = float64(total)
+		}
+		r.limit = total
+		r.last = now
+	}
+
+	// requests can cost more than one call (e.g. when including related resources)
+	if used := headerInt(res.Header, "X-RateLimit-Used-CurrentRequest"); used > 1 {
+		r.tokens -= float64(used - 1)
+	}
+
+	if v := res.Header.Get("X-RateLimit-Remaining"); v != "" {
+		if remaining, err := strconv.Atoi(v); err == nil {
+			r.remaining = remaining
+			r.updatedAt = now
+			// the account may be used by other clients, so the server is the authority on what is left
+			if float64(remaining) < r.tokens {
+				r.tokens = float64(remaining)
+			}
+		}
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		r.tokens = 0
+		if secs := headerInt(res.Header, "Retry-After"); secs > 0 {
+			r.blockUntil = now.Add(time.Duration(secs) * time.Second)
+		}
+	}
+}
+
+// RateLimiter returns the RateLimiter used by the Client, nil when rate limiting has been disabled
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.limiter
+}
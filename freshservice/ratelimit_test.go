@@ -0,0 +1,122 @@
+package freshservice
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		perMinute     int
+		status        int
+		headers       map[string]string
+		wantLimit     int
+		wantTokens    float64
+		wantRemaining int
+		wantBlocked   bool
+	}{
+		{name: "no headers", perMinute: 0, status: http.StatusOK, wantLimit: 0, wantTokens: 0, wantRemaining: -1},
+		{
+			name:      "limit learnt from the first response",
+			perMinute: 0,
+			status:    http.StatusOK,
+			headers:   map[string]string{"X-RateLimit-Total": "200", "X-RateLimit-Remaining": "150"},
+			wantLimit: 200, wantTokens: 150, wantRemaining: 150,
+		},
+		{
+			name:      "plan limit replaced by the header",
+			perMinute: RateLimitPro,
+			status:    http.StatusOK,
+			headers:   map[string]string{"X-RateLimit-Total": "500"},
+			wantLimit: 500, wantTokens: 400, wantRemaining: -1,
+		},
+		{
+			name:      "requests costing several calls",
+			perMinute: RateLimitStarter,
+			status:    http.StatusOK,
+			headers:   map[string]string{"X-RateLimit-Used-CurrentRequest": "3"},
+			wantLimit: 100, wantTokens: 98, wantRemaining: -1,
+		},
+		{
+			name:      "remaining quota used by other clients",
+			perMinute: RateLimitStarter,
+			status:    http.StatusOK,
+			headers:   map[string]string{"X-RateLimit-Remaining": "10"},
+			wantLimit: 100, wantTokens: 10, wantRemaining: 10,
+		},
+		{
+			name:      "remaining above the tokens",
+			perMinute: RateLimitStarter,
+			status:    http.StatusOK,
+			headers:   map[string]string{"X-RateLimit-Remaining": "500"},
+			wantLimit: 100, wantTokens: 100, wantRemaining: 500,
+		},
+		{
+			name:      "rate limited",
+			perMinute: RateLimitStarter,
+			status:    http.StatusTooManyRequests,
+			headers:   map[string]string{"Retry-After": "30", "X-RateLimit-Remaining": "0"},
+			wantLimit: 100, wantTokens: 0, wantRemaining: 0, wantBlocked: true,
+		},
+		{
+			name:      "rate limited without Retry-After",
+			perMinute: RateLimitStarter,
+			status:    http.StatusTooManyRequests,
+			wantLimit: 100, wantTokens: 0, wantRemaining: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRateLimiter(tt.perMinute)
+			res := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			for k, v := range tt.headers {
+				res.Header.Set(k, v)
+			}
+
+			r.update(res)
+
+			status := r.Status()
+			if status.Limit != tt.wantLimit || status.Remaining != tt.wantRemaining {
+				t.Errorf("got limit %d remaining %d, want %d and %d", status.Limit, status.Remaining, tt.wantLimit, tt.wantRemaining)
+			}
+			// tokens refill continuously, so allow for the time taken by the test
+			if math.Abs(r.tokens-tt.wantTokens) > 0.5 {
+				t.Errorf("got %.2f tokens, want %.0f", r.tokens, tt.wantTokens)
+			}
+			if blocked := r.reserve() > time.Second; blocked != tt.wantBlocked {
+				t.Errorf("got blocked %t, want %t", blocked, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	r := NewRateLimiter(60)
+	for i := 0; i < 60; i++ {
+		if d := r.reserve(); d != 0 {
+			t.Fatalf("request %d: got a wait of %s within the limit", i+1, d)
+		}
+	}
+
+	// a token is added every second at 60 requests per minute
+	if d := r.reserve(); d <= 0 || d > time.Second {
+		t.Errorf("got a wait of %s over the limit, want up to a second", d)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	r := NewRateLimiter(RateLimitStarter)
+	r.update(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"60"}}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := r.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v, want the deadline to be exceeded", err)
+	}
+}
@@ -1,8 +1,8 @@
 package freshservice
 
 import (
+    "context"
     "fmt"
-    "net/http"
     "time"
 )
 
@@ -18,22 +18,6 @@ const (
     releaseTaskIdUrl      = "releases/%d/tasks/%d"
 )
 
-const (
-    ReleasePriorityLow      = 1
-    ReleasePriorityMedium   = 2
-    ReleasePriorityHigh     = 3
-    ReleasePriorityUrgent   = 4
-    ReleaseStatusOpen       = 1
-    ReleaseStatusOnHold     = 2
-    ReleaseStatusInProgress = 3
-    ReleaseStatusIncomplete = 4
-    ReleaseStatusCompleted  = 5
-    ReleaseTypeMinor        = 1
-    ReleaseTypeStandard     = 2
-    ReleaseTypeMajor        = 3
-    ReleaseTypeEmergency    = 4
-)
-
 // ReleaseService API Docs: https://api.freshservice.com/#releases
 type ReleaseService struct {
     client *Client
@@ -51,107 +35,148 @@ type releaseWrapper struct {
 
 // Release represents a Release in FreshService
 type Release struct {
-    ID                int       `json:"id"`
-    AgentID           int       `json:"agent_id"`
-    GroupID           int       `json:"group_id"`
-    Priority          int       `json:"priority"`
-    Status            int       `json:"status"`
-    ReleaseType       int       `json:"release_type"`
-    Subject           string    `json:"subject"`
-    Description       string    `json:"description"`
-    PlannedStartDate  time.Time `json:"planned_start_date"`
-    PlannedEndDate    time.Time `json:"planned_end_date"`
-    WorkStartDate     time.Time `json:"work_start_date"`
-    WorkEndDate       time.Time `json:"work_end_date"`
-    DepartmentID      int       `json:"department_id"`
-    Category          string    `json:"category"`
-    SubCategory       string    `json:"sub_category"`
-    ItemCategory      string    `json:"item_category"`
-    CreatedAt         time.Time `json:"created_at"`
-    UpdatedAt         time.Time `json:"updated_at"`
-    AssociatedAssets  []int     `json:"associated_assets"`
-    AssociatedChanges []int     `json:"associated_changes"`
+    ID                int           `json:"id"`
+    AgentID           int           `json:"agent_id"`
+    GroupID           int           `json:"group_id"`
+    Priority          Priority      `json:"priority"`
+    Status            ReleaseStatus `json:"status"`
+    ReleaseType       ReleaseType   `json:"release_type"`
+    Subject           string        `json:"subject"`
+    Description       string        `json:"description"`
+    PlannedStartDate  Time          `json:"planned_start_date"`
+    PlannedEndDate    Time          `json:"planned_end_date"`
+    WorkStartDate     Time          `json:"work_start_date"`
+    WorkEndDate       Time          `json:"work_end_date"`
+    DepartmentID      int           `json:"department_id"`
+    Category          string        `json:"category"`
+    SubCategory       string        `json:"sub_category"`
+    ItemCategory      string        `json:"item_category"`
+    CreatedAt         Time          `json:"created_at"`
+    UpdatedAt         Time          `json:"updated_at"`
+    AssociatedAssets  []int         `json:"associated_assets"`
+    AssociatedChanges []int         `json:"associated_changes"`
+    CustomFields      CustomFields  `json:"custom_fields"`
 }
 
 // CreateReleaseModel is a data struct for creating a new Release
 type CreateReleaseModel struct {
-    AgentID          int       `json:"agent_id"`
-    GroupID          int       `json:"group_id"`
-    Priority         int       `json:"priority"`
-    Status           int       `json:"status"`
-    ReleaseType      int       `json:"release_type"`
-    Subject          string    `json:"subject"`
-    Description      string    `json:"description"`
-    PlannedStartDate time.Time `json:"planned_start_date"`
-    PlannedEndDate   time.Time `json:"planned_end_date"`
-    DepartmentID     int       `json:"department_id"`
-    Category         string    `json:"category"`
-    SubCategory      string    `json:"sub_category"`
-    ItemCategory     string    `json:"item_category"`
+    AgentID          int           `json:"agent_id"`
+    GroupID          int           `json:"group_id"`
+    Priority         Priority      `json:"priority"`
+    Status           ReleaseStatus `json:"status"`
+    ReleaseType      ReleaseType   `json:"release_type"`
+    Subject          string        `json:"subject"`
+    Description      string        `json:"description"`
+    PlannedStartDate Time          `json:"planned_start_date"`
+    PlannedEndDate   Time          `json:"planned_end_date"`
+    DepartmentID     int           `json:"department_id"`
+    Category         string        `json:"category"`
+    SubCategory      string        `json:"sub_category"`
+    ItemCategory     string        `json:"item_category"`
+    CustomFields     CustomFields  `json:"custom_fields,omitempty"`
 }
 
 // UpdateReleaseModel is a data struct for updating a Release
 type UpdateReleaseModel struct {
-    AgentID          int       `json:"agent_id"`
-    GroupID          int       `json:"group_id"`
-    Priority         int       `json:"priority"`
-    Status           int       `json:"status"`
-    ReleaseType      int       `json:"release_type"`
-    Subject          string    `json:"subject"`
-    Description      string    `json:"description"`
-    PlannedStartDate time.Time `json:"planned_start_date"`
-    PlannedEndDate   time.Time `json:"planned_end_date"`
-    WorkStartDate    time.Time `json:"work_start_date"`
-    WorkEndDate      time.Time `json:"work_end_date"`
-    DepartmentID     int       `json:"department_id"`
-    Category         string    `json:"category"`
-    SubCategory      string    `json:"sub_category"`
-    ItemCategory     string    `json:"item_category"`
+    AgentID          *int           `json:"agent_id,omitempty"`
+    GroupID          *int           `json:"group_id,omitempty"`
+    Priority         *Priority      `json:"priority,omitempty"`
+    Status           *ReleaseStatus `json:"status,omitempty"`
+    ReleaseType      *ReleaseType   `json:"release_type,omitempty"`
+    Subject          *string        `json:"subject,omitempty"`
+    Description      *string        `json:"description,omitempty"`
+    PlannedStartDate *Time          `json:"planned_start_date,omitempty"`
+    PlannedEndDate   *Time          `json:"planned_end_date,omitempty"`
+    WorkStartDate    *Time          `json:"work_start_date,omitempty"`
+    WorkEndDate      *Time          `json:"work_end_date,omitempty"`
+    DepartmentID     *int           `json:"department_id,omitempty"`
+    Category         *string        `json:"category,omitempty"`
+    SubCategory      *string        `json:"sub_category,omitempty"`
+    ItemCategory     *string        `json:"item_category,omitempty"`
+    CustomFields     CustomFields   `json:"custom_fields,omitempty"`
+    NullFields       []string       `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateReleaseModel which are set, along with the NullFields as null
+func (m UpdateReleaseModel) MarshalJSON() ([]byte, error) {
+    type model UpdateReleaseModel
+    return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListReleasesOptions represents filters/pagination for Releases
 type ListReleasesOptions struct {
     ListOptions
-    FilterName *string `json:"filter_name,omitempty" url:"filter_name,omitempty"`
+    FilterName   *string    `json:"filter_name,omitempty" url:"filter_name,omitempty"`
+    UpdatedSince *time.Time `json:"updated_since,omitempty" url:"updated_since,omitempty"`
 }
 
 // GetRelease will return a single Release by id
-func (s *ReleaseService) GetRelease(id int) (*Release, *http.Response, error) {
+func (s *ReleaseService) GetRelease(ctx context.Context, id int) (*Release, *Response, error) {
     o := new(releaseWrapper)
-    res, err := s.client.Get(fmt.Sprintf(releaseIdUrl, id), &o)
+    res, err := s.client.Get(ctx, fmt.Sprintf(releaseIdUrl, id), &o)
     return &o.Details, res, err
 }
 
 // ListReleases will return paginated/filtered Release using ListReleasesOptions
-func (s *ReleaseService) ListReleases(opt *ListReleasesOptions) (*Releases, *http.Response, error) {
+func (s *ReleaseService) ListReleases(ctx context.Context, opt *ListReleasesOptions) (*Releases, *Response, error) {
     o := new(Releases)
-    res, err := s.client.List(releasesUrl, opt, &o)
+    res, err := s.client.List(ctx, releasesUrl, opt, &o)
     return o, res, err
 }
 
 // CreateRelease will create and return a new Release based on CreateReleaseModel
-func (s *ReleaseService) CreateRelease(newRelease *CreateReleaseModel) (*Release, *http.Response, error) {
+func (s *ReleaseService) CreateRelease(ctx context.Context, newRelease *CreateReleaseModel) (*Release, *Response, error) {
     o := new(releaseWrapper)
-    res, err := s.client.Post(releasesUrl, newRelease, &o)
+    res, err := s.client.Post(ctx, releasesUrl, newRelease, &o)
     return &o.Details, res, err
 }
 
 // UpdateRelease will update and return a Release matching id based on UpdateReleaseModel
-func (s *ReleaseService) UpdateRelease(id int, ticket *UpdateReleaseModel) (*Release, *http.Response, error) {
+func (s *ReleaseService) UpdateRelease(ctx context.Context, id int, ticket *UpdateReleaseModel) (*Release, *Response, error) {
     o := new(releaseWrapper)
-    res, err := s.client.Put(fmt.Sprintf(releaseIdUrl, id), ticket, &o)
+    res, err := s.client.Put(ctx, fmt.Sprintf(releaseIdUrl, id), ticket, &o)
     return &o.Details, res, err
 }
 
 // DeleteRelease will delete a Release from FreshService (Can be restored by RestoreRelease)
-func (s *ReleaseService) DeleteRelease(id int) (bool, *http.Response, error) {
-    success, res, err := s.client.Delete(fmt.Sprintf(releaseIdUrl, id))
+func (s *ReleaseService) DeleteRelease(ctx context.Context, id int) (bool, *Response, error) {
+    success, res, err := s.client.Delete(ctx, fmt.Sprintf(releaseIdUrl, id))
     return success, res, err
 }
 
 // RestoreRelease will restore a previously trashed (deleted) Release
-func (s *ReleaseService) RestoreRelease(id int) (bool, *http.Response, error) {
-    res, err := s.client.Put(fmt.Sprintf(releaseRestoreUrl, id), nil, nil)
-    success, _ := isSuccessful(res)
+func (s *ReleaseService) RestoreRelease(ctx context.Context, id int) (bool, *Response, error) {
+    res, err := s.client.Put(ctx, fmt.Sprintf(releaseRestoreUrl, id), nil, nil)
+    success := err == nil
     return success, res, err
 }
+
+// IterReleases will call fn for every Release matching ListReleasesOptions, following pagination until fn returns false or a limit is reached
+func (s *ReleaseService) IterReleases(ctx context.Context, opt *ListReleasesOptions, limit *PaginationOptions, fn func(Release) bool) error {
+    o := ListReleasesOptions{}
+    if opt != nil {
+        o = *opt
+    }
+    return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+        page, res, err := s.ListReleases(ctx, &o)
+        if err != nil {
+            return nil, false, err
+        }
+        for _, i := range page.Collection {
+            if !yield() || !fn(i) {
+                return res, false, nil
+            }
+        }
+        return res, true, nil
+    })
+}
+
+// ListAllReleases will return every Release matching ListReleasesOptions by following pagination
+func (s *ReleaseService) ListAllReleases(ctx context.Context, opt *ListReleasesOptions, limit *PaginationOptions) ([]Release, error) {
+    var all []Release
+    err := s.IterReleases(ctx, opt, limit, func(i Release) bool {
+        all = append(all, i)
+        return true
+    })
+    return all, err
+}
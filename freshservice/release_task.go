@@ -1,40 +1,70 @@
 package freshservice
 
 import (
+    "context"
     "fmt"
-    "net/http"
 )
 
 // GetTask will return a single Task from a Release by the id
-func (s *ReleaseService) GetTask(releaseId int, taskId int) (*Task, *http.Response, error) {
+func (s *ReleaseService) GetTask(ctx context.Context, releaseId int, taskId int) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Get(fmt.Sprintf(releaseTaskIdUrl, releaseId, taskId), &o)
+    res, err := s.client.Get(ctx, fmt.Sprintf(releaseTaskIdUrl, releaseId, taskId), &o)
     return &o.Details, res, err
 }
 
 // ListTasks will return paginated/filtered Tasks using ListTasksOptions
-func (s *ReleaseService) ListTasks(releaseId int, opt *ListTasksOptions) (*Tasks, *http.Response, error) {
+func (s *ReleaseService) ListTasks(ctx context.Context, releaseId int, opt *ListTasksOptions) (*Tasks, *Response, error) {
     o := new(Tasks)
-    res, err := s.client.List(fmt.Sprintf(releaseTasksUrl, releaseId), opt, &o)
+    res, err := s.client.List(ctx, fmt.Sprintf(releaseTasksUrl, releaseId), opt, &o)
     return o, res, err
 }
 
 // CreateTask will create and return a new Task based on CreateTaskModel
-func (s *ReleaseService) CreateTask(releaseId int, newTask *CreateTaskModel) (*Task, *http.Response, error) {
+func (s *ReleaseService) CreateTask(ctx context.Context, releaseId int, newTask *CreateTaskModel) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Post(fmt.Sprintf(releaseTasksUrl, releaseId), newTask, &o)
+    res, err := s.client.Post(ctx, fmt.Sprintf(releaseTasksUrl, releaseId), newTask, &o)
     return &o.Details, res, err
 }
 
 // UpdateTask will update and return a Task matching id based on UpdateTaskModel
-func (s *ReleaseService) UpdateTask(releaseId int, taskId int, task *UpdateTaskModel) (*Task, *http.Response, error) {
+func (s *ReleaseService) UpdateTask(ctx context.Context, releaseId int, taskId int, task *UpdateTaskModel) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Put(fmt.Sprintf(releaseTaskIdUrl, releaseId, taskId), task, &o)
+    res, err := s.client.Put(ctx, fmt.Sprintf(releaseTaskIdUrl, releaseId, taskId), task, &o)
     return &o.Details, res, err
 }
 
 // DeleteTask deletes the Task on a Release with the given ID
-func (s *ReleaseService) DeleteTask(releaseId int, taskId int) (bool, *http.Response, error) {
-    success, res, err := s.client.Delete(fmt.Sprintf(releaseTaskIdUrl, releaseId, taskId))
+func (s *ReleaseService) DeleteTask(ctx context.Context, releaseId int, taskId int) (bool, *Response, error) {
+    success, res, err := s.client.Delete(ctx, fmt.Sprintf(releaseTaskIdUrl, releaseId, taskId))
     return success, res, err
 }
+
+// IterReleaseTasks will call fn for every Task matching ListTasksOptions, following pagination until fn returns false or a limit is reached
+func (s *ReleaseService) IterReleaseTasks(ctx context.Context, releaseId int, opt *ListTasksOptions, limit *PaginationOptions, fn func(Task) bool) error {
+    o := ListTasksOptions{}
+    if opt != nil {
+        o = *opt
+    }
+    return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+        page, res, err := s.ListTasks(ctx, releaseId, &o)
+        if err != nil {
+            return nil, false, err
+        }
+        for _, i := range page.Collection {
+            if !yield() || !fn(i) {
+                return res, false, nil
+            }
+        }
+        return res, true, nil
+    })
+}
+
+// ListAllReleaseTasks will return every Task matching ListTasksOptions by following pagination
+func (s *ReleaseService) ListAllReleaseTasks(ctx context.Context, releaseId int, opt *ListTasksOptions, limit *PaginationOptions) ([]Task, error) {
+    var all []Task
+    err := s.IterReleaseTasks(ctx, releaseId, opt, limit, func(i Task) bool {
+        all = append(all, i)
+        return true
+    })
+    return all, err
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -13,7 +12,7 @@ const (
 	requesterReactivateUrl = "requesters/%d/reactivate"
 )
 
-// RequesterService API Docs: https://api.freshservice.com/#requesters https://api.freshservice.com/#requester-groups
+// RequesterService API Docs: https://api.freshservice.com/#requesters (Requester Groups are available through RequesterGroupService)
 type RequesterService struct {
 	client *Client
 }
@@ -30,65 +29,75 @@ type requesterWrapper struct {
 
 // Requester represents a FreshService Requester (User)
 type Requester struct {
-	ID                    int       `json:"id"`
-	FirstName             string    `json:"first_name"`
-	LastName              string    `json:"last_name"`
-	JobTitle              string    `json:"job_title"`
-	Email                 string    `json:"primary_email"`
-	AdditionalEmails      []string  `json:"secondary_emails"`
-	WorkPhoneNumber       string    `json:"work_phone_number"`
-	MobilePhoneNumber     string    `json:"mobile_phone_number"`
-	DepartmentIDs         []int     `json:"department_ids"`
-	Active                bool      `json:"active"`
-	Address               string    `json:"address"`
-	ReportingManagerID    int       `json:"reporting_manager_id"`
-	TimeZone              string    `json:"time_zone"`
-	TimeFormat            string    `json:"time_format"`
-	Language              string    `json:"language"`
-	LocationID            int       `json:"location_id"`
-	BackgroundInformation string    `json:"background_information"`
-	HasLoggedIn           bool      `json:"has_logged_in"`
-	IsAgent               bool      `json:"is_agent"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
+	ID                    int          `json:"id"`
+	FirstName             string       `json:"first_name"`
+	LastName              string       `json:"last_name"`
+	JobTitle              string       `json:"job_title"`
+	Email                 string       `json:"primary_email"`
+	AdditionalEmails      []string     `json:"secondary_emails"`
+	WorkPhoneNumber       string       `json:"work_phone_number"`
+	MobilePhoneNumber     string       `json:"mobile_phone_number"`
+	DepartmentIDs         []int        `json:"department_ids"`
+	Active                bool         `json:"active"`
+	Address               string       `json:"address"`
+	ReportingManagerID    int          `json:"reporting_manager_id"`
+	TimeZone              string       `json:"time_zone"`
+	TimeFormat            string       `json:"time_format"`
+	Language              string       `json:"language"`
+	LocationID            int          `json:"location_id"`
+	BackgroundInformation string       `json:"background_information"`
+	HasLoggedIn           bool         `json:"has_logged_in"`
+	IsAgent               bool         `json:"is_agent"`
+	CreatedAt             Time         `json:"created_at"`
+	UpdatedAt             Time         `json:"updated_at"`
+	CustomFields          CustomFields `json:"custom_fields"`
 }
 
 // CreateRequesterModel is a data struct for creating a new Requester
 type CreateRequesterModel struct {
-	FirstName             string   `json:"first_name"`
-	LastName              string   `json:"last_name"`
-	JobTitle              string   `json:"job_title"`
-	Email                 string   `json:"primary_email"`
-	AdditionalEmails      []string `json:"secondary_emails"`
-	WorkPhoneNumber       string   `json:"work_phone_number"`
-	MobilePhoneNumber     string   `json:"mobile_phone_number"`
-	DepartmentIDs         []int    `json:"department_ids"`
-	Address               string   `json:"address"`
-	ReportingManagerID    int      `json:"reporting_manager_id"`
-	TimeZone              string   `json:"time_zone"`
-	TimeFormat            string   `json:"time_format"`
-	Language              string   `json:"language"`
-	LocationID            int      `json:"location_id"`
-	BackgroundInformation string   `json:"background_information"`
+	FirstName             string       `json:"first_name"`
+	LastName              string       `json:"last_name"`
+	JobTitle              string       `json:"job_title"`
+	Email                 string       `json:"primary_email"`
+	AdditionalEmails      []string     `json:"secondary_emails"`
+	WorkPhoneNumber       string       `json:"work_phone_number"`
+	MobilePhoneNumber     string       `json:"mobile_phone_number"`
+	DepartmentIDs         []int        `json:"department_ids"`
+	Address               string       `json:"address"`
+	ReportingManagerID    int          `json:"reporting_manager_id"`
+	TimeZone              string       `json:"time_zone"`
+	TimeFormat            string       `json:"time_format"`
+	Language              string       `json:"language"`
+	LocationID            int          `json:"location_id"`
+	BackgroundInformation string       `json:"background_information"`
+	CustomFields          CustomFields `json:"custom_fields,omitempty"`
 }
 
 // UpdateRequesterModel is a data struct for updating a Requester
 type UpdateRequesterModel struct {
-	FirstName             string   `json:"first_name"`
-	LastName              string   `json:"last_name"`
-	JobTitle              string   `json:"job_title"`
-	Email                 string   `json:"primary_email"`
-	AdditionalEmails      []string `json:"secondary_emails"`
-	WorkPhoneNumber       string   `json:"work_phone_number"`
-	MobilePhoneNumber     string   `json:"mobile_phone_number"`
-	DepartmentIDs         []int    `json:"department_ids"`
-	Address               string   `json:"address"`
-	ReportingManagerID    int      `json:"reporting_manager_id"`
-	TimeZone              string   `json:"time_zone"`
-	TimeFormat            string   `json:"time_format"`
-	Language              string   `json:"language"`
-	LocationID            int      `json:"location_id"`
-	BackgroundInformation string   `json:"background_information"`
+	FirstName             *string      `json:"first_name,omitempty"`
+	LastName              *string      `json:"last_name,omitempty"`
+	JobTitle              *string      `json:"job_title,omitempty"`
+	Email                 *string      `json:"primary_email,omitempty"`
+	AdditionalEmails      []string     `json:"secondary_emails,omitempty"`
+	WorkPhoneNumber       *string      `json:"work_phone_number,omitempty"`
+	MobilePhoneNumber     *string      `json:"mobile_phone_number,omitempty"`
+	DepartmentIDs         []int        `json:"department_ids,omitempty"`
+	Address               *string      `json:"address,omitempty"`
+	ReportingManagerID    *int         `json:"reporting_manager_id,omitempty"`
+	TimeZone              *string      `json:"time_zone,omitempty"`
+	TimeFormat            *string      `json:"time_format,omitempty"`
+	Language              *string      `json:"language,omitempty"`
+	LocationID            *int         `json:"location_id,omitempty"`
+	BackgroundInformation *string      `json:"background_information,omitempty"`
+	CustomFields          CustomFields `json:"custom_fields,omitempty"`
+	NullFields            []string     `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateRequesterModel which are set, along with the NullFields as null
+func (m UpdateRequesterModel) MarshalJSON() ([]byte, error) {
+	type model UpdateRequesterModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListRequestersOptions represents filters/pagination for Requesters
@@ -99,48 +108,78 @@ type ListRequestersOptions struct {
 }
 
 // GetRequester will return a single Requester by id
-func (s *RequesterService) GetRequester(id int) (*Requester, *http.Response, error) {
+func (s *RequesterService) GetRequester(ctx context.Context, id int) (*Requester, *Response, error) {
 	o := new(requesterWrapper)
-	res, err := s.client.Get(fmt.Sprintf(requesterIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(requesterIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListRequesters will return paginated/filtered Requesters using ListRequestersOptions
-func (s *RequesterService) ListRequesters(opt *ListRequestersOptions) (*Requesters, *http.Response, error) {
+func (s *RequesterService) ListRequesters(ctx context.Context, opt *ListRequestersOptions) (*Requesters, *Response, error) {
 	o := new(Requesters)
-	res, err := s.client.List(requestersUrl, opt, &o)
+	res, err := s.client.List(ctx, requestersUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateRequester will create and return a new Requester based on CreateRequesterModel
-func (s *RequesterService) CreateRequester(newRequester *CreateRequesterModel) (*Requester, *http.Response, error) {
+func (s *RequesterService) CreateRequester(ctx context.Context, newRequester *CreateRequesterModel) (*Requester, *Response, error) {
 	o := new(requesterWrapper)
-	res, err := s.client.Post(requestersUrl, newRequester, &o)
+	res, err := s.client.Post(ctx, requestersUrl, newRequester, &o)
 	return &o.Details, res, err
 }
 
 // UpdateRequester will update and return an Requester matching id based on UpdateRequesterModel
-func (s *RequesterService) UpdateRequester(id int, requester *UpdateRequesterModel) (*Requester, *http.Response, error) {
+func (s *RequesterService) UpdateRequester(ctx context.Context, id int, requester *UpdateRequesterModel) (*Requester, *Response, error) {
 	o := new(requesterWrapper)
-	res, err := s.client.Put(fmt.Sprintf(requesterIdUrl, id), requester, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(requesterIdUrl, id), requester, &o)
 	return &o.Details, res, err
 }
 
 // DeleteRequester will completely remove a Requester from FreshService matching id (along with their requested Tickets)
-func (s *RequesterService) DeleteRequester(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(requesterForgetUrl, id))
+func (s *RequesterService) DeleteRequester(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(requesterForgetUrl, id))
 	return success, res, err
 }
 
 // DeactivateRequester will deactivate the Requester matching the id
-func (s *RequesterService) DeactivateRequester(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(requesterIdUrl, id))
+func (s *RequesterService) DeactivateRequester(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(requesterIdUrl, id))
 	return success, res, err
 }
 
 // ReactivateRequester will reactivate a deactivated Requester matching the id
-func (s *RequesterService) ReactivateRequester(id int) (*Requester, *http.Response, error) {
+func (s *RequesterService) ReactivateRequester(ctx context.Context, id int) (*Requester, *Response, error) {
 	o := new(requesterWrapper)
-	res, err := s.client.Put(fmt.Sprintf(requesterReactivateUrl, id), nil, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(requesterReactivateUrl, id), nil, &o)
 	return &o.Details, res, err
 }
+
+// IterRequesters will call fn for every Requester matching ListRequestersOptions, following pagination until fn returns false or a limit is reached
+func (s *RequesterService) IterRequesters(ctx context.Context, opt *ListRequestersOptions, limit *PaginationOptions, fn func(Requester) bool) error {
+	o := ListRequestersOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListRequesters(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllRequesters will return every Requester matching ListRequestersOptions by following pagination
+func (s *RequesterService) ListAllRequesters(ctx context.Context, opt *ListRequestersOptions, limit *PaginationOptions) ([]Requester, error) {
+	var all []Requester
+	err := s.IterRequesters(ctx, opt, limit, func(i Requester) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
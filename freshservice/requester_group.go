@@ -0,0 +1,181 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	requesterGroupsUrl        = "requester_groups"
+	requesterGroupIdUrl       = "requester_groups/%d"
+	requesterGroupMembersUrl  = "requester_groups/%d/members"
+	requesterGroupMemberIdUrl = "requester_groups/%d/members/%d"
+)
+
+// RequesterGroupService API Docs: https://api.freshservice.com/#requester-groups
+type RequesterGroupService struct {
+	client *Client
+}
+
+// RequesterGroups contains Collection an array of RequesterGroup
+type RequesterGroups struct {
+	Collection []RequesterGroup `json:"requester_groups"`
+}
+
+// requesterGroupWrapper contains Details of one RequesterGroup
+type requesterGroupWrapper struct {
+	Details RequesterGroup `json:"requester_group"`
+}
+
+// RequesterGroup represents a FreshService Requester Group, Type is either manual or rule_based
+type RequesterGroup struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	CreatedAt   Time   `json:"created_at"`
+	UpdatedAt   Time   `json:"updated_at"`
+}
+
+// CreateRequesterGroupModel is the data structure required to create a new (manual) RequesterGroup
+type CreateRequesterGroupModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateRequesterGroupModel is the data structure for updating a RequesterGroup
+type UpdateRequesterGroupModel struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	NullFields  []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateRequesterGroupModel which are set, along with the NullFields as null
+func (m UpdateRequesterGroupModel) MarshalJSON() ([]byte, error) {
+	type model UpdateRequesterGroupModel
+	return marshalUpdate(model(m), m.NullFields)
+}
+
+// ListRequesterGroupsOptions represents pagination for RequesterGroups
+type ListRequesterGroupsOptions struct {
+	ListOptions
+}
+
+// ListRequesterGroupMembersOptions represents pagination for the members of a RequesterGroup
+type ListRequesterGroupMembersOptions struct {
+	ListOptions
+}
+
+// GetRequesterGroup will return a single RequesterGroup by id
+func (s *RequesterGroupService) GetRequesterGroup(ctx context.Context, id int) (*RequesterGroup, *Response, error) {
+	o := new(requesterGroupWrapper)
+	res, err := s.client.Get(ctx, fmt.Sprintf(requesterGroupIdUrl, id), &o)
+	return &o.Details, res, err
+}
+
+// ListRequesterGroups will return paginated RequesterGroups using ListRequesterGroupsOptions
+func (s *RequesterGroupService) ListRequesterGroups(ctx context.Context, opt *ListRequesterGroupsOptions) (*RequesterGroups, *Response, error) {
+	o := new(RequesterGroups)
+	res, err := s.client.List(ctx, requesterGroupsUrl, opt, &o)
+	return o, res, err
+}
+
+// CreateRequesterGroup will create and return a new RequesterGroup based on CreateRequesterGroupModel
+func (s *RequesterGroupService) CreateRequesterGroup(ctx context.Context, newGroup *CreateRequesterGroupModel) (*RequesterGroup, *Response, error) {
+	o := new(requesterGroupWrapper)
+	res, err := s.client.Post(ctx, requesterGroupsUrl, newGroup, &o)
+	return &o.Details, res, err
+}
+
+// UpdateRequesterGroup will update and return a RequesterGroup matching id based on UpdateRequesterGroupModel
+func (s *RequesterGroupService) UpdateRequesterGroup(ctx context.Context, id int, group *UpdateRequesterGroupModel) (*RequesterGroup, *Response, error) {
+	o := new(requesterGroupWrapper)
+	res, err := s.client.Put(ctx, fmt.Sprintf(requesterGroupIdUrl, id), group, &o)
+	return &o.Details, res, err
+}
+
+// DeleteRequesterGroup will completely remove a RequesterGroup from FreshService matching id
+func (s *RequesterGroupService) DeleteRequesterGroup(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(requesterGroupIdUrl, id))
+	return success, res, err
+}
+
+// ListRequesterGroupMembers will return the paginated Requesters which are members of the RequesterGroup matching id
+func (s *RequesterGroupService) ListRequesterGroupMembers(ctx context.Context, id int, opt *ListRequesterGroupMembersOptions) (*Requesters, *Response, error) {
+	o := new(Requesters)
+	res, err := s.client.List(ctx, fmt.Sprintf(requesterGroupMembersUrl, id), opt, &o)
+	return o, res, err
+}
+
+// AddRequesterGroupMember will add the Requester matching requesterId to the (manual) RequesterGroup matching id
+func (s *RequesterGroupService) AddRequesterGroupMember(ctx context.Context, id int, requesterId int) (bool, *Response, error) {
+	res, err := s.client.Post(ctx, fmt.Sprintf(requesterGroupMemberIdUrl, id, requesterId), nil, nil)
+	success := err == nil
+	return success, res, err
+}
+
+// RemoveRequesterGroupMember will remove the Requester matching requesterId from the (manual) RequesterGroup matching id
+func (s *RequesterGroupService) RemoveRequesterGroupMember(ctx context.Context, id int, requesterId int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(requesterGroupMemberIdUrl, id, requesterId))
+	return success, res, err
+}
+
+// IterRequesterGroups will call fn for every RequesterGroup, following pagination until fn returns false or a limit is reached
+func (s *RequesterGroupService) IterRequesterGroups(ctx context.Context, opt *ListRequesterGroupsOptions, limit *PaginationOptions, fn func(RequesterGroup) bool) error {
+	o := ListRequesterGroupsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListRequesterGroups(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllRequesterGroups will return every RequesterGroup by following pagination
+func (s *RequesterGroupService) ListAllRequesterGroups(ctx context.Context, opt *ListRequesterGroupsOptions, limit *PaginationOptions) ([]RequesterGroup, error) {
+	var all []RequesterGroup
+	err := s.IterRequesterGroups(ctx, opt, limit, func(i RequesterGroup) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
+
+// IterRequesterGroupMembers will call fn for every member of the RequesterGroup matching id, following pagination until fn returns false or a limit is reached
+func (s *RequesterGroupService) IterRequesterGroupMembers(ctx context.Context, id int, opt *ListRequesterGroupMembersOptions, limit *PaginationOptions, fn func(Requester) bool) error {
+	o := ListRequesterGroupMembersOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListRequesterGroupMembers(ctx, id, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllRequesterGroupMembers will return every member of the RequesterGroup matching id by following pagination
+func (s *RequesterGroupService) ListAllRequesterGroupMembers(ctx context.Context, id int, opt *ListRequesterGroupMembersOptions, limit *PaginationOptions) ([]Requester, error) {
+	var all []Requester
+	err := s.IterRequesterGroupMembers(ctx, id, opt, limit, func(i Requester) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -0,0 +1,35 @@
+package freshservice
+
+import "net/http"
+
+// Response wraps the http.Response returned by FreshService, exposing the pagination and rate-limit metadata.
+// The body of the embedded http.Response has already been read and closed.
+type Response struct {
+	*http.Response
+
+	// NextPage is the page referenced by the Link header, 0 when on the last page
+	NextPage int
+	// TotalEntries is the total number of results, only populated by endpoints that report it (e.g. filter queries)
+	TotalEntries int
+
+	RateLimitTotal         int
+	RateLimitRemaining     int
+	RateLimitUsedByRequest int
+	RequestID              string
+}
+
+// newResponse builds a Response, parsing the metadata from the headers of res
+func newResponse(res *http.Response) *Response {
+	if res == nil {
+		return nil
+	}
+
+	return &Response{
+		Response:               res,
+		NextPage:               nextPage(res),
+		RateLimitTotal:         headerInt(res.Header, "X-RateLimit-Total"),
+		RateLimitRemaining:     headerInt(res.Header, "X-RateLimit-Remaining"),
+		RateLimitUsedByRequest: headerInt(res.Header, "X-RateLimit-Used-CurrentRequest"),
+		RequestID:              res.Header.Get("X-Request-Id"),
+	}
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -30,30 +29,30 @@ type serviceItemWrapper struct {
 
 // ServiceItem represents a ServiceItem from the ServiceCatalog in FreshService
 type ServiceItem struct {
-	ID                     int       `json:"id"`
-	Name                   string    `json:"name"`
-	DeliveryTime           int       `json:"delivery_time"`
-	DisplayID              int       `json:"display_id"`
-	CategoryID             int       `json:"category_id"`
-	ProductID              int       `json:"product_id"`
-	Quantity               int       `json:"quantity"`
-	Deleted                bool      `json:"deleted"`
-	GroupVisibility        int       `json:"group_visibility"`
-	ItemType               int       `json:"item_type"`
-	CITypeID               int       `json:"ci_type_id"`
-	CostVisibility         bool      `json:"cost_visibility"`
-	DeliveryTimeVisibility bool      `json:"delivery_time_visibility"`
-	Botified               bool      `json:"botified"`
-	Visibility             int       `json:"visibility"`
-	AllowAttachments       bool      `json:"allow_attachments"`
-	AllowQuantity          bool      `json:"allow_quantity"`
-	IsBundle               bool      `json:"is_bundle"`
-	CreateChild            bool      `json:"create_child"`
-	Description            string    `json:"description"`
-	ShortDescription       string    `json:"short_description"`
-	Cost                   float32   `json:"cost"`
-	CreatedAt              time.Time `json:"created_at"`
-	UpdatedAt              time.Time `json:"updated_at"`
+	ID                     int     `json:"id"`
+	Name                   string  `json:"name"`
+	DeliveryTime           int     `json:"delivery_time"`
+	DisplayID              int     `json:"display_id"`
+	CategoryID             int     `json:"category_id"`
+	ProductID              int     `json:"product_id"`
+	Quantity               int     `json:"quantity"`
+	Deleted                bool    `json:"deleted"`
+	GroupVisibility        int     `json:"group_visibility"`
+	ItemType               int     `json:"item_type"`
+	CITypeID               int     `json:"ci_type_id"`
+	CostVisibility         bool    `json:"cost_visibility"`
+	DeliveryTimeVisibility bool    `json:"delivery_time_visibility"`
+	Botified               bool    `json:"botified"`
+	Visibility             int     `json:"visibility"`
+	AllowAttachments       bool    `json:"allow_attachments"`
+	AllowQuantity          bool    `json:"allow_quantity"`
+	IsBundle               bool    `json:"is_bundle"`
+	CreateChild            bool    `json:"create_child"`
+	Description            string  `json:"description"`
+	ShortDescription       string  `json:"short_description"`
+	Cost                   float32 `json:"cost"`
+	CreatedAt              Time    `json:"created_at"`
+	UpdatedAt              Time    `json:"updated_at"`
 }
 
 // ServiceCategories contains Collection an array of ServiceCategory
@@ -63,12 +62,12 @@ type ServiceCategories struct {
 
 // ServiceCategory represents a ServiceCategory in FreshService
 type ServiceCategory struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Position    int       `json:"position"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Position    int    `json:"position"`
+	CreatedAt   Time   `json:"created_at"`
+	UpdatedAt   Time   `json:"updated_at"`
 }
 
 type ServiceItemSearch struct {
@@ -78,22 +77,22 @@ type ServiceItemSearch struct {
 }
 
 // GetServiceItem will return a ServiceItem by displayId
-func (s *ServiceCatalogService) GetServiceItem(displayId int) (*ServiceItem, *http.Response, error) {
+func (s *ServiceCatalogService) GetServiceItem(ctx context.Context, displayId int) (*ServiceItem, *Response, error) {
 	o := new(serviceItemWrapper)
-	res, err := s.client.Get(fmt.Sprintf(serviceCatalogItemUrl, displayId), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(serviceCatalogItemUrl, displayId), &o)
 	return &o.Details, res, err
 }
 
 // ListServiceItems will return ServiceItems
-func (s *ServiceCatalogService) ListServiceItems() (*ServiceItems, *http.Response, error) {
+func (s *ServiceCatalogService) ListServiceItems(ctx context.Context) (*ServiceItems, *Response, error) {
 	o := new(ServiceItems)
-	res, err := s.client.List(serviceCatalogItemsUrl, nil, &o)
+	res, err := s.client.List(ctx, serviceCatalogItemsUrl, nil, &o)
 	return o, res, err
 }
 
 // SearchServiceItems will return paginated/filtered ServiceItems based on ServiceItemSearch
-func (s *ServiceCatalogService) SearchServiceItems(search *ServiceItemSearch) (*ServiceItems, *http.Response, error) {
+func (s *ServiceCatalogService) SearchServiceItems(ctx context.Context, search *ServiceItemSearch) (*ServiceItems, *Response, error) {
 	o := new(ServiceItems)
-	res, err := s.client.List(serviceCatalogItemSearchUrl, search, &o)
+	res, err := s.client.List(ctx, serviceCatalogItemSearchUrl, search, &o)
 	return o, res, err
 }
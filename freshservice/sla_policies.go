@@ -1,9 +1,6 @@
 package freshservice
 
-import (
-	"net/http"
-	"time"
-)
+import "context"
 
 const (
 	slaUrl = "sla_policies"
@@ -31,16 +28,16 @@ type Policy struct {
 	Targets     []SLATarget `json:"sla_targets"`
 	Applicable  Applicable  `json:"applicable_to"`
 	Escalation  Escalation  `json:"escalation"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	CreatedAt   Time        `json:"created_at"`
+	UpdatedAt   Time        `json:"updated_at"`
 }
 
 type SLATarget struct {
-	Priority          int  `json:"priority"`
-	EscalationEnabled bool `json:"escalation_enabled"`
-	RespondWithin     int  `json:"respond_within"`
-	ResolveWithin     int  `json:"resolve_within"`
-	BusinessHours     bool `json:"business_hours"`
+	Priority          Priority `json:"priority"`
+	EscalationEnabled bool     `json:"escalation_enabled"`
+	RespondWithin     int      `json:"respond_within"`
+	ResolveWithin     int      `json:"resolve_within"`
+	BusinessHours     bool     `json:"business_hours"`
 }
 
 type Applicable struct {
@@ -69,8 +66,8 @@ type EscalationDetails struct {
 }
 
 // ListPolicies will return SLA Policies
-func (s *SLAPoliciesService) ListPolicies() (*Policies, *http.Response, error) {
+func (s *SLAPoliciesService) ListPolicies(ctx context.Context) (*Policies, *Response, error) {
 	o := new(Policies)
-	res, err := s.client.List(slaUrl, nil, &o)
+	res, err := s.client.List(ctx, slaUrl, nil, &o)
 	return o, res, err
 }
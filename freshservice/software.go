@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -31,20 +30,20 @@ type applicationWrapper struct {
 
 // Application represents an Application (Software) registered in FreshService
 type Application struct {
-	ID                int       `json:"id"`
-	Name              string    `json:"name"`
-	Description       string    `json:"description"`
-	ApplicationType   string    `json:"application_type"`
-	Status            string    `json:"status"`
-	PublisherID       int       `json:"publisher_id"`
-	ManagedByID       int       `json:"managed_by_id"`
-	Notes             string    `json:"notes"`
-	Category          string    `json:"category"`
-	Sources           []string  `json:"sources"`
-	UserCount         int       `json:"user_count"`
-	InstallationCount int       `json:"installation_count"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                int      `json:"id"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description"`
+	ApplicationType   string   `json:"application_type"`
+	Status            string   `json:"status"`
+	PublisherID       int      `json:"publisher_id"`
+	ManagedByID       int      `json:"managed_by_id"`
+	Notes             string   `json:"notes"`
+	Category          string   `json:"category"`
+	Sources           []string `json:"sources"`
+	UserCount         int      `json:"user_count"`
+	InstallationCount int      `json:"installation_count"`
+	CreatedAt         Time     `json:"created_at"`
+	UpdatedAt         Time     `json:"updated_at"`
 }
 
 // CreateApplicationModel is a data struct for creating a new Application
@@ -62,15 +61,22 @@ type CreateApplicationModel struct {
 
 // UpdateApplicationModel is a data struct for updating an Application
 type UpdateApplicationModel struct {
-	Name            string   `json:"name"`
-	Description     string   `json:"description"`
-	ApplicationType string   `json:"application_type"`
-	Status          string   `json:"status"`
-	PublisherID     int      `json:"publisher_id"`
-	ManagedByID     int      `json:"managed_by_id"`
-	Notes           string   `json:"notes"`
-	Category        string   `json:"category"`
-	Sources         []string `json:"sources"`
+	Name            *string  `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	ApplicationType *string  `json:"application_type,omitempty"`
+	Status          *string  `json:"status,omitempty"`
+	PublisherID     *int     `json:"publisher_id,omitempty"`
+	ManagedByID     *int     `json:"managed_by_id,omitempty"`
+	Notes           *string  `json:"notes,omitempty"`
+	Category        *string  `json:"category,omitempty"`
+	Sources         []string `json:"sources,omitempty"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateApplicationModel which are set, along with the NullFields as null
+func (m UpdateApplicationModel) MarshalJSON() ([]byte, error) {
+	type model UpdateApplicationModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListApplicationsOptions represents filters/pagination for Applications
@@ -79,35 +85,65 @@ type ListApplicationsOptions struct {
 }
 
 // GetApplication will return an Application by id
-func (s *SoftwareService) GetApplication(id int) (*Application, *http.Response, error) {
+func (s *SoftwareService) GetApplication(ctx context.Context, id int) (*Application, *Response, error) {
 	o := new(applicationWrapper)
-	res, err := s.client.Get(fmt.Sprintf(applicationIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(applicationIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListApplications will return paginated/filtered Applications using ListApplicationsOptions
-func (s *SoftwareService) ListApplications(opt *ListApplicationsOptions) (*Applications, *http.Response, error) {
+func (s *SoftwareService) ListApplications(ctx context.Context, opt *ListApplicationsOptions) (*Applications, *Response, error) {
 	o := new(Applications)
-	res, err := s.client.List(applicationsUrl, opt, &o)
+	res, err := s.client.List(ctx, applicationsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateApplication will create and return a new Application based on CreateApplicationModel
-func (s *SoftwareService) CreateApplication(newApplication *CreateApplicationModel) (*Application, *http.Response, error) {
+func (s *SoftwareService) CreateApplication(ctx context.Context, newApplication *CreateApplicationModel) (*Application, *Response, error) {
 	o := new(applicationWrapper)
-	res, err := s.client.Post(applicationsUrl, newApplication, &o)
+	res, err := s.client.Post(ctx, applicationsUrl, newApplication, &o)
 	return &o.Details, res, err
 }
 
 // UpdateApplication will update and return a Application matching id based UpdateApplicationModel
-func (s *SoftwareService) UpdateApplication(id int, application *UpdateLocationModel) (*Application, *http.Response, error) {
+func (s *SoftwareService) UpdateApplication(ctx context.Context, id int, application *UpdateApplicationModel) (*Application, *Response, error) {
 	o := new(applicationWrapper)
-	res, err := s.client.Put(fmt.Sprintf(applicationIdUrl, id), application, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(applicationIdUrl, id), application, &o)
 	return &o.Details, res, err
 }
 
 // DeleteApplication will completely remove an Application from FreshService matching id
-func (s *SoftwareService) DeleteApplication(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(applicationIdUrl, id))
+func (s *SoftwareService) DeleteApplication(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(applicationIdUrl, id))
 	return success, res, err
 }
+
+// IterApplications will call fn for every Application matching ListApplicationsOptions, following pagination until fn returns false or a limit is reached
+func (s *SoftwareService) IterApplications(ctx context.Context, opt *ListApplicationsOptions, limit *PaginationOptions, fn func(Application) bool) error {
+	o := ListApplicationsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListApplications(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllApplications will return every Application matching ListApplicationsOptions by following pagination
+func (s *SoftwareService) ListAllApplications(ctx context.Context, opt *ListApplicationsOptions, limit *PaginationOptions) ([]Application, error) {
+	var all []Application
+	err := s.IterApplications(ctx, opt, limit, func(i Application) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
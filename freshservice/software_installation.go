@@ -1,10 +1,9 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"strings"
-	"time"
 )
 
 // SoftwareInstallations contains Collection an array of SoftwareInstallation
@@ -14,42 +13,42 @@ type SoftwareInstallations struct {
 
 // SoftwareInstallation represents a binding between an Application and a Device in FreshService
 type SoftwareInstallation struct {
-	ID                    int       `json:"id"`
-	InstallationMachineID int       `json:"installation_machine_id"`
-	InstallationPath      string    `json:"installation_path"`
-	Version               string    `json:"version"`
-	UserID                int       `json:"user_id"`
-	DepartmentID          int       `json:"department_id"`
-	InstallationDate      time.Time `json:"installation_date"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
+	ID                    int    `json:"id"`
+	InstallationMachineID int    `json:"installation_machine_id"`
+	InstallationPath      string `json:"installation_path"`
+	Version               string `json:"version"`
+	UserID                int    `json:"user_id"`
+	DepartmentID          int    `json:"department_id"`
+	InstallationDate      Time   `json:"installation_date"`
+	CreatedAt             Time   `json:"created_at"`
+	UpdatedAt             Time   `json:"updated_at"`
 }
 
 type CreateInstallationModel struct {
-	InstallationMachineID int       `json:"installation_machine_id"`
-	InstallationPath      string    `json:"installation_path"`
-	Version               string    `json:"version"`
-	InstallationDate      time.Time `json:"installation_date"`
+	InstallationMachineID int    `json:"installation_machine_id"`
+	InstallationPath      string `json:"installation_path"`
+	Version               string `json:"version"`
+	InstallationDate      Time   `json:"installation_date"`
 }
 
 // AddInstallation allows for adding a Device to an Application as a SoftwareInstallation
-func (s *SoftwareService) AddInstallation(applicationId int, installation *CreateInstallationModel) (*SoftwareInstallation, *http.Response, error) {
+func (s *SoftwareService) AddInstallation(ctx context.Context, applicationId int, installation *CreateInstallationModel) (*SoftwareInstallation, *Response, error) {
 	o := new(SoftwareInstallation)
-	res, err := s.client.Post(fmt.Sprintf(applicationInstallationsUrl, applicationId), installation, &o)
+	res, err := s.client.Post(ctx, fmt.Sprintf(applicationInstallationsUrl, applicationId), installation, &o)
 	return o, res, err
 }
 
 // ListInstallations will return SoftwareInstallations for a specific Application
-func (s *SoftwareService) ListInstallations(applicationId int) (*SoftwareInstallations, *http.Response, error) {
+func (s *SoftwareService) ListInstallations(ctx context.Context, applicationId int) (*SoftwareInstallations, *Response, error) {
 	o := new(SoftwareInstallations)
-	res, err := s.client.List(fmt.Sprintf(applicationInstallationsUrl, applicationId), nil, &o)
+	res, err := s.client.List(ctx, fmt.Sprintf(applicationInstallationsUrl, applicationId), nil, &o)
 	return o, res, err
 }
 
 // DeleteInstallations allows for bulk removal of Devices from Application
-func (s *SoftwareService) DeleteInstallations(applicationId int, deviceIds []string) (bool, *http.Response, error) {
+func (s *SoftwareService) DeleteInstallations(ctx context.Context, applicationId int, deviceIds []string) (bool, *Response, error) {
 	path := fmt.Sprintf(applicationInstallationsUrl, applicationId)
 	q := strings.Join(deviceIds, ",")
-	success, res, err := s.client.Delete(fmt.Sprintf("%s?device_ids=%s", path, q))
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf("%s?device_ids=%s", path, q))
 	return success, res, err
 }
@@ -1,10 +1,9 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"strings"
-	"time"
 )
 
 // SoftwareUsers contains Collection an array of SoftwareUser
@@ -24,24 +23,24 @@ type softwareUserWrapper struct {
 
 // SoftwareUser represents a binding between a User (Requester or Agent) and an Application
 type SoftwareUser struct {
-	ID            int       `json:"id"`
-	UserID        int       `json:"user_id"`
-	LicenseID     int       `json:"license_id"`
-	AllocatedDate time.Time `json:"allocated_date"`
-	FirstUsed     time.Time `json:"first_used"`
-	LastUsed      time.Time `json:"last_used"`
-	Sources       []string  `json:"sources"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            int      `json:"id"`
+	UserID        int      `json:"user_id"`
+	LicenseID     int      `json:"license_id"`
+	AllocatedDate Time     `json:"allocated_date"`
+	FirstUsed     Time     `json:"first_used"`
+	LastUsed      Time     `json:"last_used"`
+	Sources       []string `json:"sources"`
+	CreatedAt     Time     `json:"created_at"`
+	UpdatedAt     Time     `json:"updated_at"`
 }
 
 type SoftwareUserBindingModel struct {
-	UserID        int       `json:"user_id"`
-	LicenseID     int       `json:"license_id"`
-	AllocatedDate time.Time `json:"allocated_date"`
-	FirstUsed     time.Time `json:"first_used"`
-	LastUsed      time.Time `json:"last_used"`
-	Sources       []string  `json:"sources"`
+	UserID        int      `json:"user_id"`
+	LicenseID     int      `json:"license_id"`
+	AllocatedDate Time     `json:"allocated_date"`
+	FirstUsed     Time     `json:"first_used"`
+	LastUsed      Time     `json:"last_used"`
+	Sources       []string `json:"sources"`
 }
 
 // ListSoftwareUsersOptions represents filters/pagination for SoftwareUsers
@@ -50,37 +49,67 @@ type ListSoftwareUsersOptions struct {
 }
 
 // GetSoftwareUser will return an SoftwareUser by id
-func (s *SoftwareService) GetSoftwareUser(applicationId int, id int) (*SoftwareUser, *http.Response, error) {
+func (s *SoftwareService) GetSoftwareUser(ctx context.Context, applicationId int, id int) (*SoftwareUser, *Response, error) {
 	o := new(softwareUserWrapper)
-	res, err := s.client.Get(fmt.Sprintf(applicationUsersIdUrl, applicationId, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(applicationUsersIdUrl, applicationId, id), &o)
 	return &o.Details, res, err
 }
 
 // ListSoftwareUsers will return paginated/filtered SoftwareUsers using ListSoftwareUsersOptions
-func (s *SoftwareService) ListSoftwareUsers(applicationId int, opt *ListSoftwareUsersOptions) (*SoftwareUsers, *http.Response, error) {
+func (s *SoftwareService) ListSoftwareUsers(ctx context.Context, applicationId int, opt *ListSoftwareUsersOptions) (*SoftwareUsers, *Response, error) {
 	o := new(SoftwareUsers)
-	res, err := s.client.List(fmt.Sprintf(applicationUsersUrl, applicationId), opt, &o)
+	res, err := s.client.List(ctx, fmt.Sprintf(applicationUsersUrl, applicationId), opt, &o)
 	return o, res, err
 }
 
 // BulkAddUsers allows for adding many SoftwareUser records to an Application as a bulk operation, returns SoftwareUsers
-func (s *SoftwareService) BulkAddUsers(applicationId int, userBindings *SoftwareUserBindings) (*SoftwareUsers, *http.Response, error) {
+func (s *SoftwareService) BulkAddUsers(ctx context.Context, applicationId int, userBindings *SoftwareUserBindings) (*SoftwareUsers, *Response, error) {
 	o := new(SoftwareUsers)
-	res, err := s.client.Post(fmt.Sprintf(applicationUsersUrl, applicationId), userBindings, &o)
+	res, err := s.client.Post(ctx, fmt.Sprintf(applicationUsersUrl, applicationId), userBindings, &o)
 	return o, res, err
 }
 
 // BulkUpdateUsers allows for updating many SoftwareUser records of an Application as a bulk operation, returns SoftwareUsers
-func (s *SoftwareService) BulkUpdateUsers(applicationId int, userBindings *SoftwareUserBindings) (*SoftwareUsers, *http.Response, error) {
+func (s *SoftwareService) BulkUpdateUsers(ctx context.Context, applicationId int, userBindings *SoftwareUserBindings) (*SoftwareUsers, *Response, error) {
 	o := new(SoftwareUsers)
-	res, err := s.client.Put(fmt.Sprintf(applicationUsersUrl, applicationId), userBindings, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(applicationUsersUrl, applicationId), userBindings, &o)
 	return o, res, err
 }
 
 // DeleteUsers allows for bulk removal of Users (Requesters or Agent)
-func (s *SoftwareService) DeleteUsers(applicationId int, userIds []string) (bool, *http.Response, error) {
+func (s *SoftwareService) DeleteUsers(ctx context.Context, applicationId int, userIds []string) (bool, *Response, error) {
 	path := fmt.Sprintf(applicationIdUrl, applicationId)
 	q := strings.Join(userIds, ",")
-	success, res, err := s.client.Delete(fmt.Sprintf("%s?user_ids=%s", path, q))
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf("%s?user_ids=%s", path, q))
 	return success, res, err
 }
+
+// IterSoftwareUsers will call fn for every SoftwareUser matching ListSoftwareUsersOptions, following pagination until fn returns false or a limit is reached
+func (s *SoftwareService) IterSoftwareUsers(ctx context.Context, applicationId int, opt *ListSoftwareUsersOptions, limit *PaginationOptions, fn func(SoftwareUser) bool) error {
+	o := ListSoftwareUsersOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListSoftwareUsers(ctx, applicationId, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllSoftwareUsers will return every SoftwareUser matching ListSoftwareUsersOptions by following pagination
+func (s *SoftwareService) ListAllSoftwareUsers(ctx context.Context, applicationId int, opt *ListSoftwareUsersOptions, limit *PaginationOptions) ([]SoftwareUser, error) {
+	var all []SoftwareUser
+	err := s.IterSoftwareUsers(ctx, applicationId, opt, limit, func(i SoftwareUser) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 // SolutionArticles contains Collection an array of SolutionArticle
@@ -18,50 +17,57 @@ type solutionArticleWrapper struct {
 
 // SolutionArticle represents a FreshService SolutionArticle
 type SolutionArticle struct {
-	ID             int       `json:"id"`
-	Title          string    `json:"title"`
-	Description    string    `json:"description"`
-	Position       int       `json:"position"`
-	ArticleType    int       `json:"article_type"`
-	FolderID       int       `json:"folder_id"`
-	CategoryID     int       `json:"category_id"`
-	Status         int       `json:"status"`
-	ApprovalStatus int       `json:"approval_status"`
-	ThumbsUp       int       `json:"thumbs_up"`
-	ThumbsDown     int       `json:"thumbs_down"`
-	AgentID        int       `json:"agent_id"`
-	Views          int       `json:"views"`
-	Tags           []string  `json:"tags"`
-	Keywords       []string  `json:"keywords"`
-	Url            string    `json:"url"`
-	ReviewDate     time.Time `json:"review_date"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID             int      `json:"id"`
+	Title          string   `json:"title"`
+	Description    string   `json:"description"`
+	Position       int      `json:"position"`
+	ArticleType    int      `json:"article_type"`
+	FolderID       int      `json:"folder_id"`
+	CategoryID     int      `json:"category_id"`
+	Status         int      `json:"status"`
+	ApprovalStatus int      `json:"approval_status"`
+	ThumbsUp       int      `json:"thumbs_up"`
+	ThumbsDown     int      `json:"thumbs_down"`
+	AgentID        int      `json:"agent_id"`
+	Views          int      `json:"views"`
+	Tags           []string `json:"tags"`
+	Keywords       []string `json:"keywords"`
+	Url            string   `json:"url"`
+	ReviewDate     Time     `json:"review_date"`
+	CreatedAt      Time     `json:"created_at"`
+	UpdatedAt      Time     `json:"updated_at"`
 	// Attachments
 }
 
 // CreateSolutionArticleModel is a data struct for creating a new SolutionArticle
 type CreateSolutionArticleModel struct {
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	ArticleType int       `json:"article_type"`
-	FolderID    int       `json:"folder_id"`
-	Status      int       `json:"status"`
-	Tags        []string  `json:"tags"`
-	Keywords    []string  `json:"keywords"`
-	ReviewDate  time.Time `json:"review_date"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	ArticleType int      `json:"article_type"`
+	FolderID    int      `json:"folder_id"`
+	Status      int      `json:"status"`
+	Tags        []string `json:"tags"`
+	Keywords    []string `json:"keywords"`
+	ReviewDate  Time     `json:"review_date"`
 }
 
 // UpdateSolutionArticleModel is a data struct for updating a SolutionArticle
 type UpdateSolutionArticleModel struct {
-	Title       string    `json:"title,omitempty"`
-	Description string    `json:"description,omitempty"`
-	ArticleType int       `json:"article_type,omitempty"`
-	FolderID    int       `json:"folder_id,omitempty"`
-	Status      int       `json:"status,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
-	Keywords    []string  `json:"keywords,omitempty"`
-	ReviewDate  time.Time `json:"review_date,omitempty"`
+	Title       *string  `json:"title,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	ArticleType *int     `json:"article_type,omitempty"`
+	FolderID    *int     `json:"folder_id,omitempty"`
+	Status      *int     `json:"status,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	ReviewDate  *Time    `json:"review_date,omitempty"`
+	NullFields  []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateSolutionArticleModel which are set, along with the NullFields as null
+func (m UpdateSolutionArticleModel) MarshalJSON() ([]byte, error) {
+	type model UpdateSolutionArticleModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListSolutionArticlesOptions represents filters/pagination for SolutionArticles
@@ -71,42 +77,72 @@ type ListSolutionArticlesOptions struct {
 }
 
 // GetSolutionArticle will return a SolutionArticle by id
-func (s *SolutionService) GetSolutionArticle(id int) (*SolutionArticle, *http.Response, error) {
+func (s *SolutionService) GetSolutionArticle(ctx context.Context, id int) (*SolutionArticle, *Response, error) {
 	o := new(solutionArticleWrapper)
-	res, err := s.client.Get(fmt.Sprintf(solutionArticleIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(solutionArticleIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListSolutionArticles will return paginated/filtered SolutionArticles using ListSolutionArticlesOptions
-func (s *SolutionService) ListSolutionArticles(opt *ListSolutionArticlesOptions) (*SolutionArticles, *http.Response, error) {
+func (s *SolutionService) ListSolutionArticles(ctx context.Context, opt *ListSolutionArticlesOptions) (*SolutionArticles, *Response, error) {
 	o := new(SolutionArticles)
-	res, err := s.client.List(solutionArticlesUrl, opt, &o)
+	res, err := s.client.List(ctx, solutionArticlesUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateSolutionArticle will create and return a new SolutionArticle based on CreateSolutionArticleModel
-func (s *SolutionService) CreateSolutionArticle(solutionArticle *CreateSolutionArticleModel) (*SolutionArticle, *http.Response, error) {
+func (s *SolutionService) CreateSolutionArticle(ctx context.Context, solutionArticle *CreateSolutionArticleModel) (*SolutionArticle, *Response, error) {
 	o := new(solutionArticleWrapper)
-	res, err := s.client.Post(solutionArticlesUrl, solutionArticle, &o)
+	res, err := s.client.Post(ctx, solutionArticlesUrl, solutionArticle, &o)
 	return &o.Details, res, err
 }
 
 // UpdateSolutionArticle will update and return a SolutionArticle matching id based UpdateSolutionArticleModel
-func (s *SolutionService) UpdateSolutionArticle(id int, solutionArticle *UpdateSolutionArticleModel) (*SolutionArticle, *http.Response, error) {
+func (s *SolutionService) UpdateSolutionArticle(ctx context.Context, id int, solutionArticle *UpdateSolutionArticleModel) (*SolutionArticle, *Response, error) {
 	o := new(solutionArticleWrapper)
-	res, err := s.client.Put(fmt.Sprintf(solutionArticleIdUrl, id), solutionArticle, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(solutionArticleIdUrl, id), solutionArticle, &o)
 	return &o.Details, res, err
 }
 
 // DeleteSolutionArticle will completely remove a SolutionArticle from FreshService matching id
-func (s *SolutionService) DeleteSolutionArticle(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(solutionArticleIdUrl, id))
+func (s *SolutionService) DeleteSolutionArticle(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(solutionArticleIdUrl, id))
 	return success, res, err
 }
 
 // SendSolutionArticleForApproval sends the SolutionArticle matching id for approval
-func (s *SolutionService) SendSolutionArticleForApproval(id int) (*SolutionArticle, *http.Response, error) {
+func (s *SolutionService) SendSolutionArticleForApproval(ctx context.Context, id int) (*SolutionArticle, *Response, error) {
 	o := new(solutionArticleWrapper)
-	res, err := s.client.Put(fmt.Sprintf(solutionArticleApprovalUrl, id), nil, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(solutionArticleApprovalUrl, id), nil, &o)
 	return &o.Details, res, err
 }
+
+// IterSolutionArticles will call fn for every SolutionArticle matching ListSolutionArticlesOptions, following pagination until fn returns false or a limit is reached
+func (s *SolutionService) IterSolutionArticles(ctx context.Context, opt *ListSolutionArticlesOptions, limit *PaginationOptions, fn func(SolutionArticle) bool) error {
+	o := ListSolutionArticlesOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListSolutionArticles(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllSolutionArticles will return every SolutionArticle matching ListSolutionArticlesOptions by following pagination
+func (s *SolutionService) ListAllSolutionArticles(ctx context.Context, opt *ListSolutionArticlesOptions, limit *PaginationOptions) ([]SolutionArticle, error) {
+	var all []SolutionArticle
+	err := s.IterSolutionArticles(ctx, opt, limit, func(i SolutionArticle) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
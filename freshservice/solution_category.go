@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 // SolutionCategories contains Collection an array of SolutionCategory
@@ -18,14 +17,14 @@ type solutionCategoryWrapper struct {
 
 // SolutionCategory represents a FreshService SolutionCategory
 type SolutionCategory struct {
-	ID               int       `json:"id"`
-	Name             string    `json:"name"`
-	Description      string    `json:"description"`
-	Position         int       `json:"position"`
-	DefaultCategory  bool      `json:"default_category"`
-	VisibleInPortals []int     `json:"visible_in_portals"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Position         int    `json:"position"`
+	DefaultCategory  bool   `json:"default_category"`
+	VisibleInPortals []int  `json:"visible_in_portals"`
+	CreatedAt        Time   `json:"created_at"`
+	UpdatedAt        Time   `json:"updated_at"`
 }
 
 // CreateSolutionCategoryModel is the data structure required to create a new SolutionCategory
@@ -37,9 +36,16 @@ type CreateSolutionCategoryModel struct {
 
 // UpdateSolutionCategoryModel is the data structure for updating a SolutionCategory
 type UpdateSolutionCategoryModel struct {
-	Name             string `json:"name"`
-	Description      string `json:"description"`
-	VisibleInPortals []int  `json:"visible_in_portals"`
+	Name             *string  `json:"name,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	VisibleInPortals []int    `json:"visible_in_portals,omitempty"`
+	NullFields       []string `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateSolutionCategoryModel which are set, along with the NullFields as null
+func (m UpdateSolutionCategoryModel) MarshalJSON() ([]byte, error) {
+	type model UpdateSolutionCategoryModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListSolutionCategoriesOptions represents filters/pagination for SolutionCategories
@@ -48,35 +54,65 @@ type ListSolutionCategoriesOptions struct {
 }
 
 // GetSolutionCategory will return a single SolutionCategory by id
-func (s *SolutionService) GetSolutionCategory(id int) (*SolutionCategory, *http.Response, error) {
+func (s *SolutionService) GetSolutionCategory(ctx context.Context, id int) (*SolutionCategory, *Response, error) {
 	o := new(solutionCategoryWrapper)
-	res, err := s.client.Get(fmt.Sprintf(solutionCategoryIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(solutionCategoryIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListSolutionCategories will return paginated/filtered SolutionCategories using ListSolutionCategoriesOptions
-func (s *SolutionService) ListSolutionCategories(opt *ListSolutionCategoriesOptions) (*SolutionCategories, *http.Response, error) {
+func (s *SolutionService) ListSolutionCategories(ctx context.Context, opt *ListSolutionCategoriesOptions) (*SolutionCategories, *Response, error) {
 	o := new(SolutionCategories)
-	res, err := s.client.List(solutionCategoriesUrl, opt, &o)
+	res, err := s.client.List(ctx, solutionCategoriesUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateSolutionCategory will create and return a new SolutionCategory based on CreateSolutionCategoryModel
-func (s *SolutionService) CreateSolutionCategory(solutionCategory *CreateSolutionCategoryModel) (*SolutionCategory, *http.Response, error) {
+func (s *SolutionService) CreateSolutionCategory(ctx context.Context, solutionCategory *CreateSolutionCategoryModel) (*SolutionCategory, *Response, error) {
 	o := new(solutionCategoryWrapper)
-	res, err := s.client.Post(solutionCategoriesUrl, solutionCategory, &o)
+	res, err := s.client.Post(ctx, solutionCategoriesUrl, solutionCategory, &o)
 	return &o.Details, res, err
 }
 
 // UpdateSolutionCategory will update and return a SolutionCategory matching id based on UpdateSolutionCategoryModel
-func (s *SolutionService) UpdateSolutionCategory(id int, solutionCategory *UpdateSolutionCategoryModel) (*SolutionCategory, *http.Response, error) {
+func (s *SolutionService) UpdateSolutionCategory(ctx context.Context, id int, solutionCategory *UpdateSolutionCategoryModel) (*SolutionCategory, *Response, error) {
 	o := new(solutionCategoryWrapper)
-	res, err := s.client.Put(fmt.Sprintf(solutionCategoryIdUrl, id), solutionCategory, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(solutionCategoryIdUrl, id), solutionCategory, &o)
 	return &o.Details, res, err
 }
 
 // DeleteSolutionCategory will completely remove a SolutionCategory from FreshService matching id
-func (s *SolutionService) DeleteSolutionCategory(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(solutionCategoryIdUrl, id))
+func (s *SolutionService) DeleteSolutionCategory(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(solutionCategoryIdUrl, id))
 	return success, res, err
 }
+
+// IterSolutionCategories will call fn for every SolutionCategory matching ListSolutionCategoriesOptions, following pagination until fn returns false or a limit is reached
+func (s *SolutionService) IterSolutionCategories(ctx context.Context, opt *ListSolutionCategoriesOptions, limit *PaginationOptions, fn func(SolutionCategory) bool) error {
+	o := ListSolutionCategoriesOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListSolutionCategories(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllSolutionCategories will return every SolutionCategory matching ListSolutionCategoriesOptions by following pagination
+func (s *SolutionService) ListAllSolutionCategories(ctx context.Context, opt *ListSolutionCategoriesOptions, limit *PaginationOptions) ([]SolutionCategory, error) {
+	var all []SolutionCategory
+	err := s.IterSolutionCategories(ctx, opt, limit, func(i SolutionCategory) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
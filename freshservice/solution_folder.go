@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 // SolutionFolders contains Collection an array of SolutionFolder
@@ -30,8 +29,8 @@ type SolutionFolder struct {
 	RequesterGroupIDs []int            `json:"requester_group_ids"`
 	ManageByGroupIDs  []int            `json:"manage_by_group_ids"`
 	ApprovalSettings  ApprovalSettings `json:"approval_settings"`
-	CreatedAt         time.Time        `json:"created_at"`
-	UpdatedAt         time.Time        `json:"updated_at"`
+	CreatedAt         Time             `json:"created_at"`
+	UpdatedAt         Time             `json:"updated_at"`
 }
 
 type ApprovalSettings struct {
@@ -54,14 +53,21 @@ type CreateSolutionFolderModel struct {
 
 // UpdateSolutionFolderModel is a data struct for updating a SolutionFolder
 type UpdateSolutionFolderModel struct {
-	Name              string           `json:"name"`
-	Description       string           `json:"description"`
-	Visibility        int              `json:"visibility"`
-	DepartmentIDs     []int            `json:"department_ids,omitempty"`
-	GroupIDs          []int            `json:"group_ids,omitempty"`
-	RequesterGroupIDs []int            `json:"requester_group_ids,omitempty"`
-	ManageByGroupIDs  []int            `json:"manage_by_group_ids"`
-	ApprovalSettings  ApprovalSettings `json:"approval_settings,omitempty"`
+	Name              *string           `json:"name,omitempty"`
+	Description       *string           `json:"description,omitempty"`
+	Visibility        *int              `json:"visibility,omitempty"`
+	DepartmentIDs     []int             `json:"department_ids,omitempty"`
+	GroupIDs          []int             `json:"group_ids,omitempty"`
+	RequesterGroupIDs []int             `json:"requester_group_ids,omitempty"`
+	ManageByGroupIDs  []int             `json:"manage_by_group_ids,omitempty"`
+	ApprovalSettings  *ApprovalSettings `json:"approval_settings,omitempty"`
+	NullFields        []string          `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateSolutionFolderModel which are set, along with the NullFields as null
+func (m UpdateSolutionFolderModel) MarshalJSON() ([]byte, error) {
+	type model UpdateSolutionFolderModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListSolutionFoldersOptions represents filters/pagination for SolutionFolders
@@ -71,35 +77,65 @@ type ListSolutionFoldersOptions struct {
 }
 
 // GetSolutionFolder will return a SolutionFolder by id
-func (s *SolutionService) GetSolutionFolder(id int) (*SolutionFolder, *http.Response, error) {
+func (s *SolutionService) GetSolutionFolder(ctx context.Context, id int) (*SolutionFolder, *Response, error) {
 	o := new(solutionFolderWrapper)
-	res, err := s.client.Get(fmt.Sprintf(solutionFolderIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(solutionFolderIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListSolutionFolders will return paginated/filtered SolutionFolders using ListSolutionFoldersOptions
-func (s *SolutionService) ListSolutionFolders(opt *ListSolutionFoldersOptions) (*SolutionFolders, *http.Response, error) {
+func (s *SolutionService) ListSolutionFolders(ctx context.Context, opt *ListSolutionFoldersOptions) (*SolutionFolders, *Response, error) {
 	o := new(SolutionFolders)
-	res, err := s.client.List(solutionFoldersUrl, opt, &o)
+	res, err := s.client.List(ctx, solutionFoldersUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateSolutionFolder will create and return a new SolutionFolder based on CreateSolutionFolderModel
-func (s *SolutionService) CreateSolutionFolder(solutionFolder *CreateSolutionFolderModel) (*SolutionFolder, *http.Response, error) {
+func (s *SolutionService) CreateSolutionFolder(ctx context.Context, solutionFolder *CreateSolutionFolderModel) (*SolutionFolder, *Response, error) {
 	o := new(solutionFolderWrapper)
-	res, err := s.client.Post(solutionFoldersUrl, solutionFolder, &o)
+	res, err := s.client.Post(ctx, solutionFoldersUrl, solutionFolder, &o)
 	return &o.Details, res, err
 }
 
 // UpdateSolutionFolder will update and return a SolutionFolder matching id based UpdateSolutionFolderModel
-func (s *SolutionService) UpdateSolutionFolder(id int, solutionFolder *UpdateSolutionFolderModel) (*SolutionFolder, *http.Response, error) {
+func (s *SolutionService) UpdateSolutionFolder(ctx context.Context, id int, solutionFolder *UpdateSolutionFolderModel) (*SolutionFolder, *Response, error) {
 	o := new(solutionFolderWrapper)
-	res, err := s.client.Put(fmt.Sprintf(solutionFolderIdUrl, id), solutionFolder, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(solutionFolderIdUrl, id), solutionFolder, &o)
 	return &o.Details, res, err
 }
 
 // DeleteSolutionFolder will completely remove a SolutionFolder from FreshService matching id
-func (s *SolutionService) DeleteSolutionFolder(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(solutionFolderIdUrl, id))
+func (s *SolutionService) DeleteSolutionFolder(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(solutionFolderIdUrl, id))
 	return success, res, err
 }
+
+// IterSolutionFolders will call fn for every SolutionFolder matching ListSolutionFoldersOptions, following pagination until fn returns false or a limit is reached
+func (s *SolutionService) IterSolutionFolders(ctx context.Context, opt *ListSolutionFoldersOptions, limit *PaginationOptions, fn func(SolutionFolder) bool) error {
+	o := ListSolutionFoldersOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListSolutionFolders(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllSolutionFolders will return every SolutionFolder matching ListSolutionFoldersOptions by following pagination
+func (s *SolutionService) ListAllSolutionFolders(ctx context.Context, opt *ListSolutionFoldersOptions, limit *PaginationOptions) ([]SolutionFolder, error) {
+	var all []SolutionFolder
+	err := s.IterSolutionFolders(ctx, opt, limit, func(i SolutionFolder) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
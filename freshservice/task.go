@@ -1,20 +1,18 @@
 package freshservice
 
-import "time"
-
 // Task represents a Task on a FreshService Ticket
 type Task struct {
-    ID           int       `json:"id"`
-    AgentID      int       `json:"agent_id"`
-    Status       int       `json:"status"`
-    DueDate      time.Time `json:"due_date"`
-    NotifyBefore int       `json:"notify_before"`
-    Title        string    `json:"title"`
-    Description  string    `json:"description"`
-    GroupID      int       `json:"group_id"`
-    CreatedAt    time.Time `json:"created_at"`
-    UpdatedAt    time.Time `json:"updated_at"`
-    ClosedAt     time.Time `json:"closed_at"`
+    ID           int        `json:"id"`
+    AgentID      int        `json:"agent_id"`
+    Status       TaskStatus `json:"status"`
+    DueDate      Time       `json:"due_date"`
+    NotifyBefore int        `json:"notify_before"`
+    Title        string     `json:"title"`
+    Description  string     `json:"description"`
+    GroupID      int        `json:"group_id"`
+    CreatedAt    Time       `json:"created_at"`
+    UpdatedAt    Time       `json:"updated_at"`
+    ClosedAt     Time       `json:"closed_at"`
 }
 
 // Tasks contains Collection an array of Task
@@ -29,21 +27,28 @@ type taskWrapper struct {
 
 // CreateTaskModel is the data structure required to create a new Task
 type CreateTaskModel struct {
-    DueDate      time.Time `json:"due_date"`
-    NotifyBefore int       `json:"notify_before"`
-    Title        string    `json:"title"`
-    Description  string    `json:"description"`
+    DueDate      Time   `json:"due_date"`
+    NotifyBefore int    `json:"notify_before"`
+    Title        string `json:"title"`
+    Description  string `json:"description"`
 }
 
 // UpdateTaskModel is the data structure for updating an existing Task
 type UpdateTaskModel struct {
-    AgentID      int       `json:"agent_id"`
-    Status       int       `json:"status"`
-    DueDate      time.Time `json:"due_date"`
-    NotifyBefore int       `json:"notify_before"`
-    Title        string    `json:"title"`
-    Description  string    `json:"description"`
-    GroupID      int       `json:"group_id"`
+    AgentID      *int        `json:"agent_id,omitempty"`
+    Status       *TaskStatus `json:"status,omitempty"`
+    DueDate      *Time       `json:"due_date,omitempty"`
+    NotifyBefore *int        `json:"notify_before,omitempty"`
+    Title        *string     `json:"title,omitempty"`
+    Description  *string     `json:"description,omitempty"`
+    GroupID      *int        `json:"group_id,omitempty"`
+    NullFields   []string    `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateTaskModel which are set, along with the NullFields as null
+func (m UpdateTaskModel) MarshalJSON() ([]byte, error) {
+    type model UpdateTaskModel
+    return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListTasksOptions represents filters/pagination for Tasks
@@ -1,8 +1,9 @@
 package freshservice
 
 import (
+    "context"
     "fmt"
-    "net/http"
+    "io"
     "time"
 )
 
@@ -17,27 +18,9 @@ const (
     ticketTasksUrl            = "tickets/%d/tasks"
     ticketTaskIdUrl           = "tickets/%d/tasks/%d"
     ticketConversationsUrl    = "tickets/%d/conversations"
-)
-
-const (
-    TicketOpen          = 2
-    TicketPending       = 3
-    TicketResolved      = 4
-    TicketClosed        = 5
-    SourceEmail         = 1
-    SourcePortal        = 2
-    SourcePhone         = 3
-    SourceChat          = 4
-    SourceWidget        = 5
-    SourceYammer        = 6
-    SourceAwsCloudWatch = 7
-    SourcePagerDuty     = 8
-    SourceWalkUp        = 9
-    SourceSlack         = 10
-    PriorityLow         = 1
-    PriorityMedium      = 2
-    PriorityHigh        = 3
-    PriorityUrgent      = 4
+    ticketReplyUrl            = "tickets/%d/reply"
+    ticketNotesUrl            = "tickets/%d/notes"
+    conversationIdUrl         = "conversations/%d"
 )
 
 // TicketService API Docs: https://api.freshservice.com/#tickets
@@ -64,99 +47,110 @@ type Ticket struct {
     Deleted                bool               `json:"deleted"`
     Description            string             `json:"description"`
     DescriptionText        string             `json:"description_text"`
-    DueBy                  time.Time          `json:"due_by"`
+    DueBy                  Time               `json:"due_by"`
     Email                  string             `json:"email"`
     EmailConfigID          int                `json:"email_config_id"`
-    FirstResponseDueBy     time.Time          `json:"fr_due_by"`
+    FirstResponseDueBy     Time               `json:"fr_due_by"`
     FirstResponseEscalated bool               `json:"fr_escalated"`
     ForwardEmails          []string           `json:"fwd_emails"`
     GroupID                int                `json:"group_id"`
     IsEscalated            bool               `json:"is_escalated"`
     Name                   string             `json:"name"`
     Phone                  string             `json:"phone"`
-    Priority               int                `json:"priority"`
+    Priority               Priority           `json:"priority"`
     Category               string             `json:"category"`
     SubCategory            string             `json:"sub_category"`
     ItemCategory           string             `json:"item_category"`
     ReplyCcEmails          []string           `json:"reply_cc_emails"`
     RequesterID            int                `json:"requester_id"`
     ResponderID            int                `json:"responder_id"`
-    Source                 int                `json:"source"`
+    Source                 TicketSource       `json:"source"`
     Spam                   bool               `json:"spam"`
-    Status                 int                `json:"status"`
+    Status                 TicketStatus       `json:"status"`
     Subject                string             `json:"subject"`
     Tags                   []string           `json:"tags"`
     ToEmails               []string           `json:"to_emails"`
     Type                   string             `json:"type"`
-    Urgency                int                `json:"urgency"`
-    Impact                 int                `json:"impact"`
-    CreatedAt              time.Time          `json:"created_at"`
-    UpdatedAt              time.Time          `json:"updated_at"`
+    Urgency                Urgency            `json:"urgency"`
+    Impact                 Impact             `json:"impact"`
+    CreatedAt              Time               `json:"created_at"`
+    UpdatedAt              Time               `json:"updated_at"`
+    CustomFields           CustomFields       `json:"custom_fields"`
 }
 
 // CreateTicketModel is a data struct for creating a new Ticket
 type CreateTicketModel struct {
-    Attachments        []TicketAttachment `json:"attachments"`
-    CcEmails           []string           `json:"cc_emails"`
-    DepartmentID       int                `json:"department_id"`
+    Attachments        []TicketAttachment `json:"attachments,omitempty"`
+    CcEmails           []string           `json:"cc_emails,omitempty"`
+    DepartmentID       int                `json:"department_id,omitempty"`
     Description        string             `json:"description"`
-    DueBy              time.Time          `json:"due_by"`
-    Email              string             `json:"email"`
-    EmailConfigID      int                `json:"email_config_id"`
-    FirstResponseDueBy time.Time          `json:"fr_due_by"`
-    GroupID            int                `json:"group_id"`
-    Name               string             `json:"name"`
-    Phone              string             `json:"phone"`
-    Priority           int                `json:"priority"`
-    Category           string             `json:"category"`
-    SubCategory        string             `json:"sub_category"`
-    ItemCategory       string             `json:"item_category"`
-    RequesterID        int                `json:"requester_id"`
-    ResponderID        int                `json:"responder_id"`
-    Source             int                `json:"source"`
-    Status             int                `json:"status"`
+    DueBy              *Time              `json:"due_by,omitempty"`
+    Email              string             `json:"email,omitempty"`
+    EmailConfigID      int                `json:"email_config_id,omitempty"`
+    FirstResponseDueBy *Time              `json:"fr_due_by,omitempty"`
+    GroupID            int                `json:"group_id,omitempty"`
+    Name               string             `json:"name,omitempty"`
+    Phone              string             `json:"phone,omitempty"`
+    Priority           Priority           `json:"priority"`
+    Category           string             `json:"category,omitempty"`
+    SubCategory        string             `json:"sub_category,omitempty"`
+    ItemCategory       string             `json:"item_category,omitempty"`
+    RequesterID        int                `json:"requester_id,omitempty"`
+    ResponderID        int                `json:"responder_id,omitempty"`
+    Source             TicketSource       `json:"source,omitempty"`
+    Status             TicketStatus       `json:"status"`
     Subject            string             `json:"subject"`
-    Tags               []string           `json:"tags"`
-    Type               string             `json:"type"`
-    Urgency            int                `json:"urgency"`
-    Impact             int                `json:"impact"`
+    Tags               []string           `json:"tags,omitempty"`
+    Type               string             `json:"type,omitempty"`
+    Urgency            Urgency            `json:"urgency,omitempty"`
+    Impact             Impact             `json:"impact,omitempty"`
+    CustomFields       CustomFields       `json:"custom_fields,omitempty"`
 }
 
 // UpdateTicketModel is a data struct for updating a Ticket
 type UpdateTicketModel struct {
-    Attachments        []TicketAttachment `json:"attachments"`
-    DepartmentID       int                `json:"department_id"`
-    Description        string             `json:"description"`
-    DueBy              time.Time          `json:"due_by"`
-    Email              string             `json:"email"`
-    EmailConfigID      int                `json:"email_config_id"`
-    FirstResponseDueBy time.Time          `json:"fr_due_by"`
-    GroupID            int                `json:"group_id"`
-    Name               string             `json:"name"`
-    Phone              string             `json:"phone"`
-    Priority           int                `json:"priority"`
-    Category           string             `json:"category"`
-    SubCategory        string             `json:"sub_category"`
-    ItemCategory       string             `json:"item_category"`
-    RequesterID        int                `json:"requester_id"`
-    ResponderID        int                `json:"responder_id"`
-    Source             int                `json:"source"`
-    Status             int                `json:"status"`
-    Subject            string             `json:"subject"`
-    Tags               []string           `json:"tags"`
-    Type               string             `json:"type"`
-    Urgency            int                `json:"urgency"`
-    Impact             int                `json:"impact"`
+    Attachments        []TicketAttachment `json:"attachments,omitempty"`
+    DepartmentID       *int               `json:"department_id,omitempty"`
+    Description        *string            `json:"description,omitempty"`
+    DueBy              *Time              `json:"due_by,omitempty"`
+    Email              *string            `json:"email,omitempty"`
+    EmailConfigID      *int               `json:"email_config_id,omitempty"`
+    FirstResponseDueBy *Time              `json:"fr_due_by,omitempty"`
+    GroupID            *int               `json:"group_id,omitempty"`
+    Name               *string            `json:"name,omitempty"`
+    Phone              *string            `json:"phone,omitempty"`
+    Priority           *Priority          `json:"priority,omitempty"`
+    Category           *string            `json:"category,omitempty"`
+    SubCategory        *string            `json:"sub_category,omitempty"`
+    ItemCategory       *string            `json:"item_category,omitempty"`
+    RequesterID        *int               `json:"requester_id,omitempty"`
+    ResponderID        *int               `json:"responder_id,omitempty"`
+    Source             *TicketSource      `json:"source,omitempty"`
+    Status             *TicketStatus      `json:"status,omitempty"`
+    Subject            *string            `json:"subject,omitempty"`
+    Tags               []string           `json:"tags,omitempty"`
+    Type               *string            `json:"type,omitempty"`
+    Urgency            *Urgency           `json:"urgency,omitempty"`
+    Impact             *Impact            `json:"impact,omitempty"`
+    CustomFields       CustomFields       `json:"custom_fields,omitempty"`
+    NullFields         []string           `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateTicketModel which are set, along with the NullFields as null
+func (m UpdateTicketModel) MarshalJSON() ([]byte, error) {
+    type model UpdateTicketModel
+    return marshalUpdate(model(m), m.NullFields)
 }
 
 // TicketAttachment represents an Attachment on a Ticket
 type TicketAttachment struct {
-    Name          string    `json:"name"`
-    Size          int       `json:"size"`
-    ContentType   string    `json:"content_type"`
-    AttachmentUrl string    `json:"attachment_url"`
-    CreatedAt     time.Time `json:"created_at"`
-    UpdatedAt     time.Time `json:"updated_at"`
+    ID            int    `json:"id"`
+    Name          string `json:"name"`
+    Size          int    `json:"size"`
+    ContentType   string `json:"content_type"`
+    AttachmentUrl string `json:"attachment_url"`
+    CreatedAt     Time   `json:"created_at"`
+    UpdatedAt     Time   `json:"updated_at"`
 }
 
 // TicketActivities contains Collection an array of TicketActivity
@@ -166,10 +160,10 @@ type TicketActivities struct {
 
 // TicketActivity represents an Audit Item on a Ticket
 type TicketActivity struct {
-    Actor       Actor     `json:"actor"`
-    Content     string    `json:"content"`
-    SubContents string    `json:"sub_contents"`
-    CreatedAt   time.Time `json:"created_at"`
+    Actor       Actor  `json:"actor"`
+    Content     string `json:"content"`
+    SubContents string `json:"sub_contents"`
+    CreatedAt   Time   `json:"created_at"`
 }
 
 // ListTicketsOptions represents filters/pagination for Tickets
@@ -182,55 +176,108 @@ type ListTicketsOptions struct {
 }
 
 // GetTicket will return a single Ticket by id
-func (s *TicketService) GetTicket(id int) (*Ticket, *http.Response, error) {
+func (s *TicketService) GetTicket(ctx context.Context, id int) (*Ticket, *Response, error) {
     o := new(ticketWrapper)
-    res, err := s.client.Get(fmt.Sprintf(ticketIdUrl, id), &o)
+    res, err := s.client.Get(ctx, fmt.Sprintf(ticketIdUrl, id), &o)
     return &o.Details, res, err
 }
 
 // ListTickets will return paginated/filtered Ticket using ListTicketsOptions
-func (s *TicketService) ListTickets(opt *ListTicketsOptions) (*Tickets, *http.Response, error) {
+func (s *TicketService) ListTickets(ctx context.Context, opt *ListTicketsOptions) (*Tickets, *Response, error) {
     o := new(Tickets)
-    res, err := s.client.List(ticketsUrl, opt, &o)
+    res, err := s.client.List(ctx, ticketsUrl, opt, &o)
     return o, res, err
 }
 
 // CreateTicket will create and return a new Ticket based on CreateTicketModel
-func (s *TicketService) CreateTicket(newTicket *CreateAgentModel) (*Ticket, *http.Response, error) {
+func (s *TicketService) CreateTicket(ctx context.Context, newTicket *CreateTicketModel) (*Ticket, *Response, error) {
     o := new(ticketWrapper)
-    res, err := s.client.Post(ticketsUrl, newTicket, &o)
+    res, err := s.client.Post(ctx, ticketsUrl, newTicket, &o)
+    return &o.Details, res, err
+}
+
+// CreateTicketWithAttachments will create and return a new Ticket based on CreateTicketModel, uploading files as attachments.
+// Use NamedReader to set the file name of readers without a Name method, the combined size is limited to 15 MB.
+func (s *TicketService) CreateTicketWithAttachments(ctx context.Context, newTicket *CreateTicketModel, files ...io.Reader) (*Ticket, *Response, error) {
+    body, err := newMultipartBody(newTicket, attachmentsFormKey, files)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    o := new(ticketWrapper)
+    res, err := s.client.Post(ctx, ticketsUrl, body, &o)
     return &o.Details, res, err
 }
 
 // UpdateTicket will update and return a Ticket matching id based on UpdateTicketModel
-func (s *TicketService) UpdateTicket(id int, ticket *UpdateTicketModel) (*Ticket, *http.Response, error) {
+func (s *TicketService) UpdateTicket(ctx context.Context, id int, ticket *UpdateTicketModel) (*Ticket, *Response, error) {
     o := new(ticketWrapper)
-    res, err := s.client.Put(fmt.Sprintf(ticketIdUrl, id), ticket, &o)
+    res, err := s.client.Put(ctx, fmt.Sprintf(ticketIdUrl, id), ticket, &o)
     return &o.Details, res, err
 }
 
 // DeleteTicket will trash a Ticket from FreshService (Can be restored by RestoreTicket)
-func (s *TicketService) DeleteTicket(id int) (bool, *http.Response, error) {
-    success, res, err := s.client.Delete(fmt.Sprintf(ticketIdUrl, id))
+func (s *TicketService) DeleteTicket(ctx context.Context, id int) (bool, *Response, error) {
+    success, res, err := s.client.Delete(ctx, fmt.Sprintf(ticketIdUrl, id))
     return success, res, err
 }
 
 // RestoreTicket will restore a previously trashed (deleted) Ticket
-func (s *TicketService) RestoreTicket(id int) (bool, *http.Response, error) {
-    res, err := s.client.Put(fmt.Sprintf(ticketRestoreUrl, id), nil, nil)
-    success, _ := isSuccessful(res)
+func (s *TicketService) RestoreTicket(ctx context.Context, id int) (bool, *Response, error) {
+    res, err := s.client.Put(ctx, fmt.Sprintf(ticketRestoreUrl, id), nil, nil)
+    success := err == nil
     return success, res, err
 }
 
 // DeleteAttachment will remove a TicketAttachment from a Ticket
-func (s *TicketService) DeleteAttachment(ticketId int, attachmentId int) (bool, *http.Response, error) {
-    success, res, err := s.client.Delete(fmt.Sprintf(ticketRemoveAttachmentUrl, ticketId, attachmentId))
+func (s *TicketService) DeleteAttachment(ctx context.Context, ticketId int, attachmentId int) (bool, *Response, error) {
+    success, res, err := s.client.Delete(ctx, fmt.Sprintf(ticketRemoveAttachmentUrl, ticketId, attachmentId))
     return success, res, err
 }
 
+// DownloadAttachment streams a TicketAttachment (of a Ticket or Conversation) to w, verifying the size and content type
+// against the attachment. progress is optional and is called as the attachment is written.
+func (s *TicketService) DownloadAttachment(ctx context.Context, attachment *TicketAttachment, w io.Writer, progress ProgressFunc) (*Response, error) {
+    if attachment == nil || attachment.AttachmentUrl == "" {
+        return nil, fmt.Errorf("attachment has no url to download from")
+    }
+
+    return s.client.download(ctx, attachment.AttachmentUrl, attachment.ContentType, int64(attachment.Size), w, progress)
+}
+
 // GetAudit returns TicketActivities for a specific Ticket
-func (s *TicketService) GetAudit(ticketId int) (*TicketActivities, *http.Response, error) {
+func (s *TicketService) GetAudit(ctx context.Context, ticketId int) (*TicketActivities, *Response, error) {
     o := new(TicketActivities)
-    res, err := s.client.List(fmt.Sprintf(ticketActivitiesUrl, ticketId), nil, &o)
+    res, err := s.client.List(ctx, fmt.Sprintf(ticketActivitiesUrl, ticketId), nil, &o)
     return o, res, err
 }
+
+// IterTickets will call fn for every Ticket matching ListTicketsOptions, following pagination until fn returns false or a limit is reached
+func (s *TicketService) IterTickets(ctx context.Context, opt *ListTicketsOptions, limit *PaginationOptions, fn func(Ticket) bool) error {
+    o := ListTicketsOptions{}
+    if opt != nil {
+        o = *opt
+    }
+    return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+        page, res, err := s.ListTickets(ctx, &o)
+        if err != nil {
+            return nil, false, err
+        }
+        for _, i := range page.Collection {
+            if !yield() || !fn(i) {
+                return res, false, nil
+            }
+        }
+        return res, true, nil
+    })
+}
+
+// ListAllTickets will return every Ticket matching ListTicketsOptions by following pagination
+func (s *TicketService) ListAllTickets(ctx context.Context, opt *ListTicketsOptions, limit *PaginationOptions) ([]Ticket, error) {
+    var all []Ticket
+    err := s.IterTickets(ctx, opt, limit, func(i Ticket) bool {
+        all = append(all, i)
+        return true
+    })
+    return all, err
+}
@@ -1,9 +1,9 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
+	"io"
 )
 
 // Conversations contains Collection an array of Conversation
@@ -11,6 +11,11 @@ type Conversations struct {
 	Collection []Conversation `json:"conversations"`
 }
 
+// conversationWrapper contains Details of one Conversation
+type conversationWrapper struct {
+	Details Conversation `json:"conversation"`
+}
+
 // Conversation represents a Conversation / Discussion on a Ticket
 type Conversation struct {
 	ID           int                `json:"id"`
@@ -18,14 +23,41 @@ type Conversation struct {
 	Body         string             `json:"body"`
 	BodyText     string             `json:"body_text"`
 	Incoming     bool               `json:"incoming"`
+	FromEmail    string             `json:"from_email"`
 	ToEmails     []string           `json:"to_emails"`
+	CcEmails     []string           `json:"cc_emails"`
+	BccEmails    []string           `json:"bcc_emails"`
+	NotifyEmails []string           `json:"notify_emails"`
 	Private      bool               `json:"private"`
-	Source       int                `json:"source"`
+	Source       ConversationSource `json:"source"`
 	SupportEmail string             `json:"support_email"`
 	TicketID     int                `json:"ticket_id"`
 	UserID       int                `json:"user_id"`
-	CreatedAt    time.Time          `json:"created_at"`
-	UpdatedAt    time.Time          `json:"updated_at"`
+	CreatedAt    Time               `json:"created_at"`
+	UpdatedAt    Time               `json:"updated_at"`
+}
+
+// CreateReplyModel is a data struct for replying to the requester of a Ticket
+type CreateReplyModel struct {
+	Body      string   `json:"body"`
+	FromEmail string   `json:"from_email,omitempty"`
+	UserID    int      `json:"user_id,omitempty"`
+	CcEmails  []string `json:"cc_emails,omitempty"`
+	BccEmails []string `json:"bcc_emails,omitempty"`
+}
+
+// CreateConversationNoteModel is a data struct for adding a Note to a Ticket, notes are private unless Private is set to false
+type CreateConversationNoteModel struct {
+	Body         string   `json:"body"`
+	Incoming     *bool    `json:"incoming,omitempty"`
+	Private      *bool    `json:"private,omitempty"`
+	UserID       int      `json:"user_id,omitempty"`
+	NotifyEmails []string `json:"notify_emails,omitempty"`
+}
+
+// UpdateConversationModel is a data struct for updating a Conversation
+type UpdateConversationModel struct {
+	Body string `json:"body"`
 }
 
 // ListConversationsOptions represents filters/pagination for Conversations
@@ -34,8 +66,101 @@ type ListConversationsOptions struct {
 }
 
 // ListConversations will return paginated/filtered Conversation using ListConversationsOptions
-func (s *TicketService) ListConversations(ticketId int, opt *ListConversationsOptions) (*Conversations, *http.Response, error) {
+func (s *TicketService) ListConversations(ctx context.Context, ticketId int, opt *ListConversationsOptions) (*Conversations, *Response, error) {
 	o := new(Conversations)
-	res, err := s.client.List(fmt.Sprintf(ticketConversationsUrl, ticketId), opt, &o)
+	res, err := s.client.List(ctx, fmt.Sprintf(ticketConversationsUrl, ticketId), opt, &o)
 	return o, res, err
 }
+
+// IterConversations will call fn for every Conversation matching ListConversationsOptions, following pagination until fn returns false or a limit is reached
+func (s *TicketService) IterConversations(ctx context.Context, ticketId int, opt *ListConversationsOptions, limit *PaginationOptions, fn func(Conversation) bool) error {
+	o := ListConversationsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListConversations(ctx, ticketId, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllConversations will return every Conversation matching ListConversationsOptions by following pagination
+func (s *TicketService) ListAllConversations(ctx context.Context, ticketId int, opt *ListConversationsOptions, limit *PaginationOptions) ([]Conversation, error) {
+	var all []Conversation
+	err := s.IterConversations(ctx, ticketId, opt, limit, func(i Conversation) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
+
+// Reply will reply to the requester of a Ticket and return the new Conversation
+func (s *TicketService) Reply(ctx context.Context, ticketId int, reply *CreateReplyModel) (*Conversation, *Response, error) {
+	o := new(conversationWrapper)
+	res, err := s.client.Post(ctx, fmt.Sprintf(ticketReplyUrl, ticketId), reply, &o)
+	return &o.Details, res, err
+}
+
+// ReplyWithAttachments will reply to the requester of a Ticket, uploading files as attachments (see CreateTicketWithAttachments)
+func (s *TicketService) ReplyWithAttachments(ctx context.Context, ticketId int, reply *CreateReplyModel, files ...io.Reader) (*Conversation, *Response, error) {
+	body, err := newMultipartBody(reply, attachmentsFormKey, files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o := new(conversationWrapper)
+	res, err := s.client.Post(ctx, fmt.Sprintf(ticketReplyUrl, ticketId), body, &o)
+	return &o.Details, res, err
+}
+
+// CreateNote will add a Note to a Ticket and return the new Conversation
+func (s *TicketService) CreateNote(ctx context.Context, ticketId int, note *CreateConversationNoteModel) (*Conversation, *Response, error) {
+	o := new(conversationWrapper)
+	res, err := s.client.Post(ctx, fmt.Sprintf(ticketNotesUrl, ticketId), note, &o)
+	return &o.Details, res, err
+}
+
+// CreateNoteWithAttachments will add a Note to a Ticket, uploading files as attachments (see CreateTicketWithAttachments)
+func (s *TicketService) CreateNoteWithAttachments(ctx context.Context, ticketId int, note *CreateConversationNoteModel, files ...io.Reader) (*Conversation, *Response, error) {
+	body, err := newMultipartBody(note, attachmentsFormKey, files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o := new(conversationWrapper)
+	res, err := s.client.Post(ctx, fmt.Sprintf(ticketNotesUrl, ticketId), body, &o)
+	return &o.Details, res, err
+}
+
+// UpdateConversation will update and return a Conversation (reply or note) matching id based on UpdateConversationModel
+func (s *TicketService) UpdateConversation(ctx context.Context, conversationId int, conversation *UpdateConversationModel) (*Conversation, *Response, error) {
+	o := new(conversationWrapper)
+	res, err := s.client.Put(ctx, fmt.Sprintf(conversationIdUrl, conversationId), conversation, &o)
+	return &o.Details, res, err
+}
+
+// UpdateConversationWithAttachments will update a Conversation, uploading files as additional attachments (see CreateTicketWithAttachments)
+func (s *TicketService) UpdateConversationWithAttachments(ctx context.Context, conversationId int, conversation *UpdateConversationModel, files ...io.Reader) (*Conversation, *Response, error) {
+	body, err := newMultipartBody(conversation, attachmentsFormKey, files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o := new(conversationWrapper)
+	res, err := s.client.Put(ctx, fmt.Sprintf(conversationIdUrl, conversationId), body, &o)
+	return &o.Details, res, err
+}
+
+// DeleteConversation will completely remove a Conversation (reply or note) from a Ticket
+func (s *TicketService) DeleteConversation(ctx context.Context, conversationId int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(conversationIdUrl, conversationId))
+	return success, res, err
+}
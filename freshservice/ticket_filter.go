@@ -0,0 +1,87 @@
+package freshservice
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ticketsFilterUrl = "tickets/filter"
+
+	// filterPerPage is the fixed page size of the filter endpoints
+	filterPerPage = 30
+	// filterMaxPages is the last page the filter endpoints will return
+	filterMaxPages = 10
+)
+
+// FilterTicketsOptions represents pagination for FilterTickets, pages are fixed at 30 results and capped at page 10
+type FilterTicketsOptions struct {
+	Page int `json:"page,omitempty" url:"page,omitempty"`
+}
+
+// filterTicketsQuery is the query string sent to the filter endpoint
+type filterTicketsQuery struct {
+	Query string `url:"query"`
+	Page  int    `url:"page,omitempty"`
+}
+
+// filteredTickets contains a page of Tickets along with the total number of matches
+type filteredTickets struct {
+	Tickets
+	Total int `json:"total"`
+}
+
+// FilterTickets will return a page of Tickets matching the Query, the total number of matches is set on Response.TotalEntries
+func (s *TicketService) FilterTickets(ctx context.Context, q Query, opt *FilterTicketsOptions) (*Tickets, *Response, error) {
+	expr, err := q.Build()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid ticket filter: %v", err)
+	}
+
+	f := filterTicketsQuery{Query: expr}
+	if opt != nil {
+		if opt.Page > filterMaxPages {
+			return nil, nil, fmt.Errorf("page %d is beyond the last page (%d) of the filter endpoint", opt.Page, filterMaxPages)
+		}
+		f.Page = opt.Page
+	}
+
+	o := new(filteredTickets)
+	res, err := s.client.List(ctx, ticketsFilterUrl, &f, &o)
+	if res != nil {
+		res.TotalEntries = o.Total
+	}
+	return &o.Tickets, res, err
+}
+
+// IterFilterTickets will call fn for every Ticket matching the Query, until fn returns false, a limit is reached or the
+// last page (10) of the filter endpoint has been read. PaginationOptions.PerPage is ignored as the page size is fixed.
+func (s *TicketService) IterFilterTickets(ctx context.Context, q Query, limit *PaginationOptions, fn func(Ticket) bool) error {
+	o := ListOptions{}
+	return paginate(&o, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.FilterTickets(ctx, q, &FilterTicketsOptions{Page: o.Page})
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		if o.Page*filterPerPage >= res.TotalEntries || o.Page >= filterMaxPages {
+			return res, false, nil
+		}
+		res.NextPage = o.Page + 1
+		return res, true, nil
+	})
+}
+
+// ListAllFilterTickets will return every Ticket matching the Query, up to the 300 results the filter endpoint allows
+func (s *TicketService) ListAllFilterTickets(ctx context.Context, q Query, limit *PaginationOptions) ([]Ticket, error) {
+	var all []Ticket
+	err := s.IterFilterTickets(ctx, q, limit, func(i Ticket) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
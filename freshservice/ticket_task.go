@@ -1,40 +1,70 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 )
 
 // GetTask will return a single Task from a Ticket by the id
-func (s *TicketService) GetTask(ticketId int, taskId int) (*Task, *http.Response, error) {
+func (s *TicketService) GetTask(ctx context.Context, ticketId int, taskId int) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Get(fmt.Sprintf(ticketTaskIdUrl, ticketId, taskId), &o)
+    res, err := s.client.Get(ctx, fmt.Sprintf(ticketTaskIdUrl, ticketId, taskId), &o)
     return &o.Details, res, err
 }
 
 // ListTasks will return paginated/filtered Tasks using ListTasksOptions
-func (s *TicketService) ListTasks(ticketId int, opt *ListTasksOptions) (*Tasks, *http.Response, error) {
+func (s *TicketService) ListTasks(ctx context.Context, ticketId int, opt *ListTasksOptions) (*Tasks, *Response, error) {
     o := new(Tasks)
-    res, err := s.client.List(fmt.Sprintf(ticketTasksUrl, ticketId), opt, &o)
+    res, err := s.client.List(ctx, fmt.Sprintf(ticketTasksUrl, ticketId), opt, &o)
     return o, res, err
 }
 
 // CreateTask will create and return a new Task based on CreateTaskModel
-func (s *TicketService) CreateTask(ticketId int, newTask *CreateTaskModel) (*Task, *http.Response, error) {
+func (s *TicketService) CreateTask(ctx context.Context, ticketId int, newTask *CreateTaskModel) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Post(fmt.Sprintf(ticketTasksUrl, ticketId), newTask, &o)
+    res, err := s.client.Post(ctx, fmt.Sprintf(ticketTasksUrl, ticketId), newTask, &o)
     return &o.Details, res, err
 }
 
 // UpdateTask will update and return a Task matching id based on UpdateTaskModel
-func (s *TicketService) UpdateTask(ticketId int, taskId int, task *UpdateTaskModel) (*Task, *http.Response, error) {
+func (s *TicketService) UpdateTask(ctx context.Context, ticketId int, taskId int, task *UpdateTaskModel) (*Task, *Response, error) {
     o := new(taskWrapper)
-    res, err := s.client.Put(fmt.Sprintf(ticketTaskIdUrl, ticketId, taskId), task, &o)
+    res, err := s.client.Put(ctx, fmt.Sprintf(ticketTaskIdUrl, ticketId, taskId), task, &o)
     return &o.Details, res, err
 }
 
 // DeleteTask deletes the Task on a Ticket with the given ID
-func (s *TicketService) DeleteTask(ticketId int, taskId int) (bool, *http.Response, error) {
-    success, res, err := s.client.Delete(fmt.Sprintf(ticketTaskIdUrl, ticketId, taskId))
+func (s *TicketService) DeleteTask(ctx context.Context, ticketId int, taskId int) (bool, *Response, error) {
+    success, res, err := s.client.Delete(ctx, fmt.Sprintf(ticketTaskIdUrl, ticketId, taskId))
     return success, res, err
 }
+
+// IterTicketTasks will call fn for every Task matching ListTasksOptions, following pagination until fn returns false or a limit is reached
+func (s *TicketService) IterTicketTasks(ctx context.Context, ticketId int, opt *ListTasksOptions, limit *PaginationOptions, fn func(Task) bool) error {
+    o := ListTasksOptions{}
+    if opt != nil {
+        o = *opt
+    }
+    return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+        page, res, err := s.ListTasks(ctx, ticketId, &o)
+        if err != nil {
+            return nil, false, err
+        }
+        for _, i := range page.Collection {
+            if !yield() || !fn(i) {
+                return res, false, nil
+            }
+        }
+        return res, true, nil
+    })
+}
+
+// ListAllTicketTasks will return every Task matching ListTasksOptions by following pagination
+func (s *TicketService) ListAllTicketTasks(ctx context.Context, ticketId int, opt *ListTasksOptions, limit *PaginationOptions) ([]Task, error) {
+    var all []Task
+    err := s.IterTicketTasks(ctx, ticketId, opt, limit, func(i Task) bool {
+        all = append(all, i)
+        return true
+    })
+    return all, err
+}
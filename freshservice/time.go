@@ -0,0 +1,125 @@
+package freshservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// dateLayout is the format FreshService uses for date-only values
+const dateLayout = "2006-01-02"
+
+// timeLayouts are the formats FreshService has been seen to return dates and times in, tried in order
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	dateLayout,
+	"Mon, 02 Jan 2006",
+	"02 Jan 2006",
+}
+
+// Time is a timestamp in FreshService, it decodes null and any of the formats FreshService uses (zoned, unzoned or
+// date-only) and is sent as RFC3339, or null when zero
+type Time struct {
+	time.Time
+}
+
+// NewTime wraps t as a Time
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// Ptr returns a pointer to the Time, for setting a field of an update model
+func (t Time) Ptr() *Time {
+	return &t
+}
+
+// MarshalJSON sends the Time as RFC3339, or null when zero
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339))
+}
+
+// UnmarshalJSON reads a Time from null or any of the formats FreshService uses
+func (t *Time) UnmarshalJSON(b []byte) error {
+	p, err := parseTimeJSON(b)
+	if err != nil {
+		return err
+	}
+	t.Time = p
+	return nil
+}
+
+// Date is a date-only value in FreshService (e.g. the start date of a Contract or a Holiday), it decodes the same
+// formats as Time and is sent as YYYY-MM-DD, or null when zero
+type Date struct {
+	time.Time
+}
+
+// NewDate wraps t as a Date, only the year, month and day of t are sent
+func NewDate(t time.Time) Date {
+	return Date{Time: t}
+}
+
+// Ptr returns a pointer to the Date, for setting a field of an update model
+func (d Date) Ptr() *Date {
+	return &d
+}
+
+// String returns the Date as YYYY-MM-DD
+func (d Date) String() string {
+	return d.Format(dateLayout)
+}
+
+// MarshalJSON sends the Date as YYYY-MM-DD, or null when zero
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Format(dateLayout))
+}
+
+// UnmarshalJSON reads a Date from null or any of the formats FreshService uses
+func (d *Date) UnmarshalJSON(b []byte) error {
+	p, err := parseTimeJSON(b)
+	if err != nil {
+		return err
+	}
+	d.Time = p
+	return nil
+}
+
+// parseTimeJSON parses a JSON string in any of the timeLayouts, null and empty strings result in the zero time
+func parseTimeJSON(b []byte) (time.Time, error) {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		return time.Time{}, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s", b)
+	}
+
+	return parseTime(s)
+}
+
+// parseTime parses s in any of the timeLayouts, values without a zone are taken as UTC
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
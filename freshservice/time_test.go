@@ -0,0 +1,87 @@
+package freshservice_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+func TestTimeUnmarshalJSON(t *testing.T) {
+	cet := time.FixedZone("", 2*60*60)
+
+	tests := []struct {
+		json    string
+		want    time.Time
+		wantErr bool
+	}{
+		{json: `"2021-07-01T15:04:05Z"`, want: time.Date(2021, 7, 1, 15, 4, 5, 0, time.UTC)},
+		{json: `"2021-07-01T15:04:05.123Z"`, want: time.Date(2021, 7, 1, 15, 4, 5, 123000000, time.UTC)},
+		{json: `"2021-07-01T15:04:05+02:00"`, want: time.Date(2021, 7, 1, 15, 4, 5, 0, cet)},
+		{json: `"2021-07-01T15:04:05+0200"`, want: time.Date(2021, 7, 1, 15, 4, 5, 0, cet)},
+		{json: `"2021-07-01T15:04:05"`, want: time.Date(2021, 7, 1, 15, 4, 5, 0, time.UTC)},
+		{json: `"2021-07-01 15:04:05 +0200"`, want: time.Date(2021, 7, 1, 15, 4, 5, 0, cet)},
+		{json: `"2021-07-01 15:04:05"`, want: time.Date(2021, 7, 1, 15, 4, 5, 0, time.UTC)},
+		{json: `"2021-07-01"`, want: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{json: `"Thu, 01 Jul 2021"`, want: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{json: `"01 Jul 2021"`, want: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{json: `null`},
+		{json: `""`},
+		{json: `"yesterday"`, wantErr: true},
+		{json: `1625151845`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			var got freshservice.Time
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %s, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got.Time, tt.want)
+			}
+
+			var d freshservice.Date
+			if err = json.Unmarshal([]byte(tt.json), &d); err != nil || !d.Equal(tt.want) {
+				t.Errorf("got date %s (%v), want %s", d.Time, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeMarshalJSON(t *testing.T) {
+	at := time.Date(2021, 7, 1, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "time", value: freshservice.NewTime(at), want: `"2021-07-01T15:04:05Z"`},
+		{name: "zero time", value: freshservice.Time{}, want: `null`},
+		{name: "time pointer", value: freshservice.TimePtr(at), want: `"2021-07-01T15:04:05Z"`},
+		{name: "date", value: freshservice.NewDate(at), want: `"2021-07-01"`},
+		{name: "zero date", value: freshservice.Date{}, want: `null`},
+		{name: "date pointer", value: freshservice.NewDate(at).Ptr(), want: `"2021-07-01"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("got %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
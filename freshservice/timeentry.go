@@ -1,20 +1,18 @@
 package freshservice
 
-import "time"
-
 // TimeEntry represents a TimeEntry associated to a Task / Ticket
 type TimeEntry struct {
-	ID           int       `json:"id"`
-	StartTime    time.Time `json:"start_time"`
-	ExecutedAt   time.Time `json:"executed_at"`
-	TimerRunning bool      `json:"timer_running"`
-	Billable     bool      `json:"billable"`
-	TimeSpent    string    `json:"time_spent"`
-	TaskID       int       `json:"task_id"`
-	AgentID      int       `json:"agent_id"`
-	Note         string    `json:"note"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           int    `json:"id"`
+	StartTime    Time   `json:"start_time"`
+	ExecutedAt   Time   `json:"executed_at"`
+	TimerRunning bool   `json:"timer_running"`
+	Billable     bool   `json:"billable"`
+	TimeSpent    string `json:"time_spent"`
+	TaskID       int    `json:"task_id"`
+	AgentID      int    `json:"agent_id"`
+	Note         string `json:"note"`
+	CreatedAt    Time   `json:"created_at"`
+	UpdatedAt    Time   `json:"updated_at"`
 }
 
 // timeEntryWrapper contains Details of one TimeEntry
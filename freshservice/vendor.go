@@ -1,9 +1,8 @@
 package freshservice
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"time"
 )
 
 const (
@@ -33,8 +32,8 @@ type Vendor struct {
 	Description      string        `json:"description"`
 	PrimaryContactID int           `json:"primary_contact_id"`
 	Address          VendorAddress `json:"address"`
-	CreatedAt        time.Time     `json:"created_at"`
-	UpdatedAt        time.Time     `json:"updated_at"`
+	CreatedAt        Time          `json:"created_at"`
+	UpdatedAt        Time          `json:"updated_at"`
 }
 
 // VendorAddress is an alternative to Address but with only one address line
@@ -56,10 +55,17 @@ type CreateVendorModel struct {
 
 // UpdateVendorModel is the data structure required to update a Vendor
 type UpdateVendorModel struct {
-	Name             string        `json:"name"`
-	Description      string        `json:"description"`
-	PrimaryContactID int           `json:"primary_contact_id"`
-	Address          VendorAddress `json:"address"`
+	Name             *string        `json:"name,omitempty"`
+	Description      *string        `json:"description,omitempty"`
+	PrimaryContactID *int           `json:"primary_contact_id,omitempty"`
+	Address          *VendorAddress `json:"address,omitempty"`
+	NullFields       []string       `json:"-"`
+}
+
+// MarshalJSON only sends the fields of UpdateVendorModel which are set, along with the NullFields as null
+func (m UpdateVendorModel) MarshalJSON() ([]byte, error) {
+	type model UpdateVendorModel
+	return marshalUpdate(model(m), m.NullFields)
 }
 
 // ListVendorsOptions represents filters/pagination for Vendors
@@ -68,35 +74,65 @@ type ListVendorsOptions struct {
 }
 
 // GetVendor will return a single Vendor by id
-func (s *VendorService) GetVendor(id int) (*Vendor, *http.Response, error) {
+func (s *VendorService) GetVendor(ctx context.Context, id int) (*Vendor, *Response, error) {
 	o := new(vendorWrapper)
-	res, err := s.client.Get(fmt.Sprintf(vendorIdUrl, id), &o)
+	res, err := s.client.Get(ctx, fmt.Sprintf(vendorIdUrl, id), &o)
 	return &o.Details, res, err
 }
 
 // ListVendors will return paginated/filtered Vendors using ListVendorsOptions
-func (s *VendorService) ListVendors(opt *ListVendorsOptions) (*Vendors, *http.Response, error) {
+func (s *VendorService) ListVendors(ctx context.Context, opt *ListVendorsOptions) (*Vendors, *Response, error) {
 	o := new(Vendors)
-	res, err := s.client.List(vendorsUrl, opt, &o)
+	res, err := s.client.List(ctx, vendorsUrl, opt, &o)
 	return o, res, err
 }
 
 // CreateVendor will create and return a new Vendor based on CreateVendorModel
-func (s *VendorService) CreateVendor(newVendor *CreateVendorModel) (*Vendor, *http.Response, error) {
+func (s *VendorService) CreateVendor(ctx context.Context, newVendor *CreateVendorModel) (*Vendor, *Response, error) {
 	o := new(vendorWrapper)
-	res, err := s.client.Post(vendorsUrl, newVendor, &o)
+	res, err := s.client.Post(ctx, vendorsUrl, newVendor, &o)
 	return &o.Details, res, err
 }
 
 // UpdateVendor will update and return a Vendor matching id based on UpdateVendorModel
-func (s *VendorService) UpdateVendor(id int, vendor *UpdateVendorModel) (*Vendor, *http.Response, error) {
+func (s *VendorService) UpdateVendor(ctx context.Context, id int, vendor *UpdateVendorModel) (*Vendor, *Response, error) {
 	o := new(vendorWrapper)
-	res, err := s.client.Put(fmt.Sprintf(vendorIdUrl, id), vendor, &o)
+	res, err := s.client.Put(ctx, fmt.Sprintf(vendorIdUrl, id), vendor, &o)
 	return &o.Details, res, err
 }
 
 // DeleteVendor will completely remove a Vendor from FreshService matching id
-func (s *VendorService) DeleteVendor(id int) (bool, *http.Response, error) {
-	success, res, err := s.client.Delete(fmt.Sprintf(vendorIdUrl, id))
+func (s *VendorService) DeleteVendor(ctx context.Context, id int) (bool, *Response, error) {
+	success, res, err := s.client.Delete(ctx, fmt.Sprintf(vendorIdUrl, id))
 	return success, res, err
 }
+
+// IterVendors will call fn for every Vendor matching ListVendorsOptions, following pagination until fn returns false or a limit is reached
+func (s *VendorService) IterVendors(ctx context.Context, opt *ListVendorsOptions, limit *PaginationOptions, fn func(Vendor) bool) error {
+	o := ListVendorsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	return paginate(&o.ListOptions, limit, func(yield func() bool) (*Response, bool, error) {
+		page, res, err := s.ListVendors(ctx, &o)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, i := range page.Collection {
+			if !yield() || !fn(i) {
+				return res, false, nil
+			}
+		}
+		return res, true, nil
+	})
+}
+
+// ListAllVendors will return every Vendor matching ListVendorsOptions by following pagination
+func (s *VendorService) ListAllVendors(ctx context.Context, opt *ListVendorsOptions, limit *PaginationOptions) ([]Vendor, error) {
+	var all []Vendor
+	err := s.IterVendors(ctx, opt, limit, func(i Vendor) bool {
+		all = append(all, i)
+		return true
+	})
+	return all, err
+}
@@ -0,0 +1,391 @@
+package freshservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWatchInterval = time.Minute
+	defaultWatchOverlap  = 2 * time.Minute
+	defaultWatchPerPage  = 100
+)
+
+// WatchResource is a type of resource followed by a Watcher
+type WatchResource string
+
+const (
+	WatchTickets  WatchResource = "tickets"
+	WatchChanges  WatchResource = "changes"
+	WatchProblems WatchResource = "problems"
+	WatchReleases WatchResource = "releases"
+	WatchAssets   WatchResource = "assets"
+)
+
+// WatchEventType is the type of a WatchEvent
+type WatchEventType string
+
+const (
+	WatchCreated WatchEventType = "created"
+	WatchUpdated WatchEventType = "updated"
+)
+
+// WatchEvent is emitted by a Watcher for every resource created or updated, only the field of its Resource is set
+type WatchEvent struct {
+	Type      WatchEventType
+	Resource  WatchResource
+	ID        int
+	UpdatedAt Time
+	Ticket    *Ticket
+	Change    *Change
+	Problem   *Problem
+	Release   *Release
+	Asset     *Asset
+}
+
+// WatchCheckpoint is the position of a Watcher for a WatchResource: the high-water mark (the latest updated_at seen)
+// and the resources seen within the overlap, keyed by id with their updated_at
+type WatchCheckpoint struct {
+	Mark Time         `json:"mark"`
+	Seen map[int]Time `json:"seen,omitempty"`
+}
+
+// CheckpointStore persists the WatchCheckpoint of each WatchResource, so a Watcher resumes where it stopped.
+// LoadCheckpoint returns nil when there is no WatchCheckpoint yet.
+type CheckpointStore interface {
+	LoadCheckpoint(ctx context.Context, resource WatchResource) (*WatchCheckpoint, error)
+	SaveCheckpoint(ctx context.Context, resource WatchResource, checkpoint *WatchCheckpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore kept in memory, it is used when WatcherOptions has no Store
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[WatchResource]WatchCheckpoint
+}
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: map[WatchResource]WatchCheckpoint{}}
+}
+
+// LoadCheckpoint implements CheckpointStore
+func (m *MemoryCheckpointStore) LoadCheckpoint(_ context.Context, resource WatchResource) (*WatchCheckpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cp, ok := m.checkpoints[resource]
+	if !ok {
+		return nil, nil
+	}
+	return cp.copy(), nil
+}
+
+// SaveCheckpoint implements CheckpointStore
+func (m *MemoryCheckpointStore) SaveCheckpoint(_ context.Context, resource WatchResource, checkpoint *WatchCheckpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.checkpoints[resource] = *checkpoint.copy()
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore keeping a JSON file per WatchResource in Dir
+type FileCheckpointStore struct {
+	Dir string
+}
+
+// LoadCheckpoint implements CheckpointStore
+func (f FileCheckpointStore) LoadCheckpoint(_ context.Context, resource WatchResource) (*WatchCheckpoint, error) {
+	b, err := ioutil.ReadFile(f.path(resource))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cp := new(WatchCheckpoint)
+	if err = json.Unmarshal(b, cp); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint for %s: %v", resource, err)
+	}
+	return cp, nil
+}
+
+// SaveCheckpoint implements CheckpointStore, the file is replaced atomically
+func (f FileCheckpointStore) SaveCheckpoint(_ context.Context, resource WatchResource, checkpoint *WatchCheckpoint) error {
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(f.Dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(f.Dir, string(resource)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.path(resource))
+}
+
+func (f FileCheckpointStore) path(resource WatchResource) string {
+	return filepath.Join(f.Dir, string(resource)+".json")
+}
+
+func (cp *WatchCheckpoint) copy() *WatchCheckpoint {
+	c := &WatchCheckpoint{Mark: cp.Mark, Seen: make(map[int]Time, len(cp.Seen))}
+	for id, updated := range cp.Seen {
+		c.Seen[id] = updated
+	}
+	return c
+}
+
+// WatcherOptions configures a Watcher, the zero value follows every WatchResource from now on
+type WatcherOptions struct {
+	// Resources to follow, all of them when empty
+	Resources []WatchResource
+	// Interval between polls, defaults to a minute
+	Interval time.Duration
+	// Overlap is subtracted from the high-water mark when polling to catch changes committed late (or with a skewed
+	// clock), resources seen within the overlap are not emitted again. Defaults to 2 minutes.
+	Overlap time.Duration
+	// Since is where to start when there is no checkpoint, defaults to when the Watcher is created
+	Since time.Time
+	// Store persists the checkpoints, defaults to a MemoryCheckpointStore
+	Store CheckpointStore
+	// PerPage is the page size used when polling, defaults to 100
+	PerPage int
+	// Buffer is the capacity of the Events channel
+	Buffer int
+	// OnError is called with the errors of Run, which keeps polling. Run returns the first error when nil.
+	OnError func(err error)
+}
+
+// Watcher polls FreshService for resources updated since its high-water mark and emits them on its Events channel.
+// The checkpoint of a WatchResource is saved as its events are delivered to the channel, so a Watcher which is stopped
+// mid-poll resumes where it stopped (emitting at most a page of events again).
+type Watcher struct {
+	api    *API
+	opt    WatcherOptions
+	events chan WatchEvent
+}
+
+// watchItem is a resource returned by a poll
+type watchItem struct {
+	id      int
+	created Time
+	updated Time
+	event   WatchEvent
+}
+
+// NewWatcher creates a Watcher using the services of api (see Client.API)
+func NewWatcher(api *API, opt *WatcherOptions) *Watcher {
+	w := &Watcher{api: api}
+	if opt != nil {
+		w.opt = *opt
+	}
+
+	if len(w.opt.Resources) == 0 {
+		w.opt.Resources = []WatchResource{WatchTickets, WatchChanges, WatchProblems, WatchReleases, WatchAssets}
+	}
+	if w.opt.Interval <= 0 {
+		w.opt.Interval = defaultWatchInterval
+	}
+	if w.opt.Overlap <= 0 {
+		w.opt.Overlap = defaultWatchOverlap
+	}
+	if w.opt.Since.IsZero() {
+		w.opt.Since = time.Now()
+	}
+	if w.opt.Store == nil {
+		w.opt.Store = NewMemoryCheckpointStore()
+	}
+	if w.opt.PerPage <= 0 {
+		w.opt.PerPage = defaultWatchPerPage
+	}
+
+	w.events = make(chan WatchEvent, w.opt.Buffer)
+	return w
+}
+
+// Events returns the channel of WatchEvents, it is closed when Run returns
+func (w *Watcher) Events() <-chan WatchEvent {
+	return w.events
+}
+
+// Run polls every Interval until ctx is done, returning ctx.Err()
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.events)
+
+	ticker := time.NewTicker(w.opt.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if w.opt.OnError == nil {
+				return err
+			}
+			w.opt.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches the resources updated since the checkpoint of each WatchResource once, emitting them in the order they
+// are listed
+func (w *Watcher) Poll(ctx context.Context) error {
+	for _, resource := range w.opt.Resources {
+		if err := w.poll(ctx, resource); err != nil {
+			return fmt.Errorf("unable to poll %s: %w", resource, err)
+		}
+	}
+	return nil
+}
+
+// poll streams the resources updated since the checkpoint, saving it every page of events so that an interrupted poll
+// resumes without emitting them again. The lists are not ordered by updated_at, so the mark only moves on once the
+// poll completes, until then the resources emitted are skipped using the seen ids.
+func (w *Watcher) poll(ctx context.Context, resource WatchResource) error {
+	cp, err := w.opt.Store.LoadCheckpoint(ctx, resource)
+	if err != nil {
+		return fmt.Errorf("unable to load checkpoint: %w", err)
+	}
+
+	since := w.opt.Since
+	if cp == nil {
+		cp = &WatchCheckpoint{Mark: NewTime(since)}
+	} else {
+		since = cp.Mark.Add(-w.opt.Overlap)
+	}
+	if cp.Seen == nil {
+		cp.Seen = map[int]Time{}
+	}
+
+	mark := cp.Mark
+	emitted := 0
+	var saveErr error
+	err = w.fetch(ctx, resource, since, func(item watchItem) bool {
+		if item.updated.Before(since) {
+			return true
+		}
+		previous, seen := cp.Seen[item.id]
+		if seen && previous.Equal(item.updated.Time) {
+			// emitted by an interrupted poll, it still counts towards the mark
+			if item.updated.After(mark.Time) {
+				mark = item.updated
+			}
+			return true
+		}
+
+		e := item.event
+		e.Resource, e.ID, e.UpdatedAt = resource, item.id, item.updated
+		e.Type = WatchUpdated
+		if !seen && !item.created.Before(since) {
+			e.Type = WatchCreated
+		}
+
+		select {
+		case w.events <- e:
+		case <-ctx.Done():
+			return false
+		}
+
+		cp.Seen[item.id] = item.updated
+		if item.updated.After(mark.Time) {
+			mark = item.updated
+		}
+
+		if emitted++; emitted%w.opt.PerPage == 0 {
+			if saveErr = w.opt.Store.SaveCheckpoint(ctx, resource, cp); saveErr != nil {
+				return false
+			}
+		}
+		return true
+	})
+	if saveErr != nil {
+		return fmt.Errorf("unable to save checkpoint: %w", saveErr)
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		if emitted > 0 {
+			// keep the progress made, the error of the poll is the one reported
+			_ = w.opt.Store.SaveCheckpoint(context.Background(), resource, cp)
+		}
+		return err
+	}
+
+	cp.Mark = mark
+	cutoff := cp.Mark.Add(-w.opt.Overlap)
+	for id, updated := range cp.Seen {
+		if updated.Before(cutoff) {
+			delete(cp.Seen, id)
+		}
+	}
+
+	if err = w.opt.Store.SaveCheckpoint(ctx, resource, cp); err != nil {
+		return fmt.Errorf("unable to save checkpoint: %w", err)
+	}
+	return nil
+}
+
+// fetch calls fn for each resource updated since, page by page, until fn returns false
+func (w *Watcher) fetch(ctx context.Context, resource WatchResource, since time.Time, fn func(watchItem) bool) error {
+	limit := &PaginationOptions{PerPage: w.opt.PerPage}
+
+	switch resource {
+	case WatchTickets:
+		return w.api.Tickets.IterTickets(ctx, &ListTicketsOptions{UpdatedSince: &since}, limit, func(t Ticket) bool {
+			return fn(watchItem{id: t.ID, created: t.CreatedAt, updated: t.UpdatedAt, event: WatchEvent{Ticket: &t}})
+		})
+
+	case WatchChanges:
+		return w.api.Changes.IterChanges(ctx, &ListChangesOptions{UpdatedSince: &since}, limit, func(c Change) bool {
+			return fn(watchItem{id: c.ID, created: c.CreatedAt, updated: c.UpdatedAt, event: WatchEvent{Change: &c}})
+		})
+
+	case WatchProblems:
+		return w.api.Problems.IterProblems(ctx, &ListProblemsOptions{UpdatedSince: &since}, limit, func(p Problem) bool {
+			return fn(watchItem{id: p.ID, created: p.CreatedAt, updated: p.UpdatedAt, event: WatchEvent{Problem: &p}})
+		})
+
+	case WatchReleases:
+		return w.api.Releases.IterReleases(ctx, &ListReleasesOptions{UpdatedSince: &since}, limit, func(r Release) bool {
+			return fn(watchItem{id: r.ID, created: r.CreatedAt, updated: r.UpdatedAt, event: WatchEvent{Release: &r}})
+		})
+
+	case WatchAssets:
+		// the asset filter compares dates, so the day before is included and the rest is dropped by updated_at
+		return w.api.Assets.IterFilterAssets(ctx, Q.Gt("updated_at", since.AddDate(0, 0, -1)), nil, limit, func(a Asset) bool {
+			return fn(watchItem{id: a.DisplayID, created: a.CreatedAt, updated: a.UpdatedAt, event: WatchEvent{Asset: &a}})
+		})
+	}
+
+	return fmt.Errorf("unknown resource %q", resource)
+}
@@ -0,0 +1,355 @@
+package freshservice_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicemock"
+)
+
+var watchSince = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// watchTickets returns n tickets updated one second apart after watchSince, listed out of updated_at order
+func watchTickets(n int) []freshservice.Ticket {
+	tickets := make([]freshservice.Ticket, n)
+	for i := range tickets {
+		at := freshservice.NewTime(watchSince.Add(time.Duration(n-i) * time.Second))
+		tickets[i] = freshservice.Ticket{ID: i + 1, CreatedAt: at, UpdatedAt: at}
+	}
+	return tickets
+}
+
+// pagedTickets mocks IterTickets listing tickets page by page, counting the pages fetched
+func pagedTickets(tickets func() []freshservice.Ticket, pages *int32) func(context.Context, *freshservice.ListTicketsOptions, *freshservice.PaginationOptions, func(freshservice.Ticket) bool) error {
+	return func(ctx context.Context, opt *freshservice.ListTicketsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Ticket) bool) error {
+		all := tickets()
+		for start := 0; start < len(all); start += limit.PerPage {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			atomic.AddInt32(pages, 1)
+
+			end := start + limit.PerPage
+			if end > len(all) {
+				end = len(all)
+			}
+			for _, t := range all[start:end] {
+				if !fn(t) {
+					return nil
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// countingStore is a CheckpointStore recording how often each checkpoint is saved
+type countingStore struct {
+	*freshservice.MemoryCheckpointStore
+	saves int32
+}
+
+func (s *countingStore) SaveCheckpoint(ctx context.Context, resource freshservice.WatchResource, cp *freshservice.WatchCheckpoint) error {
+	atomic.AddInt32(&s.saves, 1)
+	return s.MemoryCheckpointStore.SaveCheckpoint(ctx, resource, cp)
+}
+
+func newTicketWatcher(tickets func() []freshservice.Ticket, pages *int32, store freshservice.CheckpointStore) *freshservice.Watcher {
+	api := freshservicemock.New()
+	api.Tickets.IterTicketsFunc = pagedTickets(tickets, pages)
+
+	return freshservice.NewWatcher(api.API(), &freshservice.WatcherOptions{
+		Resources: []freshservice.WatchResource{freshservice.WatchTickets},
+		Since:     watchSince,
+		Store:     store,
+		PerPage:   100,
+	})
+}
+
+// poll runs a Poll of w, calling fn for each event until it returns false, which cancels the poll
+func poll(t *testing.T, w *freshservice.Watcher, fn func(freshservice.WatchEvent) bool) error {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- w.Poll(ctx)
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			return err
+		case e := <-w.Events():
+			if !fn(e) {
+				cancel()
+				return <-done
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("poll timed out")
+		}
+	}
+}
+
+func TestWatcherStreamsPages(t *testing.T) {
+	tickets := watchTickets(250)
+	var pages int32
+	store := &countingStore{MemoryCheckpointStore: freshservice.NewMemoryCheckpointStore()}
+	w := newTicketWatcher(func() []freshservice.Ticket { return tickets }, &pages, store)
+
+	var events []freshservice.WatchEvent
+	pagesAtFirstEvent := int32(-1)
+	err := poll(t, w, func(e freshservice.WatchEvent) bool {
+		if pagesAtFirstEvent < 0 {
+			pagesAtFirstEvent = atomic.LoadInt32(&pages)
+		}
+		events = append(events, e)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if pagesAtFirstEvent != 1 {
+		t.Errorf("expected the first event before the next page is fetched, %d pages were fetched", pagesAtFirstEvent)
+	}
+	if pages != 3 {
+		t.Errorf("expected 3 pages, got %d", pages)
+	}
+	if len(events) != 250 {
+		t.Fatalf("expected 250 events, got %d", len(events))
+	}
+	for i, e := range events {
+		if e.ID != i+1 || e.Type != freshservice.WatchCreated || e.Resource != freshservice.WatchTickets || e.Ticket == nil || e.Ticket.ID != e.ID {
+			t.Fatalf("event %d: unexpected %+v", i, e)
+		}
+	}
+
+	// every page of events, then once the poll completes
+	if store.saves != 3 {
+		t.Errorf("expected 3 checkpoint saves, got %d", store.saves)
+	}
+}
+
+func TestWatcherResumesInterruptedPoll(t *testing.T) {
+	tests := []struct {
+		name      string
+		stopAfter int
+	}{
+		{name: "within the first page", stopAfter: 30},
+		{name: "at a page boundary", stopAfter: 100},
+		{name: "within a later page", stopAfter: 150},
+		{name: "before the last event", stopAfter: 249},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tickets := watchTickets(250)
+			list := func() []freshservice.Ticket { return tickets }
+			store := freshservice.NewMemoryCheckpointStore()
+			var pages int32
+
+			seen := map[int]int{}
+			count := func(e freshservice.WatchEvent) bool {
+				seen[e.ID]++
+				return len(seen) < tt.stopAfter
+			}
+
+			err := poll(t, newTicketWatcher(list, &pages, store), count)
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("expected the poll to be cancelled, got %v", err)
+			}
+
+			// the mark only moves on once a poll completes
+			cp, _ := store.LoadCheckpoint(context.Background(), freshservice.WatchTickets)
+			if cp == nil || !cp.Mark.Equal(watchSince) {
+				t.Fatalf("expected the mark to stay at %v, got %+v", watchSince, cp)
+			}
+			if len(cp.Seen) != tt.stopAfter {
+				t.Errorf("expected %d seen tickets, got %d", tt.stopAfter, len(cp.Seen))
+			}
+
+			// a new Watcher resumes where the first one stopped
+			err = poll(t, newTicketWatcher(list, &pages, store), func(e freshservice.WatchEvent) bool {
+				seen[e.ID]++
+				return true
+			})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if len(seen) != 250 {
+				t.Errorf("expected 250 tickets, got %d", len(seen))
+			}
+			for id, n := range seen {
+				if n != 1 {
+					t.Errorf("ticket %d was emitted %d times", id, n)
+				}
+			}
+
+			cp, _ = store.LoadCheckpoint(context.Background(), freshservice.WatchTickets)
+			if want := watchSince.Add(250 * time.Second); !cp.Mark.Equal(want) {
+				t.Errorf("expected the mark to be %v, got %v", want, cp.Mark)
+			}
+		})
+	}
+}
+
+func TestWatcherEmitsUpdates(t *testing.T) {
+	var mu sync.Mutex
+	tickets := watchTickets(3)
+	list := func() []freshservice.Ticket {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]freshservice.Ticket(nil), tickets...)
+	}
+	store := freshservice.NewMemoryCheckpointStore()
+	var pages int32
+	w := newTicketWatcher(list, &pages, store)
+
+	collect := func() []freshservice.WatchEvent {
+		var events []freshservice.WatchEvent
+		if err := poll(t, w, func(e freshservice.WatchEvent) bool {
+			events = append(events, e)
+			return true
+		}); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		return events
+	}
+
+	if events := collect(); len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+
+	// nothing changed, nothing is emitted again within the overlap
+	if events := collect(); len(events) != 0 {
+		t.Fatalf("expected no events, got %+v", events)
+	}
+
+	mu.Lock()
+	tickets[1].UpdatedAt = freshservice.NewTime(watchSince.Add(time.Hour))
+	mu.Unlock()
+
+	events := collect()
+	if len(events) != 1 || events[0].ID != 2 || events[0].Type != freshservice.WatchUpdated {
+		t.Fatalf("expected ticket 2 to be updated, got %+v", events)
+	}
+
+	// tickets seen before the overlap are forgotten
+	cp, _ := store.LoadCheckpoint(context.Background(), freshservice.WatchTickets)
+	if !cp.Mark.Equal(watchSince.Add(time.Hour)) || len(cp.Seen) != 1 {
+		t.Errorf("unexpected checkpoint %+v", cp)
+	}
+}
+
+func TestWatcherSaveError(t *testing.T) {
+	var pages int32
+	store := failingStore{}
+	w := newTicketWatcher(func() []freshservice.Ticket { return watchTickets(150) }, &pages, store)
+
+	err := poll(t, w, func(freshservice.WatchEvent) bool { return true })
+	if !errors.Is(err, errStoreFull) {
+		t.Fatalf("expected the save error, got %v", err)
+	}
+	if pages != 1 {
+		t.Errorf("expected the poll to stop after the first page, %d pages were fetched", pages)
+	}
+}
+
+var errStoreFull = errors.New("store full")
+
+type failingStore struct{}
+
+func (failingStore) LoadCheckpoint(context.Context, freshservice.WatchResource) (*freshservice.WatchCheckpoint, error) {
+	return nil, nil
+}
+
+func (failingStore) SaveCheckpoint(context.Context, freshservice.WatchResource, *freshservice.WatchCheckpoint) error {
+	return errStoreFull
+}
+
+func TestCheckpointStores(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stores := []struct {
+		name  string
+		store freshservice.CheckpointStore
+	}{
+		{name: "memory", store: freshservice.NewMemoryCheckpointStore()},
+		{name: "file", store: freshservice.FileCheckpointStore{Dir: filepath.Join(dir, "nested")}},
+	}
+
+	for _, tt := range stores {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cp, err := tt.store.LoadCheckpoint(ctx, freshservice.WatchTickets)
+			if err != nil || cp != nil {
+				t.Fatalf("expected no checkpoint, got %+v, %v", cp, err)
+			}
+
+			saved := &freshservice.WatchCheckpoint{
+				Mark: freshservice.NewTime(watchSince),
+				Seen: map[int]freshservice.Time{1: freshservice.NewTime(watchSince)},
+			}
+			if err = tt.store.SaveCheckpoint(ctx, freshservice.WatchTickets, saved); err != nil {
+				t.Fatalf("unable to save: %v", err)
+			}
+			// the store keeps its own copy
+			saved.Seen[2] = freshservice.NewTime(watchSince)
+
+			cp, err = tt.store.LoadCheckpoint(ctx, freshservice.WatchTickets)
+			if err != nil {
+				t.Fatalf("unable to load: %v", err)
+			}
+			if !cp.Mark.Equal(watchSince) || len(cp.Seen) != 1 || !cp.Seen[1].Equal(watchSince) {
+				t.Errorf("unexpected checkpoint %+v", cp)
+			}
+
+			if cp, _ = tt.store.LoadCheckpoint(ctx, freshservice.WatchChanges); cp != nil {
+				t.Errorf("expected no checkpoint for changes, got %+v", cp)
+			}
+		})
+	}
+}
+
+func TestFileCheckpointStoreInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = ioutil.WriteFile(filepath.Join(dir, "tickets.json"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := freshservice.FileCheckpointStore{Dir: dir}
+	if _, err = store.LoadCheckpoint(context.Background(), freshservice.WatchTickets); err == nil {
+		t.Errorf("expected an error for an invalid checkpoint")
+	}
+
+	// saving replaces the file without leaving temporary files behind
+	if err = store.SaveCheckpoint(context.Background(), freshservice.WatchTickets, &freshservice.WatchCheckpoint{}); err != nil {
+		t.Fatalf("unable to save: %v", err)
+	}
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 1 {
+		t.Errorf("expected a single file, got %d", len(files))
+	}
+}
@@ -0,0 +1,132 @@
+// Package freshservicemock provides mocks of the freshservice service interfaces (freshservice.TicketsAPI, ...) which
+// record their calls and return canned responses, for unit testing code depending on a freshservice.API.
+//
+// Each mock method calls its Func field when set (e.g. TicketsAPI.GetTicketFunc), otherwise it returns the response
+// set with Return or ReturnOnce. Methods without a response return an error wrapping ErrNoResponse.
+package freshservicemock
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrNoResponse is returned by mocked methods which have not been given a response
+var ErrNoResponse = errors.New("freshservicemock: no response")
+
+// Call is a recorded call of a mocked method
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Mock records the calls of a mock and holds its canned responses, it is embedded in every mock
+type Mock struct {
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string][]interface{}
+	once      map[string][][]interface{}
+}
+
+// Return sets the values returned by every call of method, in the order of its results
+// (e.g. Return("GetTicket", &ticket, nil, nil))
+func (m *Mock) Return(method string, values ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.responses == nil {
+		m.responses = map[string][]interface{}{}
+	}
+	m.responses[method] = values
+}
+
+// ReturnOnce queues the values returned by the next call of method, taking precedence over Return
+func (m *Mock) ReturnOnce(method string, values ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.once == nil {
+		m.once = map[string][][]interface{}{}
+	}
+	m.once[method] = append(m.once[method], values)
+}
+
+// Calls returns the recorded calls of method, or every call when method is empty
+func (m *Mock) Calls(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var calls []Call
+	for _, c := range m.calls {
+		if method == "" || c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Called reports whether method has been called
+func (m *Mock) Called(method string) bool {
+	return len(m.Calls(method)) > 0
+}
+
+// Reset clears the recorded calls and canned responses
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+	m.responses = nil
+	m.once = nil
+}
+
+// record adds a call of method
+func (m *Mock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// returns sets results (pointers to the results of method) to its canned response
+func (m *Mock) returns(method string, results ...interface{}) {
+	m.mu.Lock()
+	values, ok := m.response(method)
+	m.mu.Unlock()
+
+	if !ok {
+		if len(results) > 0 {
+			if err, ok := results[len(results)-1].(*error); ok {
+				*err = fmt.Errorf("%w for %s", ErrNoResponse, method)
+			}
+		}
+		return
+	}
+
+	if len(values) != len(results) {
+		panic(fmt.Sprintf("freshservicemock: %s returns %d values, got %d", method, len(results), len(values)))
+	}
+
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+		target := reflect.ValueOf(results[i]).Elem()
+		value := reflect.ValueOf(v)
+		if !value.Type().AssignableTo(target.Type()) {
+			panic(fmt.Sprintf("freshservicemock: %s result %d must be %s, got %T", method, i, target.Type(), v))
+		}
+		target.Set(value)
+	}
+}
+
+func (m *Mock) response(method string) ([]interface{}, bool) {
+	if queued := m.once[method]; len(queued) > 0 {
+		m.once[method] = queued[1:]
+		return queued[0], true
+	}
+
+	values, ok := m.responses[method]
+	return values, ok
+}
@@ -0,0 +1,205 @@
+package freshservicemock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicemock"
+)
+
+func TestMockResponses(t *testing.T) {
+	ctx := context.Background()
+	errNotFound := errors.New("not found")
+
+	tests := []struct {
+		name  string
+		setup func(m *freshservicemock.TicketsAPI)
+		want  []int
+		errs  []error
+	}{
+		{
+			name: "no response",
+			setup: func(m *freshservicemock.TicketsAPI) {
+			},
+			want: []int{0, 0},
+			errs: []error{freshservicemock.ErrNoResponse, freshservicemock.ErrNoResponse},
+		},
+		{
+			name: "return",
+			setup: func(m *freshservicemock.TicketsAPI) {
+				m.Return("GetTicket", &freshservice.Ticket{ID: 1}, nil, nil)
+			},
+			want: []int{1, 1},
+			errs: []error{nil, nil},
+		},
+		{
+			name: "return once takes precedence",
+			setup: func(m *freshservicemock.TicketsAPI) {
+				m.Return("GetTicket", &freshservice.Ticket{ID: 1}, nil, nil)
+				m.ReturnOnce("GetTicket", nil, nil, errNotFound)
+			},
+			want: []int{0, 1},
+			errs: []error{errNotFound, nil},
+		},
+		{
+			name: "return once in order",
+			setup: func(m *freshservicemock.TicketsAPI) {
+				m.ReturnOnce("GetTicket", &freshservice.Ticket{ID: 2}, nil, nil)
+				m.ReturnOnce("GetTicket", &freshservice.Ticket{ID: 3}, nil, nil)
+			},
+			want: []int{2, 3},
+			errs: []error{nil, nil},
+		},
+		{
+			name: "return once without return",
+			setup: func(m *freshservicemock.TicketsAPI) {
+				m.ReturnOnce("GetTicket", &freshservice.Ticket{ID: 2}, nil, nil)
+			},
+			want: []int{2, 0},
+			errs: []error{nil, freshservicemock.ErrNoResponse},
+		},
+		{
+			name: "func overrides the response",
+			setup: func(m *freshservicemock.TicketsAPI) {
+				m.Return("GetTicket", &freshservice.Ticket{ID: 1}, nil, nil)
+				m.GetTicketFunc = func(ctx context.Context, id int) (*freshservice.Ticket, *freshservice.Response, error) {
+					return &freshservice.Ticket{ID: id * 10}, nil, nil
+				}
+			},
+			want: []int{420, 420},
+			errs: []error{nil, nil},
+		},
+		{
+			name: "reset clears responses",
+			setup: func(m *freshservicemock.TicketsAPI) {
+				m.Return("GetTicket", &freshservice.Ticket{ID: 1}, nil, nil)
+				m.ReturnOnce("GetTicket", &freshservice.Ticket{ID: 2}, nil, nil)
+				m.Reset()
+			},
+			want: []int{0, 0},
+			errs: []error{freshservicemock.ErrNoResponse, freshservicemock.ErrNoResponse},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &freshservicemock.TicketsAPI{}
+			tt.setup(m)
+
+			for i := range tt.want {
+				ticket, _, err := m.GetTicket(ctx, 42)
+				if !errors.Is(err, tt.errs[i]) {
+					t.Fatalf("call %d: expected error %v, got %v", i, tt.errs[i], err)
+				}
+				id := 0
+				if ticket != nil {
+					id = ticket.ID
+				}
+				if id != tt.want[i] {
+					t.Errorf("call %d: expected ticket %d, got %d", i, tt.want[i], id)
+				}
+			}
+		})
+	}
+}
+
+func TestMockNoResponseNamesMethod(t *testing.T) {
+	m := &freshservicemock.TicketsAPI{}
+
+	_, _, err := m.GetTicket(context.Background(), 1)
+	if err == nil || err.Error() != "freshservicemock: no response for GetTicket" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestMockCalls(t *testing.T) {
+	ctx := context.Background()
+	m := &freshservicemock.TicketsAPI{}
+	m.Return("GetTicket", &freshservice.Ticket{ID: 1}, nil, nil)
+
+	m.GetTicket(ctx, 1)
+	m.GetTicket(ctx, 2)
+	m.DeleteTicket(ctx, 3)
+
+	if !m.Called("GetTicket") || !m.Called("DeleteTicket") {
+		t.Errorf("expected GetTicket and DeleteTicket to be called")
+	}
+	if m.Called("UpdateTicket") {
+		t.Errorf("expected UpdateTicket not to be called")
+	}
+
+	calls := m.Calls("GetTicket")
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 GetTicket calls, got %d", len(calls))
+	}
+	for i, id := range []int{1, 2} {
+		if calls[i].Method != "GetTicket" || len(calls[i].Args) != 2 || calls[i].Args[1] != id {
+			t.Errorf("call %d: unexpected %+v", i, calls[i])
+		}
+	}
+	if n := len(m.Calls("")); n != 3 {
+		t.Errorf("expected 3 calls, got %d", n)
+	}
+
+	// calls made through a Func are recorded too
+	m.GetTicketFunc = func(ctx context.Context, id int) (*freshservice.Ticket, *freshservice.Response, error) {
+		return nil, nil, nil
+	}
+	m.GetTicket(ctx, 4)
+	if n := len(m.Calls("GetTicket")); n != 3 {
+		t.Errorf("expected 3 GetTicket calls, got %d", n)
+	}
+
+	m.Reset()
+	if m.Called("GetTicket") || len(m.Calls("")) != 0 {
+		t.Errorf("expected no calls after Reset")
+	}
+}
+
+func TestMockWrongResponsePanics(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []interface{}
+	}{
+		{name: "too few values", values: []interface{}{&freshservice.Ticket{}}},
+		{name: "wrong type", values: []interface{}{&freshservice.Problem{}, nil, nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &freshservicemock.TicketsAPI{}
+			m.Return("GetTicket", tt.values...)
+
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic")
+				}
+			}()
+			m.GetTicket(context.Background(), 1)
+		})
+	}
+}
+
+func TestAPI(t *testing.T) {
+	mock := freshservicemock.New()
+	mock.Tickets.Return("GetTicket", &freshservice.Ticket{ID: 7}, nil, nil)
+
+	var api *freshservice.API = mock.API()
+	ticket, _, err := api.Tickets.GetTicket(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if ticket.ID != 7 {
+		t.Errorf("expected ticket 7, got %d", ticket.ID)
+	}
+	if !mock.Tickets.Called("GetTicket") {
+		t.Errorf("expected the call to be recorded on the mock")
+	}
+
+	// every service is mocked
+	if api.Agents == nil || api.Assets == nil || api.Vendors == nil || api.Problems == nil {
+		t.Errorf("expected every service to be set, got %+v", api)
+	}
+}
@@ -0,0 +1,3991 @@
+// Code generated by apigen; DO NOT EDIT.
+
+package freshservicemock
+
+import (
+	"context"
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"io"
+	"time"
+)
+
+// AgentsAPI is a mock of freshservice.AgentsAPI
+type AgentsAPI struct {
+	Mock
+
+	CreateAgentFunc       func(ctx context.Context, newAgent *freshservice.CreateAgentModel) (*freshservice.Agent, *freshservice.Response, error)
+	DeactivateAgentFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteAgentFunc       func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetAgentFunc          func(ctx context.Context, id int) (*freshservice.Agent, *freshservice.Response, error)
+	GetAgentRoleFunc      func(ctx context.Context, id int) (*freshservice.AgentRole, *freshservice.Response, error)
+	IterAgentRolesFunc    func(ctx context.Context, opt freshservice.ListAgentRolesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.AgentRole) bool) error
+	IterAgentsFunc        func(ctx context.Context, opt *freshservice.ListAgentsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Agent) bool) error
+	ListAgentRolesFunc    func(ctx context.Context, opt freshservice.ListAgentRolesOptions) (*freshservice.AgentRoles, *freshservice.Response, error)
+	ListAgentsFunc        func(ctx context.Context, opt *freshservice.ListAgentsOptions) (*freshservice.Agents, *freshservice.Response, error)
+	ListAllAgentRolesFunc func(ctx context.Context, opt freshservice.ListAgentRolesOptions, limit *freshservice.PaginationOptions) ([]freshservice.AgentRole, error)
+	ListAllAgentsFunc     func(ctx context.Context, opt *freshservice.ListAgentsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Agent, error)
+	ReactivateAgentFunc   func(ctx context.Context, id int) (*freshservice.Agent, *freshservice.Response, error)
+	UpdateAgentFunc       func(ctx context.Context, id int, agent *freshservice.UpdateAgentModel) (*freshservice.Agent, *freshservice.Response, error)
+}
+
+// CreateAgent calls CreateAgentFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) CreateAgent(ctx context.Context, newAgent *freshservice.CreateAgentModel) (*freshservice.Agent, *freshservice.Response, error) {
+	m.record("CreateAgent", ctx, newAgent)
+	if m.CreateAgentFunc != nil {
+		return m.CreateAgentFunc(ctx, newAgent)
+	}
+	var r0 *freshservice.Agent
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateAgent", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeactivateAgent calls DeactivateAgentFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) DeactivateAgent(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeactivateAgent", ctx, id)
+	if m.DeactivateAgentFunc != nil {
+		return m.DeactivateAgentFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeactivateAgent", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteAgent calls DeleteAgentFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) DeleteAgent(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteAgent", ctx, id)
+	if m.DeleteAgentFunc != nil {
+		return m.DeleteAgentFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteAgent", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetAgent calls GetAgentFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) GetAgent(ctx context.Context, id int) (*freshservice.Agent, *freshservice.Response, error) {
+	m.record("GetAgent", ctx, id)
+	if m.GetAgentFunc != nil {
+		return m.GetAgentFunc(ctx, id)
+	}
+	var r0 *freshservice.Agent
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetAgent", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetAgentRole calls GetAgentRoleFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) GetAgentRole(ctx context.Context, id int) (*freshservice.AgentRole, *freshservice.Response, error) {
+	m.record("GetAgentRole", ctx, id)
+	if m.GetAgentRoleFunc != nil {
+		return m.GetAgentRoleFunc(ctx, id)
+	}
+	var r0 *freshservice.AgentRole
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetAgentRole", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterAgentRoles calls IterAgentRolesFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) IterAgentRoles(ctx context.Context, opt freshservice.ListAgentRolesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.AgentRole) bool) error {
+	m.record("IterAgentRoles", ctx, opt, limit, fn)
+	if m.IterAgentRolesFunc != nil {
+		return m.IterAgentRolesFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterAgentRoles", &r0)
+	return r0
+}
+
+// IterAgents calls IterAgentsFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) IterAgents(ctx context.Context, opt *freshservice.ListAgentsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Agent) bool) error {
+	m.record("IterAgents", ctx, opt, limit, fn)
+	if m.IterAgentsFunc != nil {
+		return m.IterAgentsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterAgents", &r0)
+	return r0
+}
+
+// ListAgentRoles calls ListAgentRolesFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) ListAgentRoles(ctx context.Context, opt freshservice.ListAgentRolesOptions) (*freshservice.AgentRoles, *freshservice.Response, error) {
+	m.record("ListAgentRoles", ctx, opt)
+	if m.ListAgentRolesFunc != nil {
+		return m.ListAgentRolesFunc(ctx, opt)
+	}
+	var r0 *freshservice.AgentRoles
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListAgentRoles", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListAgents calls ListAgentsFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) ListAgents(ctx context.Context, opt *freshservice.ListAgentsOptions) (*freshservice.Agents, *freshservice.Response, error) {
+	m.record("ListAgents", ctx, opt)
+	if m.ListAgentsFunc != nil {
+		return m.ListAgentsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Agents
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListAgents", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListAllAgentRoles calls ListAllAgentRolesFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) ListAllAgentRoles(ctx context.Context, opt freshservice.ListAgentRolesOptions, limit *freshservice.PaginationOptions) ([]freshservice.AgentRole, error) {
+	m.record("ListAllAgentRoles", ctx, opt, limit)
+	if m.ListAllAgentRolesFunc != nil {
+		return m.ListAllAgentRolesFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.AgentRole
+	var r1 error
+	m.returns("ListAllAgentRoles", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllAgents calls ListAllAgentsFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) ListAllAgents(ctx context.Context, opt *freshservice.ListAgentsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Agent, error) {
+	m.record("ListAllAgents", ctx, opt, limit)
+	if m.ListAllAgentsFunc != nil {
+		return m.ListAllAgentsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Agent
+	var r1 error
+	m.returns("ListAllAgents", &r0, &r1)
+	return r0, r1
+}
+
+// ReactivateAgent calls ReactivateAgentFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) ReactivateAgent(ctx context.Context, id int) (*freshservice.Agent, *freshservice.Response, error) {
+	m.record("ReactivateAgent", ctx, id)
+	if m.ReactivateAgentFunc != nil {
+		return m.ReactivateAgentFunc(ctx, id)
+	}
+	var r0 *freshservice.Agent
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ReactivateAgent", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateAgent calls UpdateAgentFunc when set, otherwise it returns the canned response
+func (m *AgentsAPI) UpdateAgent(ctx context.Context, id int, agent *freshservice.UpdateAgentModel) (*freshservice.Agent, *freshservice.Response, error) {
+	m.record("UpdateAgent", ctx, id, agent)
+	if m.UpdateAgentFunc != nil {
+		return m.UpdateAgentFunc(ctx, id, agent)
+	}
+	var r0 *freshservice.Agent
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateAgent", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// AnnouncementsAPI is a mock of freshservice.AnnouncementsAPI
+type AnnouncementsAPI struct {
+	Mock
+
+	CreateAnnouncementFunc   func(ctx context.Context, newAnnouncement *freshservice.CreateAnnouncementModel) (*freshservice.Announcement, *freshservice.Response, error)
+	DeleteAnnouncementFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetAnnouncementFunc      func(ctx context.Context, id int) (*freshservice.Announcement, *freshservice.Response, error)
+	IterAnnouncementsFunc    func(ctx context.Context, opt freshservice.ListAnnouncementsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Announcement) bool) error
+	ListAllAnnouncementsFunc func(ctx context.Context, opt freshservice.ListAnnouncementsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Announcement, error)
+	ListAnnouncementsFunc    func(ctx context.Context, opt freshservice.ListAnnouncementsOptions) (*freshservice.Announcements, *freshservice.Response, error)
+	UpdateAnnouncementFunc   func(ctx context.Context, id int, announcement *freshservice.UpdateAnnouncementModel) (*freshservice.Announcement, *freshservice.Response, error)
+}
+
+// CreateAnnouncement calls CreateAnnouncementFunc when set, otherwise it returns the canned response
+func (m *AnnouncementsAPI) CreateAnnouncement(ctx context.Context, newAnnouncement *freshservice.CreateAnnouncementModel) (*freshservice.Announcement, *freshservice.Response, error) {
+	m.record("CreateAnnouncement", ctx, newAnnouncement)
+	if m.CreateAnnouncementFunc != nil {
+		return m.CreateAnnouncementFunc(ctx, newAnnouncement)
+	}
+	var r0 *freshservice.Announcement
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateAnnouncement", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteAnnouncement calls DeleteAnnouncementFunc when set, otherwise it returns the canned response
+func (m *AnnouncementsAPI) DeleteAnnouncement(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteAnnouncement", ctx, id)
+	if m.DeleteAnnouncementFunc != nil {
+		return m.DeleteAnnouncementFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteAnnouncement", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetAnnouncement calls GetAnnouncementFunc when set, otherwise it returns the canned response
+func (m *AnnouncementsAPI) GetAnnouncement(ctx context.Context, id int) (*freshservice.Announcement, *freshservice.Response, error) {
+	m.record("GetAnnouncement", ctx, id)
+	if m.GetAnnouncementFunc != nil {
+		return m.GetAnnouncementFunc(ctx, id)
+	}
+	var r0 *freshservice.Announcement
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetAnnouncement", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterAnnouncements calls IterAnnouncementsFunc when set, otherwise it returns the canned response
+func (m *AnnouncementsAPI) IterAnnouncements(ctx context.Context, opt freshservice.ListAnnouncementsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Announcement) bool) error {
+	m.record("IterAnnouncements", ctx, opt, limit, fn)
+	if m.IterAnnouncementsFunc != nil {
+		return m.IterAnnouncementsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterAnnouncements", &r0)
+	return r0
+}
+
+// ListAllAnnouncements calls ListAllAnnouncementsFunc when set, otherwise it returns the canned response
+func (m *AnnouncementsAPI) ListAllAnnouncements(ctx context.Context, opt freshservice.ListAnnouncementsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Announcement, error) {
+	m.record("ListAllAnnouncements", ctx, opt, limit)
+	if m.ListAllAnnouncementsFunc != nil {
+		return m.ListAllAnnouncementsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Announcement
+	var r1 error
+	m.returns("ListAllAnnouncements", &r0, &r1)
+	return r0, r1
+}
+
+// ListAnnouncements calls ListAnnouncementsFunc when set, otherwise it returns the canned response
+func (m *AnnouncementsAPI) ListAnnouncements(ctx context.Context, opt freshservice.ListAnnouncementsOptions) (*freshservice.Announcements, *freshservice.Response, error) {
+	m.record("ListAnnouncements", ctx, opt)
+	if m.ListAnnouncementsFunc != nil {
+		return m.ListAnnouncementsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Announcements
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListAnnouncements", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateAnnouncement calls UpdateAnnouncementFunc when set, otherwise it returns the canned response
+func (m *AnnouncementsAPI) UpdateAnnouncement(ctx context.Context, id int, announcement *freshservice.UpdateAnnouncementModel) (*freshservice.Announcement, *freshservice.Response, error) {
+	m.record("UpdateAnnouncement", ctx, id, announcement)
+	if m.UpdateAnnouncementFunc != nil {
+		return m.UpdateAnnouncementFunc(ctx, id, announcement)
+	}
+	var r0 *freshservice.Announcement
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateAnnouncement", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// AssetsAPI is a mock of freshservice.AssetsAPI
+type AssetsAPI struct {
+	Mock
+
+	CreateAssetFunc            func(ctx context.Context, newAsset *freshservice.CreateAssetModel) (*freshservice.Asset, *freshservice.Response, error)
+	CreateAssetTypeFunc        func(ctx context.Context, newAssetType freshservice.CreateAssetTypeModel) (*freshservice.AssetType, *freshservice.Response, error)
+	CreateRelationshipsFunc    func(ctx context.Context, relationships []freshservice.CreateRelationshipModel) (*freshservice.RelationshipJob, *freshservice.Response, error)
+	DeleteAssetFunc            func(ctx context.Context, displayId int) (bool, *freshservice.Response, error)
+	DeleteAssetTypeFunc        func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteRelationshipsFunc    func(ctx context.Context, ids ...int) (bool, *freshservice.Response, error)
+	FilterAssetsFunc           func(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions) (*freshservice.Assets, *freshservice.Response, error)
+	GetAssetFunc               func(ctx context.Context, displayId int) (*freshservice.Asset, *freshservice.Response, error)
+	GetAssetTypeFunc           func(ctx context.Context, id int) (*freshservice.AssetType, *freshservice.Response, error)
+	GetAssetWithTypeFieldsFunc func(ctx context.Context, displayId int) (*freshservice.Asset, *freshservice.Response, error)
+	GetRelationshipJobFunc     func(ctx context.Context, jobId string) (*freshservice.RelationshipJob, *freshservice.Response, error)
+	GetRelationshipsFunc       func(ctx context.Context, ids ...int) (*freshservice.Relationships, *freshservice.Response, error)
+	IterAssetTypesFunc         func(ctx context.Context, opt *freshservice.ListAssetTypesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.AssetType) bool) error
+	IterAssetsFunc             func(ctx context.Context, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Asset) bool) error
+	IterFilterAssetsFunc       func(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Asset) bool) error
+	IterSearchAssetsFunc       func(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Asset) bool) error
+	ListAllAssetTypesFunc      func(ctx context.Context, opt *freshservice.ListAssetTypesOptions, limit *freshservice.PaginationOptions) ([]freshservice.AssetType, error)
+	ListAllAssetsFunc          func(ctx context.Context, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Asset, error)
+	ListAllFilterAssetsFunc    func(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Asset, error)
+	ListAllSearchAssetsFunc    func(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Asset, error)
+	ListAssetComponentsFunc    func(ctx context.Context, displayId int) (*freshservice.AssetComponents, *freshservice.Response, error)
+	ListAssetContractsFunc     func(ctx context.Context, displayId int) (*freshservice.AssetContracts, *freshservice.Response, error)
+	ListAssetTypesFunc         func(ctx context.Context, opt *freshservice.ListAssetTypesOptions) (*freshservice.AssetTypes, *freshservice.Response, error)
+	ListAssetsFunc             func(ctx context.Context, opt *freshservice.ListAssetsOptions) (*freshservice.Assets, *freshservice.Response, error)
+	ListRelationshipTypesFunc  func(ctx context.Context) (*freshservice.RelationshipTypes, *freshservice.Response, error)
+	ListRelationshipsFunc      func(ctx context.Context, displayId int) (*freshservice.Relationships, *freshservice.Response, error)
+	RestoreAssetFunc           func(ctx context.Context, displayId int) (bool, *freshservice.Response, error)
+	SearchAssetsFunc           func(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions) (*freshservice.Assets, *freshservice.Response, error)
+	TrashAssetFunc             func(ctx context.Context, displayId int) (bool, *freshservice.Response, error)
+	UpdateAssetFunc            func(ctx context.Context, displayId int, asset *freshservice.UpdateAssetModel) (*freshservice.Asset, *freshservice.Response, error)
+	UpdateAssetTypeFunc        func(ctx context.Context, id int, updatedAssetType freshservice.UpdateAssetTypeModel) (*freshservice.AssetType, *freshservice.Response, error)
+	WaitForRelationshipJobFunc func(ctx context.Context, jobId string, interval time.Duration) (*freshservice.RelationshipJob, error)
+}
+
+// CreateAsset calls CreateAssetFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) CreateAsset(ctx context.Context, newAsset *freshservice.CreateAssetModel) (*freshservice.Asset, *freshservice.Response, error) {
+	m.record("CreateAsset", ctx, newAsset)
+	if m.CreateAssetFunc != nil {
+		return m.CreateAssetFunc(ctx, newAsset)
+	}
+	var r0 *freshservice.Asset
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateAsset", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateAssetType calls CreateAssetTypeFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) CreateAssetType(ctx context.Context, newAssetType freshservice.CreateAssetTypeModel) (*freshservice.AssetType, *freshservice.Response, error) {
+	m.record("CreateAssetType", ctx, newAssetType)
+	if m.CreateAssetTypeFunc != nil {
+		return m.CreateAssetTypeFunc(ctx, newAssetType)
+	}
+	var r0 *freshservice.AssetType
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateAssetType", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateRelationships calls CreateRelationshipsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) CreateRelationships(ctx context.Context, relationships []freshservice.CreateRelationshipModel) (*freshservice.RelationshipJob, *freshservice.Response, error) {
+	m.record("CreateRelationships", ctx, relationships)
+	if m.CreateRelationshipsFunc != nil {
+		return m.CreateRelationshipsFunc(ctx, relationships)
+	}
+	var r0 *freshservice.RelationshipJob
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateRelationships", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteAsset calls DeleteAssetFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) DeleteAsset(ctx context.Context, displayId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteAsset", ctx, displayId)
+	if m.DeleteAssetFunc != nil {
+		return m.DeleteAssetFunc(ctx, displayId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteAsset", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteAssetType calls DeleteAssetTypeFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) DeleteAssetType(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteAssetType", ctx, id)
+	if m.DeleteAssetTypeFunc != nil {
+		return m.DeleteAssetTypeFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteAssetType", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteRelationships calls DeleteRelationshipsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) DeleteRelationships(ctx context.Context, ids ...int) (bool, *freshservice.Response, error) {
+	m.record("DeleteRelationships", ctx, ids)
+	if m.DeleteRelationshipsFunc != nil {
+		return m.DeleteRelationshipsFunc(ctx, ids...)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteRelationships", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// FilterAssets calls FilterAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) FilterAssets(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions) (*freshservice.Assets, *freshservice.Response, error) {
+	m.record("FilterAssets", ctx, q, opt)
+	if m.FilterAssetsFunc != nil {
+		return m.FilterAssetsFunc(ctx, q, opt)
+	}
+	var r0 *freshservice.Assets
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("FilterAssets", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetAsset calls GetAssetFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) GetAsset(ctx context.Context, displayId int) (*freshservice.Asset, *freshservice.Response, error) {
+	m.record("GetAsset", ctx, displayId)
+	if m.GetAssetFunc != nil {
+		return m.GetAssetFunc(ctx, displayId)
+	}
+	var r0 *freshservice.Asset
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetAsset", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetAssetType calls GetAssetTypeFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) GetAssetType(ctx context.Context, id int) (*freshservice.AssetType, *freshservice.Response, error) {
+	m.record("GetAssetType", ctx, id)
+	if m.GetAssetTypeFunc != nil {
+		return m.GetAssetTypeFunc(ctx, id)
+	}
+	var r0 *freshservice.AssetType
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetAssetType", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetAssetWithTypeFields calls GetAssetWithTypeFieldsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) GetAssetWithTypeFields(ctx context.Context, displayId int) (*freshservice.Asset, *freshservice.Response, error) {
+	m.record("GetAssetWithTypeFields", ctx, displayId)
+	if m.GetAssetWithTypeFieldsFunc != nil {
+		return m.GetAssetWithTypeFieldsFunc(ctx, displayId)
+	}
+	var r0 *freshservice.Asset
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetAssetWithTypeFields", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetRelationshipJob calls GetRelationshipJobFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) GetRelationshipJob(ctx context.Context, jobId string) (*freshservice.RelationshipJob, *freshservice.Response, error) {
+	m.record("GetRelationshipJob", ctx, jobId)
+	if m.GetRelationshipJobFunc != nil {
+		return m.GetRelationshipJobFunc(ctx, jobId)
+	}
+	var r0 *freshservice.RelationshipJob
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetRelationshipJob", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetRelationships calls GetRelationshipsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) GetRelationships(ctx context.Context, ids ...int) (*freshservice.Relationships, *freshservice.Response, error) {
+	m.record("GetRelationships", ctx, ids)
+	if m.GetRelationshipsFunc != nil {
+		return m.GetRelationshipsFunc(ctx, ids...)
+	}
+	var r0 *freshservice.Relationships
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetRelationships", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterAssetTypes calls IterAssetTypesFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) IterAssetTypes(ctx context.Context, opt *freshservice.ListAssetTypesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.AssetType) bool) error {
+	m.record("IterAssetTypes", ctx, opt, limit, fn)
+	if m.IterAssetTypesFunc != nil {
+		return m.IterAssetTypesFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterAssetTypes", &r0)
+	return r0
+}
+
+// IterAssets calls IterAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) IterAssets(ctx context.Context, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Asset) bool) error {
+	m.record("IterAssets", ctx, opt, limit, fn)
+	if m.IterAssetsFunc != nil {
+		return m.IterAssetsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterAssets", &r0)
+	return r0
+}
+
+// IterFilterAssets calls IterFilterAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) IterFilterAssets(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Asset) bool) error {
+	m.record("IterFilterAssets", ctx, q, opt, limit, fn)
+	if m.IterFilterAssetsFunc != nil {
+		return m.IterFilterAssetsFunc(ctx, q, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterFilterAssets", &r0)
+	return r0
+}
+
+// IterSearchAssets calls IterSearchAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) IterSearchAssets(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Asset) bool) error {
+	m.record("IterSearchAssets", ctx, q, opt, limit, fn)
+	if m.IterSearchAssetsFunc != nil {
+		return m.IterSearchAssetsFunc(ctx, q, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterSearchAssets", &r0)
+	return r0
+}
+
+// ListAllAssetTypes calls ListAllAssetTypesFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAllAssetTypes(ctx context.Context, opt *freshservice.ListAssetTypesOptions, limit *freshservice.PaginationOptions) ([]freshservice.AssetType, error) {
+	m.record("ListAllAssetTypes", ctx, opt, limit)
+	if m.ListAllAssetTypesFunc != nil {
+		return m.ListAllAssetTypesFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.AssetType
+	var r1 error
+	m.returns("ListAllAssetTypes", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllAssets calls ListAllAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAllAssets(ctx context.Context, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Asset, error) {
+	m.record("ListAllAssets", ctx, opt, limit)
+	if m.ListAllAssetsFunc != nil {
+		return m.ListAllAssetsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Asset
+	var r1 error
+	m.returns("ListAllAssets", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllFilterAssets calls ListAllFilterAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAllFilterAssets(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Asset, error) {
+	m.record("ListAllFilterAssets", ctx, q, opt, limit)
+	if m.ListAllFilterAssetsFunc != nil {
+		return m.ListAllFilterAssetsFunc(ctx, q, opt, limit)
+	}
+	var r0 []freshservice.Asset
+	var r1 error
+	m.returns("ListAllFilterAssets", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllSearchAssets calls ListAllSearchAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAllSearchAssets(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Asset, error) {
+	m.record("ListAllSearchAssets", ctx, q, opt, limit)
+	if m.ListAllSearchAssetsFunc != nil {
+		return m.ListAllSearchAssetsFunc(ctx, q, opt, limit)
+	}
+	var r0 []freshservice.Asset
+	var r1 error
+	m.returns("ListAllSearchAssets", &r0, &r1)
+	return r0, r1
+}
+
+// ListAssetComponents calls ListAssetComponentsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAssetComponents(ctx context.Context, displayId int) (*freshservice.AssetComponents, *freshservice.Response, error) {
+	m.record("ListAssetComponents", ctx, displayId)
+	if m.ListAssetComponentsFunc != nil {
+		return m.ListAssetComponentsFunc(ctx, displayId)
+	}
+	var r0 *freshservice.AssetComponents
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListAssetComponents", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListAssetContracts calls ListAssetContractsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAssetContracts(ctx context.Context, displayId int) (*freshservice.AssetContracts, *freshservice.Response, error) {
+	m.record("ListAssetContracts", ctx, displayId)
+	if m.ListAssetContractsFunc != nil {
+		return m.ListAssetContractsFunc(ctx, displayId)
+	}
+	var r0 *freshservice.AssetContracts
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListAssetContracts", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListAssetTypes calls ListAssetTypesFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAssetTypes(ctx context.Context, opt *freshservice.ListAssetTypesOptions) (*freshservice.AssetTypes, *freshservice.Response, error) {
+	m.record("ListAssetTypes", ctx, opt)
+	if m.ListAssetTypesFunc != nil {
+		return m.ListAssetTypesFunc(ctx, opt)
+	}
+	var r0 *freshservice.AssetTypes
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListAssetTypes", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListAssets calls ListAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListAssets(ctx context.Context, opt *freshservice.ListAssetsOptions) (*freshservice.Assets, *freshservice.Response, error) {
+	m.record("ListAssets", ctx, opt)
+	if m.ListAssetsFunc != nil {
+		return m.ListAssetsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Assets
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListAssets", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListRelationshipTypes calls ListRelationshipTypesFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListRelationshipTypes(ctx context.Context) (*freshservice.RelationshipTypes, *freshservice.Response, error) {
+	m.record("ListRelationshipTypes", ctx)
+	if m.ListRelationshipTypesFunc != nil {
+		return m.ListRelationshipTypesFunc(ctx)
+	}
+	var r0 *freshservice.RelationshipTypes
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListRelationshipTypes", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListRelationships calls ListRelationshipsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) ListRelationships(ctx context.Context, displayId int) (*freshservice.Relationships, *freshservice.Response, error) {
+	m.record("ListRelationships", ctx, displayId)
+	if m.ListRelationshipsFunc != nil {
+		return m.ListRelationshipsFunc(ctx, displayId)
+	}
+	var r0 *freshservice.Relationships
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListRelationships", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RestoreAsset calls RestoreAssetFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) RestoreAsset(ctx context.Context, displayId int) (bool, *freshservice.Response, error) {
+	m.record("RestoreAsset", ctx, displayId)
+	if m.RestoreAssetFunc != nil {
+		return m.RestoreAssetFunc(ctx, displayId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("RestoreAsset", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// SearchAssets calls SearchAssetsFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) SearchAssets(ctx context.Context, q freshservice.Query, opt *freshservice.ListAssetsOptions) (*freshservice.Assets, *freshservice.Response, error) {
+	m.record("SearchAssets", ctx, q, opt)
+	if m.SearchAssetsFunc != nil {
+		return m.SearchAssetsFunc(ctx, q, opt)
+	}
+	var r0 *freshservice.Assets
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("SearchAssets", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// TrashAsset calls TrashAssetFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) TrashAsset(ctx context.Context, displayId int) (bool, *freshservice.Response, error) {
+	m.record("TrashAsset", ctx, displayId)
+	if m.TrashAssetFunc != nil {
+		return m.TrashAssetFunc(ctx, displayId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("TrashAsset", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateAsset calls UpdateAssetFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) UpdateAsset(ctx context.Context, displayId int, asset *freshservice.UpdateAssetModel) (*freshservice.Asset, *freshservice.Response, error) {
+	m.record("UpdateAsset", ctx, displayId, asset)
+	if m.UpdateAssetFunc != nil {
+		return m.UpdateAssetFunc(ctx, displayId, asset)
+	}
+	var r0 *freshservice.Asset
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateAsset", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateAssetType calls UpdateAssetTypeFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) UpdateAssetType(ctx context.Context, id int, updatedAssetType freshservice.UpdateAssetTypeModel) (*freshservice.AssetType, *freshservice.Response, error) {
+	m.record("UpdateAssetType", ctx, id, updatedAssetType)
+	if m.UpdateAssetTypeFunc != nil {
+		return m.UpdateAssetTypeFunc(ctx, id, updatedAssetType)
+	}
+	var r0 *freshservice.AssetType
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateAssetType", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// WaitForRelationshipJob calls WaitForRelationshipJobFunc when set, otherwise it returns the canned response
+func (m *AssetsAPI) WaitForRelationshipJob(ctx context.Context, jobId string, interval time.Duration) (*freshservice.RelationshipJob, error) {
+	m.record("WaitForRelationshipJob", ctx, jobId, interval)
+	if m.WaitForRelationshipJobFunc != nil {
+		return m.WaitForRelationshipJobFunc(ctx, jobId, interval)
+	}
+	var r0 *freshservice.RelationshipJob
+	var r1 error
+	m.returns("WaitForRelationshipJob", &r0, &r1)
+	return r0, r1
+}
+
+// BusinessHoursAPI is a mock of freshservice.BusinessHoursAPI
+type BusinessHoursAPI struct {
+	Mock
+
+	GetBusinessHoursFunc     func(ctx context.Context, id int) (*freshservice.BusinessHour, *freshservice.Response, error)
+	IterBusinessHoursFunc    func(ctx context.Context, opt *freshservice.ListBusinessHoursOptions, limit *freshservice.PaginationOptions, fn func(freshservice.BusinessHour) bool) error
+	ListAllBusinessHoursFunc func(ctx context.Context, opt *freshservice.ListBusinessHoursOptions, limit *freshservice.PaginationOptions) ([]freshservice.BusinessHour, error)
+	ListBusinessHoursFunc    func(ctx context.Context, opt *freshservice.ListBusinessHoursOptions) (*freshservice.BusinessHours, *freshservice.Response, error)
+}
+
+// GetBusinessHours calls GetBusinessHoursFunc when set, otherwise it returns the canned response
+func (m *BusinessHoursAPI) GetBusinessHours(ctx context.Context, id int) (*freshservice.BusinessHour, *freshservice.Response, error) {
+	m.record("GetBusinessHours", ctx, id)
+	if m.GetBusinessHoursFunc != nil {
+		return m.GetBusinessHoursFunc(ctx, id)
+	}
+	var r0 *freshservice.BusinessHour
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetBusinessHours", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterBusinessHours calls IterBusinessHoursFunc when set, otherwise it returns the canned response
+func (m *BusinessHoursAPI) IterBusinessHours(ctx context.Context, opt *freshservice.ListBusinessHoursOptions, limit *freshservice.PaginationOptions, fn func(freshservice.BusinessHour) bool) error {
+	m.record("IterBusinessHours", ctx, opt, limit, fn)
+	if m.IterBusinessHoursFunc != nil {
+		return m.IterBusinessHoursFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterBusinessHours", &r0)
+	return r0
+}
+
+// ListAllBusinessHours calls ListAllBusinessHoursFunc when set, otherwise it returns the canned response
+func (m *BusinessHoursAPI) ListAllBusinessHours(ctx context.Context, opt *freshservice.ListBusinessHoursOptions, limit *freshservice.PaginationOptions) ([]freshservice.BusinessHour, error) {
+	m.record("ListAllBusinessHours", ctx, opt, limit)
+	if m.ListAllBusinessHoursFunc != nil {
+		return m.ListAllBusinessHoursFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.BusinessHour
+	var r1 error
+	m.returns("ListAllBusinessHours", &r0, &r1)
+	return r0, r1
+}
+
+// ListBusinessHours calls ListBusinessHoursFunc when set, otherwise it returns the canned response
+func (m *BusinessHoursAPI) ListBusinessHours(ctx context.Context, opt *freshservice.ListBusinessHoursOptions) (*freshservice.BusinessHours, *freshservice.Response, error) {
+	m.record("ListBusinessHours", ctx, opt)
+	if m.ListBusinessHoursFunc != nil {
+		return m.ListBusinessHoursFunc(ctx, opt)
+	}
+	var r0 *freshservice.BusinessHours
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListBusinessHours", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ChangesAPI is a mock of freshservice.ChangesAPI
+type ChangesAPI struct {
+	Mock
+
+	CreateChangeFunc         func(ctx context.Context, newChange *freshservice.CreateChangeModel) (*freshservice.Change, *freshservice.Response, error)
+	CreateChangeNoteFunc     func(ctx context.Context, changeId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error)
+	DeleteChangeFunc         func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteChangeNoteFunc     func(ctx context.Context, changeId int, changeNoteId int) (bool, *freshservice.Response, error)
+	GetChangeFunc            func(ctx context.Context, id int) (*freshservice.Change, *freshservice.Response, error)
+	GetChangeNoteFunc        func(ctx context.Context, changeId int, changeNoteId int) (*freshservice.Note, *freshservice.Response, error)
+	IterChangesFunc          func(ctx context.Context, opt *freshservice.ListChangesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Change) bool) error
+	ListAllChangesFunc       func(ctx context.Context, opt *freshservice.ListChangesOptions, limit *freshservice.PaginationOptions) ([]freshservice.Change, error)
+	ListChangeFormFieldsFunc func(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error)
+	ListChangeNotesFunc      func(ctx context.Context, changeId int) (*freshservice.Notes, *freshservice.Response, error)
+	ListChangesFunc          func(ctx context.Context, opt *freshservice.ListChangesOptions) (*freshservice.Changes, *freshservice.Response, error)
+	RestoreChangeFunc        func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	UpdateChangeFunc         func(ctx context.Context, id int, ticket *freshservice.UpdateChangeModel) (*freshservice.Change, *freshservice.Response, error)
+	UpdateChangeNoteFunc     func(ctx context.Context, changeId int, changeNoteId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error)
+}
+
+// CreateChange calls CreateChangeFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) CreateChange(ctx context.Context, newChange *freshservice.CreateChangeModel) (*freshservice.Change, *freshservice.Response, error) {
+	m.record("CreateChange", ctx, newChange)
+	if m.CreateChangeFunc != nil {
+		return m.CreateChangeFunc(ctx, newChange)
+	}
+	var r0 *freshservice.Change
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateChange", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateChangeNote calls CreateChangeNoteFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) CreateChangeNote(ctx context.Context, changeId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("CreateChangeNote", ctx, changeId, note)
+	if m.CreateChangeNoteFunc != nil {
+		return m.CreateChangeNoteFunc(ctx, changeId, note)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateChangeNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteChange calls DeleteChangeFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) DeleteChange(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteChange", ctx, id)
+	if m.DeleteChangeFunc != nil {
+		return m.DeleteChangeFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteChange", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteChangeNote calls DeleteChangeNoteFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) DeleteChangeNote(ctx context.Context, changeId int, changeNoteId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteChangeNote", ctx, changeId, changeNoteId)
+	if m.DeleteChangeNoteFunc != nil {
+		return m.DeleteChangeNoteFunc(ctx, changeId, changeNoteId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteChangeNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetChange calls GetChangeFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) GetChange(ctx context.Context, id int) (*freshservice.Change, *freshservice.Response, error) {
+	m.record("GetChange", ctx, id)
+	if m.GetChangeFunc != nil {
+		return m.GetChangeFunc(ctx, id)
+	}
+	var r0 *freshservice.Change
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetChange", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetChangeNote calls GetChangeNoteFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) GetChangeNote(ctx context.Context, changeId int, changeNoteId int) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("GetChangeNote", ctx, changeId, changeNoteId)
+	if m.GetChangeNoteFunc != nil {
+		return m.GetChangeNoteFunc(ctx, changeId, changeNoteId)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetChangeNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterChanges calls IterChangesFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) IterChanges(ctx context.Context, opt *freshservice.ListChangesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Change) bool) error {
+	m.record("IterChanges", ctx, opt, limit, fn)
+	if m.IterChangesFunc != nil {
+		return m.IterChangesFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterChanges", &r0)
+	return r0
+}
+
+// ListAllChanges calls ListAllChangesFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) ListAllChanges(ctx context.Context, opt *freshservice.ListChangesOptions, limit *freshservice.PaginationOptions) ([]freshservice.Change, error) {
+	m.record("ListAllChanges", ctx, opt, limit)
+	if m.ListAllChangesFunc != nil {
+		return m.ListAllChangesFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Change
+	var r1 error
+	m.returns("ListAllChanges", &r0, &r1)
+	return r0, r1
+}
+
+// ListChangeFormFields calls ListChangeFormFieldsFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) ListChangeFormFields(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error) {
+	m.record("ListChangeFormFields", ctx)
+	if m.ListChangeFormFieldsFunc != nil {
+		return m.ListChangeFormFieldsFunc(ctx)
+	}
+	var r0 *freshservice.FormFields
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListChangeFormFields", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListChangeNotes calls ListChangeNotesFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) ListChangeNotes(ctx context.Context, changeId int) (*freshservice.Notes, *freshservice.Response, error) {
+	m.record("ListChangeNotes", ctx, changeId)
+	if m.ListChangeNotesFunc != nil {
+		return m.ListChangeNotesFunc(ctx, changeId)
+	}
+	var r0 *freshservice.Notes
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListChangeNotes", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListChanges calls ListChangesFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) ListChanges(ctx context.Context, opt *freshservice.ListChangesOptions) (*freshservice.Changes, *freshservice.Response, error) {
+	m.record("ListChanges", ctx, opt)
+	if m.ListChangesFunc != nil {
+		return m.ListChangesFunc(ctx, opt)
+	}
+	var r0 *freshservice.Changes
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListChanges", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RestoreChange calls RestoreChangeFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) RestoreChange(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("RestoreChange", ctx, id)
+	if m.RestoreChangeFunc != nil {
+		return m.RestoreChangeFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("RestoreChange", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateChange calls UpdateChangeFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) UpdateChange(ctx context.Context, id int, ticket *freshservice.UpdateChangeModel) (*freshservice.Change, *freshservice.Response, error) {
+	m.record("UpdateChange", ctx, id, ticket)
+	if m.UpdateChangeFunc != nil {
+		return m.UpdateChangeFunc(ctx, id, ticket)
+	}
+	var r0 *freshservice.Change
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateChange", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateChangeNote calls UpdateChangeNoteFunc when set, otherwise it returns the canned response
+func (m *ChangesAPI) UpdateChangeNote(ctx context.Context, changeId int, changeNoteId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("UpdateChangeNote", ctx, changeId, changeNoteId, note)
+	if m.UpdateChangeNoteFunc != nil {
+		return m.UpdateChangeNoteFunc(ctx, changeId, changeNoteId, note)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateChangeNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ContractsAPI is a mock of freshservice.ContractsAPI
+type ContractsAPI struct {
+	Mock
+
+	ApproveContractFunc              func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	CreateContractFunc               func(ctx context.Context, contract *freshservice.CreateContractModel) (*freshservice.Contract, *freshservice.Response, error)
+	GetContractFunc                  func(ctx context.Context, id int) (*freshservice.Contract, *freshservice.Response, error)
+	IterContractsFunc                func(ctx context.Context, opt *freshservice.ListContractsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Contract) bool) error
+	ListAllContractsFunc             func(ctx context.Context, opt *freshservice.ListContractsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Contract, error)
+	ListContractAssociatedAssetsFunc func(ctx context.Context, id int) (*freshservice.AssociatedAssets, *freshservice.Response, error)
+	ListContractTypesFunc            func(ctx context.Context) (*freshservice.ContractTypes, *freshservice.Response, error)
+	ListContractsFunc                func(ctx context.Context, opt *freshservice.ListContractsOptions) (*freshservice.Contracts, *freshservice.Response, error)
+	RejectContractFunc               func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	SubmitContractApprovalFunc       func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	UpdateContractFunc               func(ctx context.Context, id int, contract *freshservice.UpdateContractModel) (*freshservice.Contract, *freshservice.Response, error)
+}
+
+// ApproveContract calls ApproveContractFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) ApproveContract(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("ApproveContract", ctx, id)
+	if m.ApproveContractFunc != nil {
+		return m.ApproveContractFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ApproveContract", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateContract calls CreateContractFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) CreateContract(ctx context.Context, contract *freshservice.CreateContractModel) (*freshservice.Contract, *freshservice.Response, error) {
+	m.record("CreateContract", ctx, contract)
+	if m.CreateContractFunc != nil {
+		return m.CreateContractFunc(ctx, contract)
+	}
+	var r0 *freshservice.Contract
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateContract", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetContract calls GetContractFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) GetContract(ctx context.Context, id int) (*freshservice.Contract, *freshservice.Response, error) {
+	m.record("GetContract", ctx, id)
+	if m.GetContractFunc != nil {
+		return m.GetContractFunc(ctx, id)
+	}
+	var r0 *freshservice.Contract
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetContract", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterContracts calls IterContractsFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) IterContracts(ctx context.Context, opt *freshservice.ListContractsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Contract) bool) error {
+	m.record("IterContracts", ctx, opt, limit, fn)
+	if m.IterContractsFunc != nil {
+		return m.IterContractsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterContracts", &r0)
+	return r0
+}
+
+// ListAllContracts calls ListAllContractsFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) ListAllContracts(ctx context.Context, opt *freshservice.ListContractsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Contract, error) {
+	m.record("ListAllContracts", ctx, opt, limit)
+	if m.ListAllContractsFunc != nil {
+		return m.ListAllContractsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Contract
+	var r1 error
+	m.returns("ListAllContracts", &r0, &r1)
+	return r0, r1
+}
+
+// ListContractAssociatedAssets calls ListContractAssociatedAssetsFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) ListContractAssociatedAssets(ctx context.Context, id int) (*freshservice.AssociatedAssets, *freshservice.Response, error) {
+	m.record("ListContractAssociatedAssets", ctx, id)
+	if m.ListContractAssociatedAssetsFunc != nil {
+		return m.ListContractAssociatedAssetsFunc(ctx, id)
+	}
+	var r0 *freshservice.AssociatedAssets
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListContractAssociatedAssets", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListContractTypes calls ListContractTypesFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) ListContractTypes(ctx context.Context) (*freshservice.ContractTypes, *freshservice.Response, error) {
+	m.record("ListContractTypes", ctx)
+	if m.ListContractTypesFunc != nil {
+		return m.ListContractTypesFunc(ctx)
+	}
+	var r0 *freshservice.ContractTypes
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListContractTypes", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListContracts calls ListContractsFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) ListContracts(ctx context.Context, opt *freshservice.ListContractsOptions) (*freshservice.Contracts, *freshservice.Response, error) {
+	m.record("ListContracts", ctx, opt)
+	if m.ListContractsFunc != nil {
+		return m.ListContractsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Contracts
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListContracts", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RejectContract calls RejectContractFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) RejectContract(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("RejectContract", ctx, id)
+	if m.RejectContractFunc != nil {
+		return m.RejectContractFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("RejectContract", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// SubmitContractApproval calls SubmitContractApprovalFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) SubmitContractApproval(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("SubmitContractApproval", ctx, id)
+	if m.SubmitContractApprovalFunc != nil {
+		return m.SubmitContractApprovalFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("SubmitContractApproval", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateContract calls UpdateContractFunc when set, otherwise it returns the canned response
+func (m *ContractsAPI) UpdateContract(ctx context.Context, id int, contract *freshservice.UpdateContractModel) (*freshservice.Contract, *freshservice.Response, error) {
+	m.record("UpdateContract", ctx, id, contract)
+	if m.UpdateContractFunc != nil {
+		return m.UpdateContractFunc(ctx, id, contract)
+	}
+	var r0 *freshservice.Contract
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateContract", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DepartmentsAPI is a mock of freshservice.DepartmentsAPI
+type DepartmentsAPI struct {
+	Mock
+
+	CreateDepartmentFunc   func(ctx context.Context, newDepartment *freshservice.CreateDepartmentModel) (*freshservice.Department, *freshservice.Response, error)
+	DeleteDepartmentFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetDepartmentFunc      func(ctx context.Context, id int) (*freshservice.Department, *freshservice.Response, error)
+	IterDepartmentsFunc    func(ctx context.Context, opt *freshservice.ListDepartmentsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Department) bool) error
+	ListAllDepartmentsFunc func(ctx context.Context, opt *freshservice.ListDepartmentsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Department, error)
+	ListDepartmentsFunc    func(ctx context.Context, opt *freshservice.ListDepartmentsOptions) (*freshservice.Departments, *freshservice.Response, error)
+	UpdateDepartmentFunc   func(ctx context.Context, id int, department *freshservice.UpdateDepartmentModel) (*freshservice.Department, *freshservice.Response, error)
+}
+
+// CreateDepartment calls CreateDepartmentFunc when set, otherwise it returns the canned response
+func (m *DepartmentsAPI) CreateDepartment(ctx context.Context, newDepartment *freshservice.CreateDepartmentModel) (*freshservice.Department, *freshservice.Response, error) {
+	m.record("CreateDepartment", ctx, newDepartment)
+	if m.CreateDepartmentFunc != nil {
+		return m.CreateDepartmentFunc(ctx, newDepartment)
+	}
+	var r0 *freshservice.Department
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateDepartment", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteDepartment calls DeleteDepartmentFunc when set, otherwise it returns the canned response
+func (m *DepartmentsAPI) DeleteDepartment(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteDepartment", ctx, id)
+	if m.DeleteDepartmentFunc != nil {
+		return m.DeleteDepartmentFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteDepartment", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetDepartment calls GetDepartmentFunc when set, otherwise it returns the canned response
+func (m *DepartmentsAPI) GetDepartment(ctx context.Context, id int) (*freshservice.Department, *freshservice.Response, error) {
+	m.record("GetDepartment", ctx, id)
+	if m.GetDepartmentFunc != nil {
+		return m.GetDepartmentFunc(ctx, id)
+	}
+	var r0 *freshservice.Department
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetDepartment", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterDepartments calls IterDepartmentsFunc when set, otherwise it returns the canned response
+func (m *DepartmentsAPI) IterDepartments(ctx context.Context, opt *freshservice.ListDepartmentsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Department) bool) error {
+	m.record("IterDepartments", ctx, opt, limit, fn)
+	if m.IterDepartmentsFunc != nil {
+		return m.IterDepartmentsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterDepartments", &r0)
+	return r0
+}
+
+// ListAllDepartments calls ListAllDepartmentsFunc when set, otherwise it returns the canned response
+func (m *DepartmentsAPI) ListAllDepartments(ctx context.Context, opt *freshservice.ListDepartmentsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Department, error) {
+	m.record("ListAllDepartments", ctx, opt, limit)
+	if m.ListAllDepartmentsFunc != nil {
+		return m.ListAllDepartmentsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Department
+	var r1 error
+	m.returns("ListAllDepartments", &r0, &r1)
+	return r0, r1
+}
+
+// ListDepartments calls ListDepartmentsFunc when set, otherwise it returns the canned response
+func (m *DepartmentsAPI) ListDepartments(ctx context.Context, opt *freshservice.ListDepartmentsOptions) (*freshservice.Departments, *freshservice.Response, error) {
+	m.record("ListDepartments", ctx, opt)
+	if m.ListDepartmentsFunc != nil {
+		return m.ListDepartmentsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Departments
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListDepartments", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateDepartment calls UpdateDepartmentFunc when set, otherwise it returns the canned response
+func (m *DepartmentsAPI) UpdateDepartment(ctx context.Context, id int, department *freshservice.UpdateDepartmentModel) (*freshservice.Department, *freshservice.Response, error) {
+	m.record("UpdateDepartment", ctx, id, department)
+	if m.UpdateDepartmentFunc != nil {
+		return m.UpdateDepartmentFunc(ctx, id, department)
+	}
+	var r0 *freshservice.Department
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateDepartment", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GroupsAPI is a mock of freshservice.GroupsAPI
+type GroupsAPI struct {
+	Mock
+
+	CreateGroupFunc   func(ctx context.Context, newGroup *freshservice.CreateGroupModel) (*freshservice.Group, *freshservice.Response, error)
+	DeleteGroupFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetGroupFunc      func(ctx context.Context, id int) (*freshservice.Group, *freshservice.Response, error)
+	IterGroupsFunc    func(ctx context.Context, opt *freshservice.ListGroupsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Group) bool) error
+	ListAllGroupsFunc func(ctx context.Context, opt *freshservice.ListGroupsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Group, error)
+	ListGroupsFunc    func(ctx context.Context, opt *freshservice.ListGroupsOptions) (*freshservice.Groups, *freshservice.Response, error)
+	UpdateGroupFunc   func(ctx context.Context, id int, group *freshservice.UpdateGroupModel) (*freshservice.Group, *freshservice.Response, error)
+}
+
+// CreateGroup calls CreateGroupFunc when set, otherwise it returns the canned response
+func (m *GroupsAPI) CreateGroup(ctx context.Context, newGroup *freshservice.CreateGroupModel) (*freshservice.Group, *freshservice.Response, error) {
+	m.record("CreateGroup", ctx, newGroup)
+	if m.CreateGroupFunc != nil {
+		return m.CreateGroupFunc(ctx, newGroup)
+	}
+	var r0 *freshservice.Group
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteGroup calls DeleteGroupFunc when set, otherwise it returns the canned response
+func (m *GroupsAPI) DeleteGroup(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteGroup", ctx, id)
+	if m.DeleteGroupFunc != nil {
+		return m.DeleteGroupFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetGroup calls GetGroupFunc when set, otherwise it returns the canned response
+func (m *GroupsAPI) GetGroup(ctx context.Context, id int) (*freshservice.Group, *freshservice.Response, error) {
+	m.record("GetGroup", ctx, id)
+	if m.GetGroupFunc != nil {
+		return m.GetGroupFunc(ctx, id)
+	}
+	var r0 *freshservice.Group
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterGroups calls IterGroupsFunc when set, otherwise it returns the canned response
+func (m *GroupsAPI) IterGroups(ctx context.Context, opt *freshservice.ListGroupsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Group) bool) error {
+	m.record("IterGroups", ctx, opt, limit, fn)
+	if m.IterGroupsFunc != nil {
+		return m.IterGroupsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterGroups", &r0)
+	return r0
+}
+
+// ListAllGroups calls ListAllGroupsFunc when set, otherwise it returns the canned response
+func (m *GroupsAPI) ListAllGroups(ctx context.Context, opt *freshservice.ListGroupsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Group, error) {
+	m.record("ListAllGroups", ctx, opt, limit)
+	if m.ListAllGroupsFunc != nil {
+		return m.ListAllGroupsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Group
+	var r1 error
+	m.returns("ListAllGroups", &r0, &r1)
+	return r0, r1
+}
+
+// ListGroups calls ListGroupsFunc when set, otherwise it returns the canned response
+func (m *GroupsAPI) ListGroups(ctx context.Context, opt *freshservice.ListGroupsOptions) (*freshservice.Groups, *freshservice.Response, error) {
+	m.record("ListGroups", ctx, opt)
+	if m.ListGroupsFunc != nil {
+		return m.ListGroupsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Groups
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListGroups", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateGroup calls UpdateGroupFunc when set, otherwise it returns the canned response
+func (m *GroupsAPI) UpdateGroup(ctx context.Context, id int, group *freshservice.UpdateGroupModel) (*freshservice.Group, *freshservice.Response, error) {
+	m.record("UpdateGroup", ctx, id, group)
+	if m.UpdateGroupFunc != nil {
+		return m.UpdateGroupFunc(ctx, id, group)
+	}
+	var r0 *freshservice.Group
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// LocationsAPI is a mock of freshservice.LocationsAPI
+type LocationsAPI struct {
+	Mock
+
+	CreateLocationFunc   func(ctx context.Context, newLocation *freshservice.CreateLocationModel) (*freshservice.Location, *freshservice.Response, error)
+	DeleteLocationFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetLocationFunc      func(ctx context.Context, id int) (*freshservice.Location, *freshservice.Response, error)
+	IterLocationsFunc    func(ctx context.Context, opt *freshservice.ListLocationsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Location) bool) error
+	ListAllLocationsFunc func(ctx context.Context, opt *freshservice.ListLocationsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Location, error)
+	ListLocationsFunc    func(ctx context.Context, opt *freshservice.ListLocationsOptions) (*freshservice.Locations, *freshservice.Response, error)
+	UpdateLocationFunc   func(ctx context.Context, id int, location *freshservice.UpdateLocationModel) (*freshservice.Location, *freshservice.Response, error)
+}
+
+// CreateLocation calls CreateLocationFunc when set, otherwise it returns the canned response
+func (m *LocationsAPI) CreateLocation(ctx context.Context, newLocation *freshservice.CreateLocationModel) (*freshservice.Location, *freshservice.Response, error) {
+	m.record("CreateLocation", ctx, newLocation)
+	if m.CreateLocationFunc != nil {
+		return m.CreateLocationFunc(ctx, newLocation)
+	}
+	var r0 *freshservice.Location
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateLocation", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteLocation calls DeleteLocationFunc when set, otherwise it returns the canned response
+func (m *LocationsAPI) DeleteLocation(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteLocation", ctx, id)
+	if m.DeleteLocationFunc != nil {
+		return m.DeleteLocationFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteLocation", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetLocation calls GetLocationFunc when set, otherwise it returns the canned response
+func (m *LocationsAPI) GetLocation(ctx context.Context, id int) (*freshservice.Location, *freshservice.Response, error) {
+	m.record("GetLocation", ctx, id)
+	if m.GetLocationFunc != nil {
+		return m.GetLocationFunc(ctx, id)
+	}
+	var r0 *freshservice.Location
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetLocation", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterLocations calls IterLocationsFunc when set, otherwise it returns the canned response
+func (m *LocationsAPI) IterLocations(ctx context.Context, opt *freshservice.ListLocationsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Location) bool) error {
+	m.record("IterLocations", ctx, opt, limit, fn)
+	if m.IterLocationsFunc != nil {
+		return m.IterLocationsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterLocations", &r0)
+	return r0
+}
+
+// ListAllLocations calls ListAllLocationsFunc when set, otherwise it returns the canned response
+func (m *LocationsAPI) ListAllLocations(ctx context.Context, opt *freshservice.ListLocationsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Location, error) {
+	m.record("ListAllLocations", ctx, opt, limit)
+	if m.ListAllLocationsFunc != nil {
+		return m.ListAllLocationsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Location
+	var r1 error
+	m.returns("ListAllLocations", &r0, &r1)
+	return r0, r1
+}
+
+// ListLocations calls ListLocationsFunc when set, otherwise it returns the canned response
+func (m *LocationsAPI) ListLocations(ctx context.Context, opt *freshservice.ListLocationsOptions) (*freshservice.Locations, *freshservice.Response, error) {
+	m.record("ListLocations", ctx, opt)
+	if m.ListLocationsFunc != nil {
+		return m.ListLocationsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Locations
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListLocations", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateLocation calls UpdateLocationFunc when set, otherwise it returns the canned response
+func (m *LocationsAPI) UpdateLocation(ctx context.Context, id int, location *freshservice.UpdateLocationModel) (*freshservice.Location, *freshservice.Response, error) {
+	m.record("UpdateLocation", ctx, id, location)
+	if m.UpdateLocationFunc != nil {
+		return m.UpdateLocationFunc(ctx, id, location)
+	}
+	var r0 *freshservice.Location
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateLocation", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ProblemsAPI is a mock of freshservice.ProblemsAPI
+type ProblemsAPI struct {
+	Mock
+
+	CreateProblemFunc         func(ctx context.Context, problem *freshservice.CreateProblemModel) (*freshservice.Problem, *freshservice.Response, error)
+	CreateProblemNoteFunc     func(ctx context.Context, problemId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error)
+	CreateTaskFunc            func(ctx context.Context, problemId int, newTask *freshservice.CreateTaskModel) (*freshservice.Task, *freshservice.Response, error)
+	CreateTimeEntryFunc       func(ctx context.Context, problemId int, timeEntry *freshservice.CreateTimeEntryModel) (*freshservice.TimeEntry, *freshservice.Response, error)
+	DeleteProblemFunc         func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteProblemNoteFunc     func(ctx context.Context, problemId int, problemNoteId int) (bool, *freshservice.Response, error)
+	DeleteTaskFunc            func(ctx context.Context, problemId int, taskId int) (bool, *freshservice.Response, error)
+	DeleteTimeEntryFunc       func(ctx context.Context, problemId int, timeEntryId int) (bool, *freshservice.Response, error)
+	GetProblemFunc            func(ctx context.Context, id int) (*freshservice.Problem, *freshservice.Response, error)
+	GetProblemNoteFunc        func(ctx context.Context, problemId int, problemNoteId int) (*freshservice.Note, *freshservice.Response, error)
+	GetTaskFunc               func(ctx context.Context, problemId int, taskId int) (*freshservice.Task, *freshservice.Response, error)
+	GetTimeEntryFunc          func(ctx context.Context, problemId int, timeEntryId int) (*freshservice.TimeEntry, *freshservice.Response, error)
+	IterProblemTasksFunc      func(ctx context.Context, problemId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Task) bool) error
+	IterProblemsFunc          func(ctx context.Context, opt *freshservice.ListProblemsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Problem) bool) error
+	ListAllProblemTasksFunc   func(ctx context.Context, problemId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions) ([]freshservice.Task, error)
+	ListAllProblemsFunc       func(ctx context.Context, opt *freshservice.ListProblemsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Problem, error)
+	ListProblemFormFieldsFunc func(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error)
+	ListProblemNotesFunc      func(ctx context.Context, problemId int) (*freshservice.Notes, *freshservice.Response, error)
+	ListProblemsFunc          func(ctx context.Context, opt *freshservice.ListProblemsOptions) (*freshservice.Problems, *freshservice.Response, error)
+	ListTasksFunc             func(ctx context.Context, problemId int, opt *freshservice.ListTasksOptions) (*freshservice.Tasks, *freshservice.Response, error)
+	ListTimeEntriesFunc       func(ctx context.Context, problemId int) (*freshservice.TimeEntries, *freshservice.Response, error)
+	RestoreProblemFunc        func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	UpdateProblemFunc         func(ctx context.Context, id int, problem *freshservice.UpdateProblemModel) (*freshservice.Problem, *freshservice.Response, error)
+	UpdateProblemNoteFunc     func(ctx context.Context, problemId int, problemNoteId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error)
+	UpdateTaskFunc            func(ctx context.Context, problemId int, taskId int, task *freshservice.UpdateTaskModel) (*freshservice.Task, *freshservice.Response, error)
+}
+
+// CreateProblem calls CreateProblemFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) CreateProblem(ctx context.Context, problem *freshservice.CreateProblemModel) (*freshservice.Problem, *freshservice.Response, error) {
+	m.record("CreateProblem", ctx, problem)
+	if m.CreateProblemFunc != nil {
+		return m.CreateProblemFunc(ctx, problem)
+	}
+	var r0 *freshservice.Problem
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateProblem", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateProblemNote calls CreateProblemNoteFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) CreateProblemNote(ctx context.Context, problemId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("CreateProblemNote", ctx, problemId, note)
+	if m.CreateProblemNoteFunc != nil {
+		return m.CreateProblemNoteFunc(ctx, problemId, note)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateProblemNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTask calls CreateTaskFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) CreateTask(ctx context.Context, problemId int, newTask *freshservice.CreateTaskModel) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("CreateTask", ctx, problemId, newTask)
+	if m.CreateTaskFunc != nil {
+		return m.CreateTaskFunc(ctx, problemId, newTask)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTimeEntry calls CreateTimeEntryFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) CreateTimeEntry(ctx context.Context, problemId int, timeEntry *freshservice.CreateTimeEntryModel) (*freshservice.TimeEntry, *freshservice.Response, error) {
+	m.record("CreateTimeEntry", ctx, problemId, timeEntry)
+	if m.CreateTimeEntryFunc != nil {
+		return m.CreateTimeEntryFunc(ctx, problemId, timeEntry)
+	}
+	var r0 *freshservice.TimeEntry
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteProblem calls DeleteProblemFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) DeleteProblem(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteProblem", ctx, id)
+	if m.DeleteProblemFunc != nil {
+		return m.DeleteProblemFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteProblem", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteProblemNote calls DeleteProblemNoteFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) DeleteProblemNote(ctx context.Context, problemId int, problemNoteId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteProblemNote", ctx, problemId, problemNoteId)
+	if m.DeleteProblemNoteFunc != nil {
+		return m.DeleteProblemNoteFunc(ctx, problemId, problemNoteId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteProblemNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteTask calls DeleteTaskFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) DeleteTask(ctx context.Context, problemId int, taskId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteTask", ctx, problemId, taskId)
+	if m.DeleteTaskFunc != nil {
+		return m.DeleteTaskFunc(ctx, problemId, taskId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteTimeEntry calls DeleteTimeEntryFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) DeleteTimeEntry(ctx context.Context, problemId int, timeEntryId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteTimeEntry", ctx, problemId, timeEntryId)
+	if m.DeleteTimeEntryFunc != nil {
+		return m.DeleteTimeEntryFunc(ctx, problemId, timeEntryId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetProblem calls GetProblemFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) GetProblem(ctx context.Context, id int) (*freshservice.Problem, *freshservice.Response, error) {
+	m.record("GetProblem", ctx, id)
+	if m.GetProblemFunc != nil {
+		return m.GetProblemFunc(ctx, id)
+	}
+	var r0 *freshservice.Problem
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetProblem", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetProblemNote calls GetProblemNoteFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) GetProblemNote(ctx context.Context, problemId int, problemNoteId int) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("GetProblemNote", ctx, problemId, problemNoteId)
+	if m.GetProblemNoteFunc != nil {
+		return m.GetProblemNoteFunc(ctx, problemId, problemNoteId)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetProblemNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetTask calls GetTaskFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) GetTask(ctx context.Context, problemId int, taskId int) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("GetTask", ctx, problemId, taskId)
+	if m.GetTaskFunc != nil {
+		return m.GetTaskFunc(ctx, problemId, taskId)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetTimeEntry calls GetTimeEntryFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) GetTimeEntry(ctx context.Context, problemId int, timeEntryId int) (*freshservice.TimeEntry, *freshservice.Response, error) {
+	m.record("GetTimeEntry", ctx, problemId, timeEntryId)
+	if m.GetTimeEntryFunc != nil {
+		return m.GetTimeEntryFunc(ctx, problemId, timeEntryId)
+	}
+	var r0 *freshservice.TimeEntry
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterProblemTasks calls IterProblemTasksFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) IterProblemTasks(ctx context.Context, problemId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Task) bool) error {
+	m.record("IterProblemTasks", ctx, problemId, opt, limit, fn)
+	if m.IterProblemTasksFunc != nil {
+		return m.IterProblemTasksFunc(ctx, problemId, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterProblemTasks", &r0)
+	return r0
+}
+
+// IterProblems calls IterProblemsFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) IterProblems(ctx context.Context, opt *freshservice.ListProblemsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Problem) bool) error {
+	m.record("IterProblems", ctx, opt, limit, fn)
+	if m.IterProblemsFunc != nil {
+		return m.IterProblemsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterProblems", &r0)
+	return r0
+}
+
+// ListAllProblemTasks calls ListAllProblemTasksFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) ListAllProblemTasks(ctx context.Context, problemId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions) ([]freshservice.Task, error) {
+	m.record("ListAllProblemTasks", ctx, problemId, opt, limit)
+	if m.ListAllProblemTasksFunc != nil {
+		return m.ListAllProblemTasksFunc(ctx, problemId, opt, limit)
+	}
+	var r0 []freshservice.Task
+	var r1 error
+	m.returns("ListAllProblemTasks", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllProblems calls ListAllProblemsFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) ListAllProblems(ctx context.Context, opt *freshservice.ListProblemsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Problem, error) {
+	m.record("ListAllProblems", ctx, opt, limit)
+	if m.ListAllProblemsFunc != nil {
+		return m.ListAllProblemsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Problem
+	var r1 error
+	m.returns("ListAllProblems", &r0, &r1)
+	return r0, r1
+}
+
+// ListProblemFormFields calls ListProblemFormFieldsFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) ListProblemFormFields(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error) {
+	m.record("ListProblemFormFields", ctx)
+	if m.ListProblemFormFieldsFunc != nil {
+		return m.ListProblemFormFieldsFunc(ctx)
+	}
+	var r0 *freshservice.FormFields
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListProblemFormFields", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListProblemNotes calls ListProblemNotesFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) ListProblemNotes(ctx context.Context, problemId int) (*freshservice.Notes, *freshservice.Response, error) {
+	m.record("ListProblemNotes", ctx, problemId)
+	if m.ListProblemNotesFunc != nil {
+		return m.ListProblemNotesFunc(ctx, problemId)
+	}
+	var r0 *freshservice.Notes
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListProblemNotes", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListProblems calls ListProblemsFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) ListProblems(ctx context.Context, opt *freshservice.ListProblemsOptions) (*freshservice.Problems, *freshservice.Response, error) {
+	m.record("ListProblems", ctx, opt)
+	if m.ListProblemsFunc != nil {
+		return m.ListProblemsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Problems
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListProblems", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTasks calls ListTasksFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) ListTasks(ctx context.Context, problemId int, opt *freshservice.ListTasksOptions) (*freshservice.Tasks, *freshservice.Response, error) {
+	m.record("ListTasks", ctx, problemId, opt)
+	if m.ListTasksFunc != nil {
+		return m.ListTasksFunc(ctx, problemId, opt)
+	}
+	var r0 *freshservice.Tasks
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTasks", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTimeEntries calls ListTimeEntriesFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) ListTimeEntries(ctx context.Context, problemId int) (*freshservice.TimeEntries, *freshservice.Response, error) {
+	m.record("ListTimeEntries", ctx, problemId)
+	if m.ListTimeEntriesFunc != nil {
+		return m.ListTimeEntriesFunc(ctx, problemId)
+	}
+	var r0 *freshservice.TimeEntries
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTimeEntries", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RestoreProblem calls RestoreProblemFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) RestoreProblem(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("RestoreProblem", ctx, id)
+	if m.RestoreProblemFunc != nil {
+		return m.RestoreProblemFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("RestoreProblem", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateProblem calls UpdateProblemFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) UpdateProblem(ctx context.Context, id int, problem *freshservice.UpdateProblemModel) (*freshservice.Problem, *freshservice.Response, error) {
+	m.record("UpdateProblem", ctx, id, problem)
+	if m.UpdateProblemFunc != nil {
+		return m.UpdateProblemFunc(ctx, id, problem)
+	}
+	var r0 *freshservice.Problem
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateProblem", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateProblemNote calls UpdateProblemNoteFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) UpdateProblemNote(ctx context.Context, problemId int, problemNoteId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("UpdateProblemNote", ctx, problemId, problemNoteId, note)
+	if m.UpdateProblemNoteFunc != nil {
+		return m.UpdateProblemNoteFunc(ctx, problemId, problemNoteId, note)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateProblemNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateTask calls UpdateTaskFunc when set, otherwise it returns the canned response
+func (m *ProblemsAPI) UpdateTask(ctx context.Context, problemId int, taskId int, task *freshservice.UpdateTaskModel) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("UpdateTask", ctx, problemId, taskId, task)
+	if m.UpdateTaskFunc != nil {
+		return m.UpdateTaskFunc(ctx, problemId, taskId, task)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ProductsAPI is a mock of freshservice.ProductsAPI
+type ProductsAPI struct {
+	Mock
+
+	CreateProductFunc   func(ctx context.Context, newProduct *freshservice.CreateProductModel) (*freshservice.Product, *freshservice.Response, error)
+	DeleteProductFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetProductFunc      func(ctx context.Context, id int) (*freshservice.Product, *freshservice.Response, error)
+	IterProductsFunc    func(ctx context.Context, opt *freshservice.ListProductsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Product) bool) error
+	ListAllProductsFunc func(ctx context.Context, opt *freshservice.ListProductsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Product, error)
+	ListProductsFunc    func(ctx context.Context, opt *freshservice.ListProductsOptions) (*freshservice.Products, *freshservice.Response, error)
+	UpdateProductFunc   func(ctx context.Context, id int, product *freshservice.UpdateProductModel) (*freshservice.Product, *freshservice.Response, error)
+}
+
+// CreateProduct calls CreateProductFunc when set, otherwise it returns the canned response
+func (m *ProductsAPI) CreateProduct(ctx context.Context, newProduct *freshservice.CreateProductModel) (*freshservice.Product, *freshservice.Response, error) {
+	m.record("CreateProduct", ctx, newProduct)
+	if m.CreateProductFunc != nil {
+		return m.CreateProductFunc(ctx, newProduct)
+	}
+	var r0 *freshservice.Product
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateProduct", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteProduct calls DeleteProductFunc when set, otherwise it returns the canned response
+func (m *ProductsAPI) DeleteProduct(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteProduct", ctx, id)
+	if m.DeleteProductFunc != nil {
+		return m.DeleteProductFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteProduct", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetProduct calls GetProductFunc when set, otherwise it returns the canned response
+func (m *ProductsAPI) GetProduct(ctx context.Context, id int) (*freshservice.Product, *freshservice.Response, error) {
+	m.record("GetProduct", ctx, id)
+	if m.GetProductFunc != nil {
+		return m.GetProductFunc(ctx, id)
+	}
+	var r0 *freshservice.Product
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetProduct", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterProducts calls IterProductsFunc when set, otherwise it returns the canned response
+func (m *ProductsAPI) IterProducts(ctx context.Context, opt *freshservice.ListProductsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Product) bool) error {
+	m.record("IterProducts", ctx, opt, limit, fn)
+	if m.IterProductsFunc != nil {
+		return m.IterProductsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterProducts", &r0)
+	return r0
+}
+
+// ListAllProducts calls ListAllProductsFunc when set, otherwise it returns the canned response
+func (m *ProductsAPI) ListAllProducts(ctx context.Context, opt *freshservice.ListProductsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Product, error) {
+	m.record("ListAllProducts", ctx, opt, limit)
+	if m.ListAllProductsFunc != nil {
+		return m.ListAllProductsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Product
+	var r1 error
+	m.returns("ListAllProducts", &r0, &r1)
+	return r0, r1
+}
+
+// ListProducts calls ListProductsFunc when set, otherwise it returns the canned response
+func (m *ProductsAPI) ListProducts(ctx context.Context, opt *freshservice.ListProductsOptions) (*freshservice.Products, *freshservice.Response, error) {
+	m.record("ListProducts", ctx, opt)
+	if m.ListProductsFunc != nil {
+		return m.ListProductsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Products
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListProducts", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateProduct calls UpdateProductFunc when set, otherwise it returns the canned response
+func (m *ProductsAPI) UpdateProduct(ctx context.Context, id int, product *freshservice.UpdateProductModel) (*freshservice.Product, *freshservice.Response, error) {
+	m.record("UpdateProduct", ctx, id, product)
+	if m.UpdateProductFunc != nil {
+		return m.UpdateProductFunc(ctx, id, product)
+	}
+	var r0 *freshservice.Product
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateProduct", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// PurchaseOrdersAPI is a mock of freshservice.PurchaseOrdersAPI
+type PurchaseOrdersAPI struct {
+	Mock
+
+	CreatePurchaseOrderFunc   func(ctx context.Context, newPurchaseOrder *freshservice.CreatePurchaseOrderModel) (*freshservice.PurchaseOrder, *freshservice.Response, error)
+	DeletePurchaseOrderFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetPurchaseOrderFunc      func(ctx context.Context, id int) (*freshservice.PurchaseOrder, *freshservice.Response, error)
+	IterPurchaseOrdersFunc    func(ctx context.Context, opt *freshservice.ListPurchaseOrdersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.PurchaseOrder) bool) error
+	ListAllPurchaseOrdersFunc func(ctx context.Context, opt *freshservice.ListPurchaseOrdersOptions, limit *freshservice.PaginationOptions) ([]freshservice.PurchaseOrder, error)
+	ListPurchaseOrdersFunc    func(ctx context.Context, opt *freshservice.ListPurchaseOrdersOptions) (*freshservice.PurchaseOrders, *freshservice.Response, error)
+	UpdatePurchaseOrderFunc   func(ctx context.Context, id int, purchaseOrder *freshservice.UpdatePurchaseOrderModel) (*freshservice.PurchaseOrder, *freshservice.Response, error)
+}
+
+// CreatePurchaseOrder calls CreatePurchaseOrderFunc when set, otherwise it returns the canned response
+func (m *PurchaseOrdersAPI) CreatePurchaseOrder(ctx context.Context, newPurchaseOrder *freshservice.CreatePurchaseOrderModel) (*freshservice.PurchaseOrder, *freshservice.Response, error) {
+	m.record("CreatePurchaseOrder", ctx, newPurchaseOrder)
+	if m.CreatePurchaseOrderFunc != nil {
+		return m.CreatePurchaseOrderFunc(ctx, newPurchaseOrder)
+	}
+	var r0 *freshservice.PurchaseOrder
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreatePurchaseOrder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeletePurchaseOrder calls DeletePurchaseOrderFunc when set, otherwise it returns the canned response
+func (m *PurchaseOrdersAPI) DeletePurchaseOrder(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeletePurchaseOrder", ctx, id)
+	if m.DeletePurchaseOrderFunc != nil {
+		return m.DeletePurchaseOrderFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeletePurchaseOrder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetPurchaseOrder calls GetPurchaseOrderFunc when set, otherwise it returns the canned response
+func (m *PurchaseOrdersAPI) GetPurchaseOrder(ctx context.Context, id int) (*freshservice.PurchaseOrder, *freshservice.Response, error) {
+	m.record("GetPurchaseOrder", ctx, id)
+	if m.GetPurchaseOrderFunc != nil {
+		return m.GetPurchaseOrderFunc(ctx, id)
+	}
+	var r0 *freshservice.PurchaseOrder
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetPurchaseOrder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterPurchaseOrders calls IterPurchaseOrdersFunc when set, otherwise it returns the canned response
+func (m *PurchaseOrdersAPI) IterPurchaseOrders(ctx context.Context, opt *freshservice.ListPurchaseOrdersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.PurchaseOrder) bool) error {
+	m.record("IterPurchaseOrders", ctx, opt, limit, fn)
+	if m.IterPurchaseOrdersFunc != nil {
+		return m.IterPurchaseOrdersFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterPurchaseOrders", &r0)
+	return r0
+}
+
+// ListAllPurchaseOrders calls ListAllPurchaseOrdersFunc when set, otherwise it returns the canned response
+func (m *PurchaseOrdersAPI) ListAllPurchaseOrders(ctx context.Context, opt *freshservice.ListPurchaseOrdersOptions, limit *freshservice.PaginationOptions) ([]freshservice.PurchaseOrder, error) {
+	m.record("ListAllPurchaseOrders", ctx, opt, limit)
+	if m.ListAllPurchaseOrdersFunc != nil {
+		return m.ListAllPurchaseOrdersFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.PurchaseOrder
+	var r1 error
+	m.returns("ListAllPurchaseOrders", &r0, &r1)
+	return r0, r1
+}
+
+// ListPurchaseOrders calls ListPurchaseOrdersFunc when set, otherwise it returns the canned response
+func (m *PurchaseOrdersAPI) ListPurchaseOrders(ctx context.Context, opt *freshservice.ListPurchaseOrdersOptions) (*freshservice.PurchaseOrders, *freshservice.Response, error) {
+	m.record("ListPurchaseOrders", ctx, opt)
+	if m.ListPurchaseOrdersFunc != nil {
+		return m.ListPurchaseOrdersFunc(ctx, opt)
+	}
+	var r0 *freshservice.PurchaseOrders
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListPurchaseOrders", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdatePurchaseOrder calls UpdatePurchaseOrderFunc when set, otherwise it returns the canned response
+func (m *PurchaseOrdersAPI) UpdatePurchaseOrder(ctx context.Context, id int, purchaseOrder *freshservice.UpdatePurchaseOrderModel) (*freshservice.PurchaseOrder, *freshservice.Response, error) {
+	m.record("UpdatePurchaseOrder", ctx, id, purchaseOrder)
+	if m.UpdatePurchaseOrderFunc != nil {
+		return m.UpdatePurchaseOrderFunc(ctx, id, purchaseOrder)
+	}
+	var r0 *freshservice.PurchaseOrder
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdatePurchaseOrder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ReleasesAPI is a mock of freshservice.ReleasesAPI
+type ReleasesAPI struct {
+	Mock
+
+	CreateReleaseFunc         func(ctx context.Context, newRelease *freshservice.CreateReleaseModel) (*freshservice.Release, *freshservice.Response, error)
+	CreateReleaseNoteFunc     func(ctx context.Context, releaseId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error)
+	CreateTaskFunc            func(ctx context.Context, releaseId int, newTask *freshservice.CreateTaskModel) (*freshservice.Task, *freshservice.Response, error)
+	CreateTimeEntryFunc       func(ctx context.Context, releaseId int, timeEntry *freshservice.CreateTimeEntryModel) (*freshservice.TimeEntry, *freshservice.Response, error)
+	DeleteReleaseFunc         func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteReleaseNoteFunc     func(ctx context.Context, releaseId int, releaseNoteId int) (bool, *freshservice.Response, error)
+	DeleteTaskFunc            func(ctx context.Context, releaseId int, taskId int) (bool, *freshservice.Response, error)
+	DeleteTimeEntryFunc       func(ctx context.Context, releaseId int, timeEntryId int) (bool, *freshservice.Response, error)
+	GetReleaseFunc            func(ctx context.Context, id int) (*freshservice.Release, *freshservice.Response, error)
+	GetReleaseNoteFunc        func(ctx context.Context, releaseId int, releaseNoteId int) (*freshservice.Note, *freshservice.Response, error)
+	GetTaskFunc               func(ctx context.Context, releaseId int, taskId int) (*freshservice.Task, *freshservice.Response, error)
+	GetTimeEntryFunc          func(ctx context.Context, releaseId int, timeEntryId int) (*freshservice.TimeEntry, *freshservice.Response, error)
+	IterReleaseTasksFunc      func(ctx context.Context, releaseId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Task) bool) error
+	IterReleasesFunc          func(ctx context.Context, opt *freshservice.ListReleasesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Release) bool) error
+	ListAllReleaseTasksFunc   func(ctx context.Context, releaseId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions) ([]freshservice.Task, error)
+	ListAllReleasesFunc       func(ctx context.Context, opt *freshservice.ListReleasesOptions, limit *freshservice.PaginationOptions) ([]freshservice.Release, error)
+	ListReleaseFormFieldsFunc func(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error)
+	ListReleaseNotesFunc      func(ctx context.Context, releaseId int) (*freshservice.Notes, *freshservice.Response, error)
+	ListReleasesFunc          func(ctx context.Context, opt *freshservice.ListReleasesOptions) (*freshservice.Releases, *freshservice.Response, error)
+	ListTasksFunc             func(ctx context.Context, releaseId int, opt *freshservice.ListTasksOptions) (*freshservice.Tasks, *freshservice.Response, error)
+	ListTimeEntriesFunc       func(ctx context.Context, releaseId int) (*freshservice.TimeEntries, *freshservice.Response, error)
+	RestoreReleaseFunc        func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	UpdateReleaseFunc         func(ctx context.Context, id int, ticket *freshservice.UpdateReleaseModel) (*freshservice.Release, *freshservice.Response, error)
+	UpdateReleaseNoteFunc     func(ctx context.Context, releaseId int, releaseNoteId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error)
+	UpdateTaskFunc            func(ctx context.Context, releaseId int, taskId int, task *freshservice.UpdateTaskModel) (*freshservice.Task, *freshservice.Response, error)
+}
+
+// CreateRelease calls CreateReleaseFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) CreateRelease(ctx context.Context, newRelease *freshservice.CreateReleaseModel) (*freshservice.Release, *freshservice.Response, error) {
+	m.record("CreateRelease", ctx, newRelease)
+	if m.CreateReleaseFunc != nil {
+		return m.CreateReleaseFunc(ctx, newRelease)
+	}
+	var r0 *freshservice.Release
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateRelease", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateReleaseNote calls CreateReleaseNoteFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) CreateReleaseNote(ctx context.Context, releaseId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("CreateReleaseNote", ctx, releaseId, note)
+	if m.CreateReleaseNoteFunc != nil {
+		return m.CreateReleaseNoteFunc(ctx, releaseId, note)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateReleaseNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTask calls CreateTaskFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) CreateTask(ctx context.Context, releaseId int, newTask *freshservice.CreateTaskModel) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("CreateTask", ctx, releaseId, newTask)
+	if m.CreateTaskFunc != nil {
+		return m.CreateTaskFunc(ctx, releaseId, newTask)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTimeEntry calls CreateTimeEntryFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) CreateTimeEntry(ctx context.Context, releaseId int, timeEntry *freshservice.CreateTimeEntryModel) (*freshservice.TimeEntry, *freshservice.Response, error) {
+	m.record("CreateTimeEntry", ctx, releaseId, timeEntry)
+	if m.CreateTimeEntryFunc != nil {
+		return m.CreateTimeEntryFunc(ctx, releaseId, timeEntry)
+	}
+	var r0 *freshservice.TimeEntry
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteRelease calls DeleteReleaseFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) DeleteRelease(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteRelease", ctx, id)
+	if m.DeleteReleaseFunc != nil {
+		return m.DeleteReleaseFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteRelease", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteReleaseNote calls DeleteReleaseNoteFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) DeleteReleaseNote(ctx context.Context, releaseId int, releaseNoteId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteReleaseNote", ctx, releaseId, releaseNoteId)
+	if m.DeleteReleaseNoteFunc != nil {
+		return m.DeleteReleaseNoteFunc(ctx, releaseId, releaseNoteId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteReleaseNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteTask calls DeleteTaskFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) DeleteTask(ctx context.Context, releaseId int, taskId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteTask", ctx, releaseId, taskId)
+	if m.DeleteTaskFunc != nil {
+		return m.DeleteTaskFunc(ctx, releaseId, taskId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteTimeEntry calls DeleteTimeEntryFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) DeleteTimeEntry(ctx context.Context, releaseId int, timeEntryId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteTimeEntry", ctx, releaseId, timeEntryId)
+	if m.DeleteTimeEntryFunc != nil {
+		return m.DeleteTimeEntryFunc(ctx, releaseId, timeEntryId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetRelease calls GetReleaseFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) GetRelease(ctx context.Context, id int) (*freshservice.Release, *freshservice.Response, error) {
+	m.record("GetRelease", ctx, id)
+	if m.GetReleaseFunc != nil {
+		return m.GetReleaseFunc(ctx, id)
+	}
+	var r0 *freshservice.Release
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetRelease", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetReleaseNote calls GetReleaseNoteFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) GetReleaseNote(ctx context.Context, releaseId int, releaseNoteId int) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("GetReleaseNote", ctx, releaseId, releaseNoteId)
+	if m.GetReleaseNoteFunc != nil {
+		return m.GetReleaseNoteFunc(ctx, releaseId, releaseNoteId)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetReleaseNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetTask calls GetTaskFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) GetTask(ctx context.Context, releaseId int, taskId int) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("GetTask", ctx, releaseId, taskId)
+	if m.GetTaskFunc != nil {
+		return m.GetTaskFunc(ctx, releaseId, taskId)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetTimeEntry calls GetTimeEntryFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) GetTimeEntry(ctx context.Context, releaseId int, timeEntryId int) (*freshservice.TimeEntry, *freshservice.Response, error) {
+	m.record("GetTimeEntry", ctx, releaseId, timeEntryId)
+	if m.GetTimeEntryFunc != nil {
+		return m.GetTimeEntryFunc(ctx, releaseId, timeEntryId)
+	}
+	var r0 *freshservice.TimeEntry
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterReleaseTasks calls IterReleaseTasksFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) IterReleaseTasks(ctx context.Context, releaseId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Task) bool) error {
+	m.record("IterReleaseTasks", ctx, releaseId, opt, limit, fn)
+	if m.IterReleaseTasksFunc != nil {
+		return m.IterReleaseTasksFunc(ctx, releaseId, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterReleaseTasks", &r0)
+	return r0
+}
+
+// IterReleases calls IterReleasesFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) IterReleases(ctx context.Context, opt *freshservice.ListReleasesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Release) bool) error {
+	m.record("IterReleases", ctx, opt, limit, fn)
+	if m.IterReleasesFunc != nil {
+		return m.IterReleasesFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterReleases", &r0)
+	return r0
+}
+
+// ListAllReleaseTasks calls ListAllReleaseTasksFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) ListAllReleaseTasks(ctx context.Context, releaseId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions) ([]freshservice.Task, error) {
+	m.record("ListAllReleaseTasks", ctx, releaseId, opt, limit)
+	if m.ListAllReleaseTasksFunc != nil {
+		return m.ListAllReleaseTasksFunc(ctx, releaseId, opt, limit)
+	}
+	var r0 []freshservice.Task
+	var r1 error
+	m.returns("ListAllReleaseTasks", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllReleases calls ListAllReleasesFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) ListAllReleases(ctx context.Context, opt *freshservice.ListReleasesOptions, limit *freshservice.PaginationOptions) ([]freshservice.Release, error) {
+	m.record("ListAllReleases", ctx, opt, limit)
+	if m.ListAllReleasesFunc != nil {
+		return m.ListAllReleasesFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Release
+	var r1 error
+	m.returns("ListAllReleases", &r0, &r1)
+	return r0, r1
+}
+
+// ListReleaseFormFields calls ListReleaseFormFieldsFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) ListReleaseFormFields(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error) {
+	m.record("ListReleaseFormFields", ctx)
+	if m.ListReleaseFormFieldsFunc != nil {
+		return m.ListReleaseFormFieldsFunc(ctx)
+	}
+	var r0 *freshservice.FormFields
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListReleaseFormFields", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListReleaseNotes calls ListReleaseNotesFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) ListReleaseNotes(ctx context.Context, releaseId int) (*freshservice.Notes, *freshservice.Response, error) {
+	m.record("ListReleaseNotes", ctx, releaseId)
+	if m.ListReleaseNotesFunc != nil {
+		return m.ListReleaseNotesFunc(ctx, releaseId)
+	}
+	var r0 *freshservice.Notes
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListReleaseNotes", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListReleases calls ListReleasesFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) ListReleases(ctx context.Context, opt *freshservice.ListReleasesOptions) (*freshservice.Releases, *freshservice.Response, error) {
+	m.record("ListReleases", ctx, opt)
+	if m.ListReleasesFunc != nil {
+		return m.ListReleasesFunc(ctx, opt)
+	}
+	var r0 *freshservice.Releases
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListReleases", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTasks calls ListTasksFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) ListTasks(ctx context.Context, releaseId int, opt *freshservice.ListTasksOptions) (*freshservice.Tasks, *freshservice.Response, error) {
+	m.record("ListTasks", ctx, releaseId, opt)
+	if m.ListTasksFunc != nil {
+		return m.ListTasksFunc(ctx, releaseId, opt)
+	}
+	var r0 *freshservice.Tasks
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTasks", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTimeEntries calls ListTimeEntriesFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) ListTimeEntries(ctx context.Context, releaseId int) (*freshservice.TimeEntries, *freshservice.Response, error) {
+	m.record("ListTimeEntries", ctx, releaseId)
+	if m.ListTimeEntriesFunc != nil {
+		return m.ListTimeEntriesFunc(ctx, releaseId)
+	}
+	var r0 *freshservice.TimeEntries
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTimeEntries", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RestoreRelease calls RestoreReleaseFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) RestoreRelease(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("RestoreRelease", ctx, id)
+	if m.RestoreReleaseFunc != nil {
+		return m.RestoreReleaseFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("RestoreRelease", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateRelease calls UpdateReleaseFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) UpdateRelease(ctx context.Context, id int, ticket *freshservice.UpdateReleaseModel) (*freshservice.Release, *freshservice.Response, error) {
+	m.record("UpdateRelease", ctx, id, ticket)
+	if m.UpdateReleaseFunc != nil {
+		return m.UpdateReleaseFunc(ctx, id, ticket)
+	}
+	var r0 *freshservice.Release
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateRelease", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateReleaseNote calls UpdateReleaseNoteFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) UpdateReleaseNote(ctx context.Context, releaseId int, releaseNoteId int, note *freshservice.UpsertNoteModel) (*freshservice.Note, *freshservice.Response, error) {
+	m.record("UpdateReleaseNote", ctx, releaseId, releaseNoteId, note)
+	if m.UpdateReleaseNoteFunc != nil {
+		return m.UpdateReleaseNoteFunc(ctx, releaseId, releaseNoteId, note)
+	}
+	var r0 *freshservice.Note
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateReleaseNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateTask calls UpdateTaskFunc when set, otherwise it returns the canned response
+func (m *ReleasesAPI) UpdateTask(ctx context.Context, releaseId int, taskId int, task *freshservice.UpdateTaskModel) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("UpdateTask", ctx, releaseId, taskId, task)
+	if m.UpdateTaskFunc != nil {
+		return m.UpdateTaskFunc(ctx, releaseId, taskId, task)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RequestersAPI is a mock of freshservice.RequestersAPI
+type RequestersAPI struct {
+	Mock
+
+	CreateRequesterFunc     func(ctx context.Context, newRequester *freshservice.CreateRequesterModel) (*freshservice.Requester, *freshservice.Response, error)
+	DeactivateRequesterFunc func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteRequesterFunc     func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetRequesterFunc        func(ctx context.Context, id int) (*freshservice.Requester, *freshservice.Response, error)
+	IterRequestersFunc      func(ctx context.Context, opt *freshservice.ListRequestersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Requester) bool) error
+	ListAllRequestersFunc   func(ctx context.Context, opt *freshservice.ListRequestersOptions, limit *freshservice.PaginationOptions) ([]freshservice.Requester, error)
+	ListRequesterFieldsFunc func(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error)
+	ListRequestersFunc      func(ctx context.Context, opt *freshservice.ListRequestersOptions) (*freshservice.Requesters, *freshservice.Response, error)
+	ReactivateRequesterFunc func(ctx context.Context, id int) (*freshservice.Requester, *freshservice.Response, error)
+	UpdateRequesterFunc     func(ctx context.Context, id int, requester *freshservice.UpdateRequesterModel) (*freshservice.Requester, *freshservice.Response, error)
+}
+
+// CreateRequester calls CreateRequesterFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) CreateRequester(ctx context.Context, newRequester *freshservice.CreateRequesterModel) (*freshservice.Requester, *freshservice.Response, error) {
+	m.record("CreateRequester", ctx, newRequester)
+	if m.CreateRequesterFunc != nil {
+		return m.CreateRequesterFunc(ctx, newRequester)
+	}
+	var r0 *freshservice.Requester
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateRequester", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeactivateRequester calls DeactivateRequesterFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) DeactivateRequester(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeactivateRequester", ctx, id)
+	if m.DeactivateRequesterFunc != nil {
+		return m.DeactivateRequesterFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeactivateRequester", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteRequester calls DeleteRequesterFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) DeleteRequester(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteRequester", ctx, id)
+	if m.DeleteRequesterFunc != nil {
+		return m.DeleteRequesterFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteRequester", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetRequester calls GetRequesterFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) GetRequester(ctx context.Context, id int) (*freshservice.Requester, *freshservice.Response, error) {
+	m.record("GetRequester", ctx, id)
+	if m.GetRequesterFunc != nil {
+		return m.GetRequesterFunc(ctx, id)
+	}
+	var r0 *freshservice.Requester
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetRequester", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterRequesters calls IterRequestersFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) IterRequesters(ctx context.Context, opt *freshservice.ListRequestersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Requester) bool) error {
+	m.record("IterRequesters", ctx, opt, limit, fn)
+	if m.IterRequestersFunc != nil {
+		return m.IterRequestersFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterRequesters", &r0)
+	return r0
+}
+
+// ListAllRequesters calls ListAllRequestersFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) ListAllRequesters(ctx context.Context, opt *freshservice.ListRequestersOptions, limit *freshservice.PaginationOptions) ([]freshservice.Requester, error) {
+	m.record("ListAllRequesters", ctx, opt, limit)
+	if m.ListAllRequestersFunc != nil {
+		return m.ListAllRequestersFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Requester
+	var r1 error
+	m.returns("ListAllRequesters", &r0, &r1)
+	return r0, r1
+}
+
+// ListRequesterFields calls ListRequesterFieldsFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) ListRequesterFields(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error) {
+	m.record("ListRequesterFields", ctx)
+	if m.ListRequesterFieldsFunc != nil {
+		return m.ListRequesterFieldsFunc(ctx)
+	}
+	var r0 *freshservice.FormFields
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListRequesterFields", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListRequesters calls ListRequestersFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) ListRequesters(ctx context.Context, opt *freshservice.ListRequestersOptions) (*freshservice.Requesters, *freshservice.Response, error) {
+	m.record("ListRequesters", ctx, opt)
+	if m.ListRequestersFunc != nil {
+		return m.ListRequestersFunc(ctx, opt)
+	}
+	var r0 *freshservice.Requesters
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListRequesters", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ReactivateRequester calls ReactivateRequesterFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) ReactivateRequester(ctx context.Context, id int) (*freshservice.Requester, *freshservice.Response, error) {
+	m.record("ReactivateRequester", ctx, id)
+	if m.ReactivateRequesterFunc != nil {
+		return m.ReactivateRequesterFunc(ctx, id)
+	}
+	var r0 *freshservice.Requester
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ReactivateRequester", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateRequester calls UpdateRequesterFunc when set, otherwise it returns the canned response
+func (m *RequestersAPI) UpdateRequester(ctx context.Context, id int, requester *freshservice.UpdateRequesterModel) (*freshservice.Requester, *freshservice.Response, error) {
+	m.record("UpdateRequester", ctx, id, requester)
+	if m.UpdateRequesterFunc != nil {
+		return m.UpdateRequesterFunc(ctx, id, requester)
+	}
+	var r0 *freshservice.Requester
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateRequester", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RequesterGroupsAPI is a mock of freshservice.RequesterGroupsAPI
+type RequesterGroupsAPI struct {
+	Mock
+
+	AddRequesterGroupMemberFunc      func(ctx context.Context, id int, requesterId int) (bool, *freshservice.Response, error)
+	CreateRequesterGroupFunc         func(ctx context.Context, newGroup *freshservice.CreateRequesterGroupModel) (*freshservice.RequesterGroup, *freshservice.Response, error)
+	DeleteRequesterGroupFunc         func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetRequesterGroupFunc            func(ctx context.Context, id int) (*freshservice.RequesterGroup, *freshservice.Response, error)
+	IterRequesterGroupMembersFunc    func(ctx context.Context, id int, opt *freshservice.ListRequesterGroupMembersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Requester) bool) error
+	IterRequesterGroupsFunc          func(ctx context.Context, opt *freshservice.ListRequesterGroupsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.RequesterGroup) bool) error
+	ListAllRequesterGroupMembersFunc func(ctx context.Context, id int, opt *freshservice.ListRequesterGroupMembersOptions, limit *freshservice.PaginationOptions) ([]freshservice.Requester, error)
+	ListAllRequesterGroupsFunc       func(ctx context.Context, opt *freshservice.ListRequesterGroupsOptions, limit *freshservice.PaginationOptions) ([]freshservice.RequesterGroup, error)
+	ListRequesterGroupMembersFunc    func(ctx context.Context, id int, opt *freshservice.ListRequesterGroupMembersOptions) (*freshservice.Requesters, *freshservice.Response, error)
+	ListRequesterGroupsFunc          func(ctx context.Context, opt *freshservice.ListRequesterGroupsOptions) (*freshservice.RequesterGroups, *freshservice.Response, error)
+	RemoveRequesterGroupMemberFunc   func(ctx context.Context, id int, requesterId int) (bool, *freshservice.Response, error)
+	UpdateRequesterGroupFunc         func(ctx context.Context, id int, group *freshservice.UpdateRequesterGroupModel) (*freshservice.RequesterGroup, *freshservice.Response, error)
+}
+
+// AddRequesterGroupMember calls AddRequesterGroupMemberFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) AddRequesterGroupMember(ctx context.Context, id int, requesterId int) (bool, *freshservice.Response, error) {
+	m.record("AddRequesterGroupMember", ctx, id, requesterId)
+	if m.AddRequesterGroupMemberFunc != nil {
+		return m.AddRequesterGroupMemberFunc(ctx, id, requesterId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("AddRequesterGroupMember", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateRequesterGroup calls CreateRequesterGroupFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) CreateRequesterGroup(ctx context.Context, newGroup *freshservice.CreateRequesterGroupModel) (*freshservice.RequesterGroup, *freshservice.Response, error) {
+	m.record("CreateRequesterGroup", ctx, newGroup)
+	if m.CreateRequesterGroupFunc != nil {
+		return m.CreateRequesterGroupFunc(ctx, newGroup)
+	}
+	var r0 *freshservice.RequesterGroup
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateRequesterGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteRequesterGroup calls DeleteRequesterGroupFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) DeleteRequesterGroup(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteRequesterGroup", ctx, id)
+	if m.DeleteRequesterGroupFunc != nil {
+		return m.DeleteRequesterGroupFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteRequesterGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetRequesterGroup calls GetRequesterGroupFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) GetRequesterGroup(ctx context.Context, id int) (*freshservice.RequesterGroup, *freshservice.Response, error) {
+	m.record("GetRequesterGroup", ctx, id)
+	if m.GetRequesterGroupFunc != nil {
+		return m.GetRequesterGroupFunc(ctx, id)
+	}
+	var r0 *freshservice.RequesterGroup
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetRequesterGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterRequesterGroupMembers calls IterRequesterGroupMembersFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) IterRequesterGroupMembers(ctx context.Context, id int, opt *freshservice.ListRequesterGroupMembersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Requester) bool) error {
+	m.record("IterRequesterGroupMembers", ctx, id, opt, limit, fn)
+	if m.IterRequesterGroupMembersFunc != nil {
+		return m.IterRequesterGroupMembersFunc(ctx, id, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterRequesterGroupMembers", &r0)
+	return r0
+}
+
+// IterRequesterGroups calls IterRequesterGroupsFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) IterRequesterGroups(ctx context.Context, opt *freshservice.ListRequesterGroupsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.RequesterGroup) bool) error {
+	m.record("IterRequesterGroups", ctx, opt, limit, fn)
+	if m.IterRequesterGroupsFunc != nil {
+		return m.IterRequesterGroupsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterRequesterGroups", &r0)
+	return r0
+}
+
+// ListAllRequesterGroupMembers calls ListAllRequesterGroupMembersFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) ListAllRequesterGroupMembers(ctx context.Context, id int, opt *freshservice.ListRequesterGroupMembersOptions, limit *freshservice.PaginationOptions) ([]freshservice.Requester, error) {
+	m.record("ListAllRequesterGroupMembers", ctx, id, opt, limit)
+	if m.ListAllRequesterGroupMembersFunc != nil {
+		return m.ListAllRequesterGroupMembersFunc(ctx, id, opt, limit)
+	}
+	var r0 []freshservice.Requester
+	var r1 error
+	m.returns("ListAllRequesterGroupMembers", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllRequesterGroups calls ListAllRequesterGroupsFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) ListAllRequesterGroups(ctx context.Context, opt *freshservice.ListRequesterGroupsOptions, limit *freshservice.PaginationOptions) ([]freshservice.RequesterGroup, error) {
+	m.record("ListAllRequesterGroups", ctx, opt, limit)
+	if m.ListAllRequesterGroupsFunc != nil {
+		return m.ListAllRequesterGroupsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.RequesterGroup
+	var r1 error
+	m.returns("ListAllRequesterGroups", &r0, &r1)
+	return r0, r1
+}
+
+// ListRequesterGroupMembers calls ListRequesterGroupMembersFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) ListRequesterGroupMembers(ctx context.Context, id int, opt *freshservice.ListRequesterGroupMembersOptions) (*freshservice.Requesters, *freshservice.Response, error) {
+	m.record("ListRequesterGroupMembers", ctx, id, opt)
+	if m.ListRequesterGroupMembersFunc != nil {
+		return m.ListRequesterGroupMembersFunc(ctx, id, opt)
+	}
+	var r0 *freshservice.Requesters
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListRequesterGroupMembers", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListRequesterGroups calls ListRequesterGroupsFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) ListRequesterGroups(ctx context.Context, opt *freshservice.ListRequesterGroupsOptions) (*freshservice.RequesterGroups, *freshservice.Response, error) {
+	m.record("ListRequesterGroups", ctx, opt)
+	if m.ListRequesterGroupsFunc != nil {
+		return m.ListRequesterGroupsFunc(ctx, opt)
+	}
+	var r0 *freshservice.RequesterGroups
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListRequesterGroups", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RemoveRequesterGroupMember calls RemoveRequesterGroupMemberFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) RemoveRequesterGroupMember(ctx context.Context, id int, requesterId int) (bool, *freshservice.Response, error) {
+	m.record("RemoveRequesterGroupMember", ctx, id, requesterId)
+	if m.RemoveRequesterGroupMemberFunc != nil {
+		return m.RemoveRequesterGroupMemberFunc(ctx, id, requesterId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("RemoveRequesterGroupMember", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateRequesterGroup calls UpdateRequesterGroupFunc when set, otherwise it returns the canned response
+func (m *RequesterGroupsAPI) UpdateRequesterGroup(ctx context.Context, id int, group *freshservice.UpdateRequesterGroupModel) (*freshservice.RequesterGroup, *freshservice.Response, error) {
+	m.record("UpdateRequesterGroup", ctx, id, group)
+	if m.UpdateRequesterGroupFunc != nil {
+		return m.UpdateRequesterGroupFunc(ctx, id, group)
+	}
+	var r0 *freshservice.RequesterGroup
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateRequesterGroup", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ServicesAPI is a mock of freshservice.ServicesAPI
+type ServicesAPI struct {
+	Mock
+
+	GetServiceItemFunc     func(ctx context.Context, displayId int) (*freshservice.ServiceItem, *freshservice.Response, error)
+	ListServiceItemsFunc   func(ctx context.Context) (*freshservice.ServiceItems, *freshservice.Response, error)
+	SearchServiceItemsFunc func(ctx context.Context, search *freshservice.ServiceItemSearch) (*freshservice.ServiceItems, *freshservice.Response, error)
+}
+
+// GetServiceItem calls GetServiceItemFunc when set, otherwise it returns the canned response
+func (m *ServicesAPI) GetServiceItem(ctx context.Context, displayId int) (*freshservice.ServiceItem, *freshservice.Response, error) {
+	m.record("GetServiceItem", ctx, displayId)
+	if m.GetServiceItemFunc != nil {
+		return m.GetServiceItemFunc(ctx, displayId)
+	}
+	var r0 *freshservice.ServiceItem
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetServiceItem", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListServiceItems calls ListServiceItemsFunc when set, otherwise it returns the canned response
+func (m *ServicesAPI) ListServiceItems(ctx context.Context) (*freshservice.ServiceItems, *freshservice.Response, error) {
+	m.record("ListServiceItems", ctx)
+	if m.ListServiceItemsFunc != nil {
+		return m.ListServiceItemsFunc(ctx)
+	}
+	var r0 *freshservice.ServiceItems
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListServiceItems", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// SearchServiceItems calls SearchServiceItemsFunc when set, otherwise it returns the canned response
+func (m *ServicesAPI) SearchServiceItems(ctx context.Context, search *freshservice.ServiceItemSearch) (*freshservice.ServiceItems, *freshservice.Response, error) {
+	m.record("SearchServiceItems", ctx, search)
+	if m.SearchServiceItemsFunc != nil {
+		return m.SearchServiceItemsFunc(ctx, search)
+	}
+	var r0 *freshservice.ServiceItems
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("SearchServiceItems", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ServiceLevelAgreementsAPI is a mock of freshservice.ServiceLevelAgreementsAPI
+type ServiceLevelAgreementsAPI struct {
+	Mock
+
+	ListPoliciesFunc func(ctx context.Context) (*freshservice.Policies, *freshservice.Response, error)
+}
+
+// ListPolicies calls ListPoliciesFunc when set, otherwise it returns the canned response
+func (m *ServiceLevelAgreementsAPI) ListPolicies(ctx context.Context) (*freshservice.Policies, *freshservice.Response, error) {
+	m.record("ListPolicies", ctx)
+	if m.ListPoliciesFunc != nil {
+		return m.ListPoliciesFunc(ctx)
+	}
+	var r0 *freshservice.Policies
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListPolicies", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// SoftwareAPI is a mock of freshservice.SoftwareAPI
+type SoftwareAPI struct {
+	Mock
+
+	AddInstallationFunc      func(ctx context.Context, applicationId int, installation *freshservice.CreateInstallationModel) (*freshservice.SoftwareInstallation, *freshservice.Response, error)
+	BulkAddUsersFunc         func(ctx context.Context, applicationId int, userBindings *freshservice.SoftwareUserBindings) (*freshservice.SoftwareUsers, *freshservice.Response, error)
+	BulkUpdateUsersFunc      func(ctx context.Context, applicationId int, userBindings *freshservice.SoftwareUserBindings) (*freshservice.SoftwareUsers, *freshservice.Response, error)
+	CreateApplicationFunc    func(ctx context.Context, newApplication *freshservice.CreateApplicationModel) (*freshservice.Application, *freshservice.Response, error)
+	DeleteApplicationFunc    func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteInstallationsFunc  func(ctx context.Context, applicationId int, deviceIds []string) (bool, *freshservice.Response, error)
+	DeleteUsersFunc          func(ctx context.Context, applicationId int, userIds []string) (bool, *freshservice.Response, error)
+	GetApplicationFunc       func(ctx context.Context, id int) (*freshservice.Application, *freshservice.Response, error)
+	GetSoftwareUserFunc      func(ctx context.Context, applicationId int, id int) (*freshservice.SoftwareUser, *freshservice.Response, error)
+	IterApplicationsFunc     func(ctx context.Context, opt *freshservice.ListApplicationsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Application) bool) error
+	IterSoftwareUsersFunc    func(ctx context.Context, applicationId int, opt *freshservice.ListSoftwareUsersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SoftwareUser) bool) error
+	ListAllApplicationsFunc  func(ctx context.Context, opt *freshservice.ListApplicationsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Application, error)
+	ListAllSoftwareUsersFunc func(ctx context.Context, applicationId int, opt *freshservice.ListSoftwareUsersOptions, limit *freshservice.PaginationOptions) ([]freshservice.SoftwareUser, error)
+	ListApplicationsFunc     func(ctx context.Context, opt *freshservice.ListApplicationsOptions) (*freshservice.Applications, *freshservice.Response, error)
+	ListInstallationsFunc    func(ctx context.Context, applicationId int) (*freshservice.SoftwareInstallations, *freshservice.Response, error)
+	ListSoftwareUsersFunc    func(ctx context.Context, applicationId int, opt *freshservice.ListSoftwareUsersOptions) (*freshservice.SoftwareUsers, *freshservice.Response, error)
+	UpdateApplicationFunc    func(ctx context.Context, id int, application *freshservice.UpdateApplicationModel) (*freshservice.Application, *freshservice.Response, error)
+}
+
+// AddInstallation calls AddInstallationFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) AddInstallation(ctx context.Context, applicationId int, installation *freshservice.CreateInstallationModel) (*freshservice.SoftwareInstallation, *freshservice.Response, error) {
+	m.record("AddInstallation", ctx, applicationId, installation)
+	if m.AddInstallationFunc != nil {
+		return m.AddInstallationFunc(ctx, applicationId, installation)
+	}
+	var r0 *freshservice.SoftwareInstallation
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("AddInstallation", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// BulkAddUsers calls BulkAddUsersFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) BulkAddUsers(ctx context.Context, applicationId int, userBindings *freshservice.SoftwareUserBindings) (*freshservice.SoftwareUsers, *freshservice.Response, error) {
+	m.record("BulkAddUsers", ctx, applicationId, userBindings)
+	if m.BulkAddUsersFunc != nil {
+		return m.BulkAddUsersFunc(ctx, applicationId, userBindings)
+	}
+	var r0 *freshservice.SoftwareUsers
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("BulkAddUsers", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// BulkUpdateUsers calls BulkUpdateUsersFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) BulkUpdateUsers(ctx context.Context, applicationId int, userBindings *freshservice.SoftwareUserBindings) (*freshservice.SoftwareUsers, *freshservice.Response, error) {
+	m.record("BulkUpdateUsers", ctx, applicationId, userBindings)
+	if m.BulkUpdateUsersFunc != nil {
+		return m.BulkUpdateUsersFunc(ctx, applicationId, userBindings)
+	}
+	var r0 *freshservice.SoftwareUsers
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("BulkUpdateUsers", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateApplication calls CreateApplicationFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) CreateApplication(ctx context.Context, newApplication *freshservice.CreateApplicationModel) (*freshservice.Application, *freshservice.Response, error) {
+	m.record("CreateApplication", ctx, newApplication)
+	if m.CreateApplicationFunc != nil {
+		return m.CreateApplicationFunc(ctx, newApplication)
+	}
+	var r0 *freshservice.Application
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateApplication", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteApplication calls DeleteApplicationFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) DeleteApplication(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteApplication", ctx, id)
+	if m.DeleteApplicationFunc != nil {
+		return m.DeleteApplicationFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteApplication", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteInstallations calls DeleteInstallationsFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) DeleteInstallations(ctx context.Context, applicationId int, deviceIds []string) (bool, *freshservice.Response, error) {
+	m.record("DeleteInstallations", ctx, applicationId, deviceIds)
+	if m.DeleteInstallationsFunc != nil {
+		return m.DeleteInstallationsFunc(ctx, applicationId, deviceIds)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteInstallations", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteUsers calls DeleteUsersFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) DeleteUsers(ctx context.Context, applicationId int, userIds []string) (bool, *freshservice.Response, error) {
+	m.record("DeleteUsers", ctx, applicationId, userIds)
+	if m.DeleteUsersFunc != nil {
+		return m.DeleteUsersFunc(ctx, applicationId, userIds)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteUsers", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetApplication calls GetApplicationFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) GetApplication(ctx context.Context, id int) (*freshservice.Application, *freshservice.Response, error) {
+	m.record("GetApplication", ctx, id)
+	if m.GetApplicationFunc != nil {
+		return m.GetApplicationFunc(ctx, id)
+	}
+	var r0 *freshservice.Application
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetApplication", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetSoftwareUser calls GetSoftwareUserFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) GetSoftwareUser(ctx context.Context, applicationId int, id int) (*freshservice.SoftwareUser, *freshservice.Response, error) {
+	m.record("GetSoftwareUser", ctx, applicationId, id)
+	if m.GetSoftwareUserFunc != nil {
+		return m.GetSoftwareUserFunc(ctx, applicationId, id)
+	}
+	var r0 *freshservice.SoftwareUser
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetSoftwareUser", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterApplications calls IterApplicationsFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) IterApplications(ctx context.Context, opt *freshservice.ListApplicationsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Application) bool) error {
+	m.record("IterApplications", ctx, opt, limit, fn)
+	if m.IterApplicationsFunc != nil {
+		return m.IterApplicationsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterApplications", &r0)
+	return r0
+}
+
+// IterSoftwareUsers calls IterSoftwareUsersFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) IterSoftwareUsers(ctx context.Context, applicationId int, opt *freshservice.ListSoftwareUsersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SoftwareUser) bool) error {
+	m.record("IterSoftwareUsers", ctx, applicationId, opt, limit, fn)
+	if m.IterSoftwareUsersFunc != nil {
+		return m.IterSoftwareUsersFunc(ctx, applicationId, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterSoftwareUsers", &r0)
+	return r0
+}
+
+// ListAllApplications calls ListAllApplicationsFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) ListAllApplications(ctx context.Context, opt *freshservice.ListApplicationsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Application, error) {
+	m.record("ListAllApplications", ctx, opt, limit)
+	if m.ListAllApplicationsFunc != nil {
+		return m.ListAllApplicationsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Application
+	var r1 error
+	m.returns("ListAllApplications", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllSoftwareUsers calls ListAllSoftwareUsersFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) ListAllSoftwareUsers(ctx context.Context, applicationId int, opt *freshservice.ListSoftwareUsersOptions, limit *freshservice.PaginationOptions) ([]freshservice.SoftwareUser, error) {
+	m.record("ListAllSoftwareUsers", ctx, applicationId, opt, limit)
+	if m.ListAllSoftwareUsersFunc != nil {
+		return m.ListAllSoftwareUsersFunc(ctx, applicationId, opt, limit)
+	}
+	var r0 []freshservice.SoftwareUser
+	var r1 error
+	m.returns("ListAllSoftwareUsers", &r0, &r1)
+	return r0, r1
+}
+
+// ListApplications calls ListApplicationsFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) ListApplications(ctx context.Context, opt *freshservice.ListApplicationsOptions) (*freshservice.Applications, *freshservice.Response, error) {
+	m.record("ListApplications", ctx, opt)
+	if m.ListApplicationsFunc != nil {
+		return m.ListApplicationsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Applications
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListApplications", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListInstallations calls ListInstallationsFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) ListInstallations(ctx context.Context, applicationId int) (*freshservice.SoftwareInstallations, *freshservice.Response, error) {
+	m.record("ListInstallations", ctx, applicationId)
+	if m.ListInstallationsFunc != nil {
+		return m.ListInstallationsFunc(ctx, applicationId)
+	}
+	var r0 *freshservice.SoftwareInstallations
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListInstallations", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListSoftwareUsers calls ListSoftwareUsersFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) ListSoftwareUsers(ctx context.Context, applicationId int, opt *freshservice.ListSoftwareUsersOptions) (*freshservice.SoftwareUsers, *freshservice.Response, error) {
+	m.record("ListSoftwareUsers", ctx, applicationId, opt)
+	if m.ListSoftwareUsersFunc != nil {
+		return m.ListSoftwareUsersFunc(ctx, applicationId, opt)
+	}
+	var r0 *freshservice.SoftwareUsers
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListSoftwareUsers", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateApplication calls UpdateApplicationFunc when set, otherwise it returns the canned response
+func (m *SoftwareAPI) UpdateApplication(ctx context.Context, id int, application *freshservice.UpdateApplicationModel) (*freshservice.Application, *freshservice.Response, error) {
+	m.record("UpdateApplication", ctx, id, application)
+	if m.UpdateApplicationFunc != nil {
+		return m.UpdateApplicationFunc(ctx, id, application)
+	}
+	var r0 *freshservice.Application
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateApplication", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// SolutionsAPI is a mock of freshservice.SolutionsAPI
+type SolutionsAPI struct {
+	Mock
+
+	CreateSolutionArticleFunc          func(ctx context.Context, solutionArticle *freshservice.CreateSolutionArticleModel) (*freshservice.SolutionArticle, *freshservice.Response, error)
+	CreateSolutionCategoryFunc         func(ctx context.Context, solutionCategory *freshservice.CreateSolutionCategoryModel) (*freshservice.SolutionCategory, *freshservice.Response, error)
+	CreateSolutionFolderFunc           func(ctx context.Context, solutionFolder *freshservice.CreateSolutionFolderModel) (*freshservice.SolutionFolder, *freshservice.Response, error)
+	DeleteSolutionArticleFunc          func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteSolutionCategoryFunc         func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteSolutionFolderFunc           func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetSolutionArticleFunc             func(ctx context.Context, id int) (*freshservice.SolutionArticle, *freshservice.Response, error)
+	GetSolutionCategoryFunc            func(ctx context.Context, id int) (*freshservice.SolutionCategory, *freshservice.Response, error)
+	GetSolutionFolderFunc              func(ctx context.Context, id int) (*freshservice.SolutionFolder, *freshservice.Response, error)
+	IterSolutionArticlesFunc           func(ctx context.Context, opt *freshservice.ListSolutionArticlesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SolutionArticle) bool) error
+	IterSolutionCategoriesFunc         func(ctx context.Context, opt *freshservice.ListSolutionCategoriesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SolutionCategory) bool) error
+	IterSolutionFoldersFunc            func(ctx context.Context, opt *freshservice.ListSolutionFoldersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SolutionFolder) bool) error
+	ListAllSolutionArticlesFunc        func(ctx context.Context, opt *freshservice.ListSolutionArticlesOptions, limit *freshservice.PaginationOptions) ([]freshservice.SolutionArticle, error)
+	ListAllSolutionCategoriesFunc      func(ctx context.Context, opt *freshservice.ListSolutionCategoriesOptions, limit *freshservice.PaginationOptions) ([]freshservice.SolutionCategory, error)
+	ListAllSolutionFoldersFunc         func(ctx context.Context, opt *freshservice.ListSolutionFoldersOptions, limit *freshservice.PaginationOptions) ([]freshservice.SolutionFolder, error)
+	ListSolutionArticlesFunc           func(ctx context.Context, opt *freshservice.ListSolutionArticlesOptions) (*freshservice.SolutionArticles, *freshservice.Response, error)
+	ListSolutionCategoriesFunc         func(ctx context.Context, opt *freshservice.ListSolutionCategoriesOptions) (*freshservice.SolutionCategories, *freshservice.Response, error)
+	ListSolutionFoldersFunc            func(ctx context.Context, opt *freshservice.ListSolutionFoldersOptions) (*freshservice.SolutionFolders, *freshservice.Response, error)
+	SendSolutionArticleForApprovalFunc func(ctx context.Context, id int) (*freshservice.SolutionArticle, *freshservice.Response, error)
+	UpdateSolutionArticleFunc          func(ctx context.Context, id int, solutionArticle *freshservice.UpdateSolutionArticleModel) (*freshservice.SolutionArticle, *freshservice.Response, error)
+	UpdateSolutionCategoryFunc         func(ctx context.Context, id int, solutionCategory *freshservice.UpdateSolutionCategoryModel) (*freshservice.SolutionCategory, *freshservice.Response, error)
+	UpdateSolutionFolderFunc           func(ctx context.Context, id int, solutionFolder *freshservice.UpdateSolutionFolderModel) (*freshservice.SolutionFolder, *freshservice.Response, error)
+}
+
+// CreateSolutionArticle calls CreateSolutionArticleFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) CreateSolutionArticle(ctx context.Context, solutionArticle *freshservice.CreateSolutionArticleModel) (*freshservice.SolutionArticle, *freshservice.Response, error) {
+	m.record("CreateSolutionArticle", ctx, solutionArticle)
+	if m.CreateSolutionArticleFunc != nil {
+		return m.CreateSolutionArticleFunc(ctx, solutionArticle)
+	}
+	var r0 *freshservice.SolutionArticle
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateSolutionArticle", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateSolutionCategory calls CreateSolutionCategoryFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) CreateSolutionCategory(ctx context.Context, solutionCategory *freshservice.CreateSolutionCategoryModel) (*freshservice.SolutionCategory, *freshservice.Response, error) {
+	m.record("CreateSolutionCategory", ctx, solutionCategory)
+	if m.CreateSolutionCategoryFunc != nil {
+		return m.CreateSolutionCategoryFunc(ctx, solutionCategory)
+	}
+	var r0 *freshservice.SolutionCategory
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateSolutionCategory", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateSolutionFolder calls CreateSolutionFolderFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) CreateSolutionFolder(ctx context.Context, solutionFolder *freshservice.CreateSolutionFolderModel) (*freshservice.SolutionFolder, *freshservice.Response, error) {
+	m.record("CreateSolutionFolder", ctx, solutionFolder)
+	if m.CreateSolutionFolderFunc != nil {
+		return m.CreateSolutionFolderFunc(ctx, solutionFolder)
+	}
+	var r0 *freshservice.SolutionFolder
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateSolutionFolder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteSolutionArticle calls DeleteSolutionArticleFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) DeleteSolutionArticle(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteSolutionArticle", ctx, id)
+	if m.DeleteSolutionArticleFunc != nil {
+		return m.DeleteSolutionArticleFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteSolutionArticle", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteSolutionCategory calls DeleteSolutionCategoryFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) DeleteSolutionCategory(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteSolutionCategory", ctx, id)
+	if m.DeleteSolutionCategoryFunc != nil {
+		return m.DeleteSolutionCategoryFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteSolutionCategory", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteSolutionFolder calls DeleteSolutionFolderFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) DeleteSolutionFolder(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteSolutionFolder", ctx, id)
+	if m.DeleteSolutionFolderFunc != nil {
+		return m.DeleteSolutionFolderFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteSolutionFolder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetSolutionArticle calls GetSolutionArticleFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) GetSolutionArticle(ctx context.Context, id int) (*freshservice.SolutionArticle, *freshservice.Response, error) {
+	m.record("GetSolutionArticle", ctx, id)
+	if m.GetSolutionArticleFunc != nil {
+		return m.GetSolutionArticleFunc(ctx, id)
+	}
+	var r0 *freshservice.SolutionArticle
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetSolutionArticle", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetSolutionCategory calls GetSolutionCategoryFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) GetSolutionCategory(ctx context.Context, id int) (*freshservice.SolutionCategory, *freshservice.Response, error) {
+	m.record("GetSolutionCategory", ctx, id)
+	if m.GetSolutionCategoryFunc != nil {
+		return m.GetSolutionCategoryFunc(ctx, id)
+	}
+	var r0 *freshservice.SolutionCategory
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetSolutionCategory", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetSolutionFolder calls GetSolutionFolderFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) GetSolutionFolder(ctx context.Context, id int) (*freshservice.SolutionFolder, *freshservice.Response, error) {
+	m.record("GetSolutionFolder", ctx, id)
+	if m.GetSolutionFolderFunc != nil {
+		return m.GetSolutionFolderFunc(ctx, id)
+	}
+	var r0 *freshservice.SolutionFolder
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetSolutionFolder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterSolutionArticles calls IterSolutionArticlesFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) IterSolutionArticles(ctx context.Context, opt *freshservice.ListSolutionArticlesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SolutionArticle) bool) error {
+	m.record("IterSolutionArticles", ctx, opt, limit, fn)
+	if m.IterSolutionArticlesFunc != nil {
+		return m.IterSolutionArticlesFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterSolutionArticles", &r0)
+	return r0
+}
+
+// IterSolutionCategories calls IterSolutionCategoriesFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) IterSolutionCategories(ctx context.Context, opt *freshservice.ListSolutionCategoriesOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SolutionCategory) bool) error {
+	m.record("IterSolutionCategories", ctx, opt, limit, fn)
+	if m.IterSolutionCategoriesFunc != nil {
+		return m.IterSolutionCategoriesFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterSolutionCategories", &r0)
+	return r0
+}
+
+// IterSolutionFolders calls IterSolutionFoldersFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) IterSolutionFolders(ctx context.Context, opt *freshservice.ListSolutionFoldersOptions, limit *freshservice.PaginationOptions, fn func(freshservice.SolutionFolder) bool) error {
+	m.record("IterSolutionFolders", ctx, opt, limit, fn)
+	if m.IterSolutionFoldersFunc != nil {
+		return m.IterSolutionFoldersFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterSolutionFolders", &r0)
+	return r0
+}
+
+// ListAllSolutionArticles calls ListAllSolutionArticlesFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) ListAllSolutionArticles(ctx context.Context, opt *freshservice.ListSolutionArticlesOptions, limit *freshservice.PaginationOptions) ([]freshservice.SolutionArticle, error) {
+	m.record("ListAllSolutionArticles", ctx, opt, limit)
+	if m.ListAllSolutionArticlesFunc != nil {
+		return m.ListAllSolutionArticlesFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.SolutionArticle
+	var r1 error
+	m.returns("ListAllSolutionArticles", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllSolutionCategories calls ListAllSolutionCategoriesFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) ListAllSolutionCategories(ctx context.Context, opt *freshservice.ListSolutionCategoriesOptions, limit *freshservice.PaginationOptions) ([]freshservice.SolutionCategory, error) {
+	m.record("ListAllSolutionCategories", ctx, opt, limit)
+	if m.ListAllSolutionCategoriesFunc != nil {
+		return m.ListAllSolutionCategoriesFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.SolutionCategory
+	var r1 error
+	m.returns("ListAllSolutionCategories", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllSolutionFolders calls ListAllSolutionFoldersFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) ListAllSolutionFolders(ctx context.Context, opt *freshservice.ListSolutionFoldersOptions, limit *freshservice.PaginationOptions) ([]freshservice.SolutionFolder, error) {
+	m.record("ListAllSolutionFolders", ctx, opt, limit)
+	if m.ListAllSolutionFoldersFunc != nil {
+		return m.ListAllSolutionFoldersFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.SolutionFolder
+	var r1 error
+	m.returns("ListAllSolutionFolders", &r0, &r1)
+	return r0, r1
+}
+
+// ListSolutionArticles calls ListSolutionArticlesFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) ListSolutionArticles(ctx context.Context, opt *freshservice.ListSolutionArticlesOptions) (*freshservice.SolutionArticles, *freshservice.Response, error) {
+	m.record("ListSolutionArticles", ctx, opt)
+	if m.ListSolutionArticlesFunc != nil {
+		return m.ListSolutionArticlesFunc(ctx, opt)
+	}
+	var r0 *freshservice.SolutionArticles
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListSolutionArticles", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListSolutionCategories calls ListSolutionCategoriesFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) ListSolutionCategories(ctx context.Context, opt *freshservice.ListSolutionCategoriesOptions) (*freshservice.SolutionCategories, *freshservice.Response, error) {
+	m.record("ListSolutionCategories", ctx, opt)
+	if m.ListSolutionCategoriesFunc != nil {
+		return m.ListSolutionCategoriesFunc(ctx, opt)
+	}
+	var r0 *freshservice.SolutionCategories
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListSolutionCategories", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListSolutionFolders calls ListSolutionFoldersFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) ListSolutionFolders(ctx context.Context, opt *freshservice.ListSolutionFoldersOptions) (*freshservice.SolutionFolders, *freshservice.Response, error) {
+	m.record("ListSolutionFolders", ctx, opt)
+	if m.ListSolutionFoldersFunc != nil {
+		return m.ListSolutionFoldersFunc(ctx, opt)
+	}
+	var r0 *freshservice.SolutionFolders
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListSolutionFolders", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// SendSolutionArticleForApproval calls SendSolutionArticleForApprovalFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) SendSolutionArticleForApproval(ctx context.Context, id int) (*freshservice.SolutionArticle, *freshservice.Response, error) {
+	m.record("SendSolutionArticleForApproval", ctx, id)
+	if m.SendSolutionArticleForApprovalFunc != nil {
+		return m.SendSolutionArticleForApprovalFunc(ctx, id)
+	}
+	var r0 *freshservice.SolutionArticle
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("SendSolutionArticleForApproval", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateSolutionArticle calls UpdateSolutionArticleFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) UpdateSolutionArticle(ctx context.Context, id int, solutionArticle *freshservice.UpdateSolutionArticleModel) (*freshservice.SolutionArticle, *freshservice.Response, error) {
+	m.record("UpdateSolutionArticle", ctx, id, solutionArticle)
+	if m.UpdateSolutionArticleFunc != nil {
+		return m.UpdateSolutionArticleFunc(ctx, id, solutionArticle)
+	}
+	var r0 *freshservice.SolutionArticle
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateSolutionArticle", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateSolutionCategory calls UpdateSolutionCategoryFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) UpdateSolutionCategory(ctx context.Context, id int, solutionCategory *freshservice.UpdateSolutionCategoryModel) (*freshservice.SolutionCategory, *freshservice.Response, error) {
+	m.record("UpdateSolutionCategory", ctx, id, solutionCategory)
+	if m.UpdateSolutionCategoryFunc != nil {
+		return m.UpdateSolutionCategoryFunc(ctx, id, solutionCategory)
+	}
+	var r0 *freshservice.SolutionCategory
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateSolutionCategory", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateSolutionFolder calls UpdateSolutionFolderFunc when set, otherwise it returns the canned response
+func (m *SolutionsAPI) UpdateSolutionFolder(ctx context.Context, id int, solutionFolder *freshservice.UpdateSolutionFolderModel) (*freshservice.SolutionFolder, *freshservice.Response, error) {
+	m.record("UpdateSolutionFolder", ctx, id, solutionFolder)
+	if m.UpdateSolutionFolderFunc != nil {
+		return m.UpdateSolutionFolderFunc(ctx, id, solutionFolder)
+	}
+	var r0 *freshservice.SolutionFolder
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateSolutionFolder", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// TicketsAPI is a mock of freshservice.TicketsAPI
+type TicketsAPI struct {
+	Mock
+
+	CreateNoteFunc                        func(ctx context.Context, ticketId int, note *freshservice.CreateConversationNoteModel) (*freshservice.Conversation, *freshservice.Response, error)
+	CreateNoteWithAttachmentsFunc         func(ctx context.Context, ticketId int, note *freshservice.CreateConversationNoteModel, files ...io.Reader) (*freshservice.Conversation, *freshservice.Response, error)
+	CreateTaskFunc                        func(ctx context.Context, ticketId int, newTask *freshservice.CreateTaskModel) (*freshservice.Task, *freshservice.Response, error)
+	CreateTicketFunc                      func(ctx context.Context, newTicket *freshservice.CreateTicketModel) (*freshservice.Ticket, *freshservice.Response, error)
+	CreateTicketWithAttachmentsFunc       func(ctx context.Context, newTicket *freshservice.CreateTicketModel, files ...io.Reader) (*freshservice.Ticket, *freshservice.Response, error)
+	CreateTimeEntryFunc                   func(ctx context.Context, ticketId int, timeEntry *freshservice.CreateTimeEntryModel) (*freshservice.TimeEntry, *freshservice.Response, error)
+	DeleteAttachmentFunc                  func(ctx context.Context, ticketId int, attachmentId int) (bool, *freshservice.Response, error)
+	DeleteConversationFunc                func(ctx context.Context, conversationId int) (bool, *freshservice.Response, error)
+	DeleteTaskFunc                        func(ctx context.Context, ticketId int, taskId int) (bool, *freshservice.Response, error)
+	DeleteTicketFunc                      func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	DeleteTimeEntryFunc                   func(ctx context.Context, ticketId int, timeEntryId int) (bool, *freshservice.Response, error)
+	DownloadAttachmentFunc                func(ctx context.Context, attachment *freshservice.TicketAttachment, w io.Writer, progress freshservice.ProgressFunc) (*freshservice.Response, error)
+	FilterTicketsFunc                     func(ctx context.Context, q freshservice.Query, opt *freshservice.FilterTicketsOptions) (*freshservice.Tickets, *freshservice.Response, error)
+	GetAuditFunc                          func(ctx context.Context, ticketId int) (*freshservice.TicketActivities, *freshservice.Response, error)
+	GetTaskFunc                           func(ctx context.Context, ticketId int, taskId int) (*freshservice.Task, *freshservice.Response, error)
+	GetTicketFunc                         func(ctx context.Context, id int) (*freshservice.Ticket, *freshservice.Response, error)
+	GetTimeEntryFunc                      func(ctx context.Context, ticketId int, timeEntryId int) (*freshservice.TimeEntry, *freshservice.Response, error)
+	IterConversationsFunc                 func(ctx context.Context, ticketId int, opt *freshservice.ListConversationsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Conversation) bool) error
+	IterFilterTicketsFunc                 func(ctx context.Context, q freshservice.Query, limit *freshservice.PaginationOptions, fn func(freshservice.Ticket) bool) error
+	IterTicketTasksFunc                   func(ctx context.Context, ticketId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Task) bool) error
+	IterTicketsFunc                       func(ctx context.Context, opt *freshservice.ListTicketsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Ticket) bool) error
+	ListAllConversationsFunc              func(ctx context.Context, ticketId int, opt *freshservice.ListConversationsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Conversation, error)
+	ListAllFilterTicketsFunc              func(ctx context.Context, q freshservice.Query, limit *freshservice.PaginationOptions) ([]freshservice.Ticket, error)
+	ListAllTicketTasksFunc                func(ctx context.Context, ticketId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions) ([]freshservice.Task, error)
+	ListAllTicketsFunc                    func(ctx context.Context, opt *freshservice.ListTicketsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Ticket, error)
+	ListConversationsFunc                 func(ctx context.Context, ticketId int, opt *freshservice.ListConversationsOptions) (*freshservice.Conversations, *freshservice.Response, error)
+	ListTasksFunc                         func(ctx context.Context, ticketId int, opt *freshservice.ListTasksOptions) (*freshservice.Tasks, *freshservice.Response, error)
+	ListTicketFormFieldsFunc              func(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error)
+	ListTicketsFunc                       func(ctx context.Context, opt *freshservice.ListTicketsOptions) (*freshservice.Tickets, *freshservice.Response, error)
+	ListTimeEntriesFunc                   func(ctx context.Context, ticketId int) (*freshservice.TimeEntries, *freshservice.Response, error)
+	ReplyFunc                             func(ctx context.Context, ticketId int, reply *freshservice.CreateReplyModel) (*freshservice.Conversation, *freshservice.Response, error)
+	ReplyWithAttachmentsFunc              func(ctx context.Context, ticketId int, reply *freshservice.CreateReplyModel, files ...io.Reader) (*freshservice.Conversation, *freshservice.Response, error)
+	RestoreTicketFunc                     func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	UpdateConversationFunc                func(ctx context.Context, conversationId int, conversation *freshservice.UpdateConversationModel) (*freshservice.Conversation, *freshservice.Response, error)
+	UpdateConversationWithAttachmentsFunc func(ctx context.Context, conversationId int, conversation *freshservice.UpdateConversationModel, files ...io.Reader) (*freshservice.Conversation, *freshservice.Response, error)
+	UpdateTaskFunc                        func(ctx context.Context, ticketId int, taskId int, task *freshservice.UpdateTaskModel) (*freshservice.Task, *freshservice.Response, error)
+	UpdateTicketFunc                      func(ctx context.Context, id int, ticket *freshservice.UpdateTicketModel) (*freshservice.Ticket, *freshservice.Response, error)
+}
+
+// CreateNote calls CreateNoteFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) CreateNote(ctx context.Context, ticketId int, note *freshservice.CreateConversationNoteModel) (*freshservice.Conversation, *freshservice.Response, error) {
+	m.record("CreateNote", ctx, ticketId, note)
+	if m.CreateNoteFunc != nil {
+		return m.CreateNoteFunc(ctx, ticketId, note)
+	}
+	var r0 *freshservice.Conversation
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateNote", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateNoteWithAttachments calls CreateNoteWithAttachmentsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) CreateNoteWithAttachments(ctx context.Context, ticketId int, note *freshservice.CreateConversationNoteModel, files ...io.Reader) (*freshservice.Conversation, *freshservice.Response, error) {
+	m.record("CreateNoteWithAttachments", ctx, ticketId, note, files)
+	if m.CreateNoteWithAttachmentsFunc != nil {
+		return m.CreateNoteWithAttachmentsFunc(ctx, ticketId, note, files...)
+	}
+	var r0 *freshservice.Conversation
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateNoteWithAttachments", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTask calls CreateTaskFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) CreateTask(ctx context.Context, ticketId int, newTask *freshservice.CreateTaskModel) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("CreateTask", ctx, ticketId, newTask)
+	if m.CreateTaskFunc != nil {
+		return m.CreateTaskFunc(ctx, ticketId, newTask)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTicket calls CreateTicketFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) CreateTicket(ctx context.Context, newTicket *freshservice.CreateTicketModel) (*freshservice.Ticket, *freshservice.Response, error) {
+	m.record("CreateTicket", ctx, newTicket)
+	if m.CreateTicketFunc != nil {
+		return m.CreateTicketFunc(ctx, newTicket)
+	}
+	var r0 *freshservice.Ticket
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTicket", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTicketWithAttachments calls CreateTicketWithAttachmentsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) CreateTicketWithAttachments(ctx context.Context, newTicket *freshservice.CreateTicketModel, files ...io.Reader) (*freshservice.Ticket, *freshservice.Response, error) {
+	m.record("CreateTicketWithAttachments", ctx, newTicket, files)
+	if m.CreateTicketWithAttachmentsFunc != nil {
+		return m.CreateTicketWithAttachmentsFunc(ctx, newTicket, files...)
+	}
+	var r0 *freshservice.Ticket
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTicketWithAttachments", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// CreateTimeEntry calls CreateTimeEntryFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) CreateTimeEntry(ctx context.Context, ticketId int, timeEntry *freshservice.CreateTimeEntryModel) (*freshservice.TimeEntry, *freshservice.Response, error) {
+	m.record("CreateTimeEntry", ctx, ticketId, timeEntry)
+	if m.CreateTimeEntryFunc != nil {
+		return m.CreateTimeEntryFunc(ctx, ticketId, timeEntry)
+	}
+	var r0 *freshservice.TimeEntry
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteAttachment calls DeleteAttachmentFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) DeleteAttachment(ctx context.Context, ticketId int, attachmentId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteAttachment", ctx, ticketId, attachmentId)
+	if m.DeleteAttachmentFunc != nil {
+		return m.DeleteAttachmentFunc(ctx, ticketId, attachmentId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteAttachment", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteConversation calls DeleteConversationFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) DeleteConversation(ctx context.Context, conversationId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteConversation", ctx, conversationId)
+	if m.DeleteConversationFunc != nil {
+		return m.DeleteConversationFunc(ctx, conversationId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteConversation", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteTask calls DeleteTaskFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) DeleteTask(ctx context.Context, ticketId int, taskId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteTask", ctx, ticketId, taskId)
+	if m.DeleteTaskFunc != nil {
+		return m.DeleteTaskFunc(ctx, ticketId, taskId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteTicket calls DeleteTicketFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) DeleteTicket(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteTicket", ctx, id)
+	if m.DeleteTicketFunc != nil {
+		return m.DeleteTicketFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteTicket", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteTimeEntry calls DeleteTimeEntryFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) DeleteTimeEntry(ctx context.Context, ticketId int, timeEntryId int) (bool, *freshservice.Response, error) {
+	m.record("DeleteTimeEntry", ctx, ticketId, timeEntryId)
+	if m.DeleteTimeEntryFunc != nil {
+		return m.DeleteTimeEntryFunc(ctx, ticketId, timeEntryId)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DownloadAttachment calls DownloadAttachmentFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) DownloadAttachment(ctx context.Context, attachment *freshservice.TicketAttachment, w io.Writer, progress freshservice.ProgressFunc) (*freshservice.Response, error) {
+	m.record("DownloadAttachment", ctx, attachment, w, progress)
+	if m.DownloadAttachmentFunc != nil {
+		return m.DownloadAttachmentFunc(ctx, attachment, w, progress)
+	}
+	var r0 *freshservice.Response
+	var r1 error
+	m.returns("DownloadAttachment", &r0, &r1)
+	return r0, r1
+}
+
+// FilterTickets calls FilterTicketsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) FilterTickets(ctx context.Context, q freshservice.Query, opt *freshservice.FilterTicketsOptions) (*freshservice.Tickets, *freshservice.Response, error) {
+	m.record("FilterTickets", ctx, q, opt)
+	if m.FilterTicketsFunc != nil {
+		return m.FilterTicketsFunc(ctx, q, opt)
+	}
+	var r0 *freshservice.Tickets
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("FilterTickets", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetAudit calls GetAuditFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) GetAudit(ctx context.Context, ticketId int) (*freshservice.TicketActivities, *freshservice.Response, error) {
+	m.record("GetAudit", ctx, ticketId)
+	if m.GetAuditFunc != nil {
+		return m.GetAuditFunc(ctx, ticketId)
+	}
+	var r0 *freshservice.TicketActivities
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetAudit", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetTask calls GetTaskFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) GetTask(ctx context.Context, ticketId int, taskId int) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("GetTask", ctx, ticketId, taskId)
+	if m.GetTaskFunc != nil {
+		return m.GetTaskFunc(ctx, ticketId, taskId)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetTicket calls GetTicketFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) GetTicket(ctx context.Context, id int) (*freshservice.Ticket, *freshservice.Response, error) {
+	m.record("GetTicket", ctx, id)
+	if m.GetTicketFunc != nil {
+		return m.GetTicketFunc(ctx, id)
+	}
+	var r0 *freshservice.Ticket
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetTicket", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetTimeEntry calls GetTimeEntryFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) GetTimeEntry(ctx context.Context, ticketId int, timeEntryId int) (*freshservice.TimeEntry, *freshservice.Response, error) {
+	m.record("GetTimeEntry", ctx, ticketId, timeEntryId)
+	if m.GetTimeEntryFunc != nil {
+		return m.GetTimeEntryFunc(ctx, ticketId, timeEntryId)
+	}
+	var r0 *freshservice.TimeEntry
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetTimeEntry", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterConversations calls IterConversationsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) IterConversations(ctx context.Context, ticketId int, opt *freshservice.ListConversationsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Conversation) bool) error {
+	m.record("IterConversations", ctx, ticketId, opt, limit, fn)
+	if m.IterConversationsFunc != nil {
+		return m.IterConversationsFunc(ctx, ticketId, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterConversations", &r0)
+	return r0
+}
+
+// IterFilterTickets calls IterFilterTicketsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) IterFilterTickets(ctx context.Context, q freshservice.Query, limit *freshservice.PaginationOptions, fn func(freshservice.Ticket) bool) error {
+	m.record("IterFilterTickets", ctx, q, limit, fn)
+	if m.IterFilterTicketsFunc != nil {
+		return m.IterFilterTicketsFunc(ctx, q, limit, fn)
+	}
+	var r0 error
+	m.returns("IterFilterTickets", &r0)
+	return r0
+}
+
+// IterTicketTasks calls IterTicketTasksFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) IterTicketTasks(ctx context.Context, ticketId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Task) bool) error {
+	m.record("IterTicketTasks", ctx, ticketId, opt, limit, fn)
+	if m.IterTicketTasksFunc != nil {
+		return m.IterTicketTasksFunc(ctx, ticketId, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterTicketTasks", &r0)
+	return r0
+}
+
+// IterTickets calls IterTicketsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) IterTickets(ctx context.Context, opt *freshservice.ListTicketsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Ticket) bool) error {
+	m.record("IterTickets", ctx, opt, limit, fn)
+	if m.IterTicketsFunc != nil {
+		return m.IterTicketsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterTickets", &r0)
+	return r0
+}
+
+// ListAllConversations calls ListAllConversationsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListAllConversations(ctx context.Context, ticketId int, opt *freshservice.ListConversationsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Conversation, error) {
+	m.record("ListAllConversations", ctx, ticketId, opt, limit)
+	if m.ListAllConversationsFunc != nil {
+		return m.ListAllConversationsFunc(ctx, ticketId, opt, limit)
+	}
+	var r0 []freshservice.Conversation
+	var r1 error
+	m.returns("ListAllConversations", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllFilterTickets calls ListAllFilterTicketsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListAllFilterTickets(ctx context.Context, q freshservice.Query, limit *freshservice.PaginationOptions) ([]freshservice.Ticket, error) {
+	m.record("ListAllFilterTickets", ctx, q, limit)
+	if m.ListAllFilterTicketsFunc != nil {
+		return m.ListAllFilterTicketsFunc(ctx, q, limit)
+	}
+	var r0 []freshservice.Ticket
+	var r1 error
+	m.returns("ListAllFilterTickets", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllTicketTasks calls ListAllTicketTasksFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListAllTicketTasks(ctx context.Context, ticketId int, opt *freshservice.ListTasksOptions, limit *freshservice.PaginationOptions) ([]freshservice.Task, error) {
+	m.record("ListAllTicketTasks", ctx, ticketId, opt, limit)
+	if m.ListAllTicketTasksFunc != nil {
+		return m.ListAllTicketTasksFunc(ctx, ticketId, opt, limit)
+	}
+	var r0 []freshservice.Task
+	var r1 error
+	m.returns("ListAllTicketTasks", &r0, &r1)
+	return r0, r1
+}
+
+// ListAllTickets calls ListAllTicketsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListAllTickets(ctx context.Context, opt *freshservice.ListTicketsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Ticket, error) {
+	m.record("ListAllTickets", ctx, opt, limit)
+	if m.ListAllTicketsFunc != nil {
+		return m.ListAllTicketsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Ticket
+	var r1 error
+	m.returns("ListAllTickets", &r0, &r1)
+	return r0, r1
+}
+
+// ListConversations calls ListConversationsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListConversations(ctx context.Context, ticketId int, opt *freshservice.ListConversationsOptions) (*freshservice.Conversations, *freshservice.Response, error) {
+	m.record("ListConversations", ctx, ticketId, opt)
+	if m.ListConversationsFunc != nil {
+		return m.ListConversationsFunc(ctx, ticketId, opt)
+	}
+	var r0 *freshservice.Conversations
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListConversations", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTasks calls ListTasksFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListTasks(ctx context.Context, ticketId int, opt *freshservice.ListTasksOptions) (*freshservice.Tasks, *freshservice.Response, error) {
+	m.record("ListTasks", ctx, ticketId, opt)
+	if m.ListTasksFunc != nil {
+		return m.ListTasksFunc(ctx, ticketId, opt)
+	}
+	var r0 *freshservice.Tasks
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTasks", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTicketFormFields calls ListTicketFormFieldsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListTicketFormFields(ctx context.Context) (*freshservice.FormFields, *freshservice.Response, error) {
+	m.record("ListTicketFormFields", ctx)
+	if m.ListTicketFormFieldsFunc != nil {
+		return m.ListTicketFormFieldsFunc(ctx)
+	}
+	var r0 *freshservice.FormFields
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTicketFormFields", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTickets calls ListTicketsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListTickets(ctx context.Context, opt *freshservice.ListTicketsOptions) (*freshservice.Tickets, *freshservice.Response, error) {
+	m.record("ListTickets", ctx, opt)
+	if m.ListTicketsFunc != nil {
+		return m.ListTicketsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Tickets
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTickets", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ListTimeEntries calls ListTimeEntriesFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ListTimeEntries(ctx context.Context, ticketId int) (*freshservice.TimeEntries, *freshservice.Response, error) {
+	m.record("ListTimeEntries", ctx, ticketId)
+	if m.ListTimeEntriesFunc != nil {
+		return m.ListTimeEntriesFunc(ctx, ticketId)
+	}
+	var r0 *freshservice.TimeEntries
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListTimeEntries", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// Reply calls ReplyFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) Reply(ctx context.Context, ticketId int, reply *freshservice.CreateReplyModel) (*freshservice.Conversation, *freshservice.Response, error) {
+	m.record("Reply", ctx, ticketId, reply)
+	if m.ReplyFunc != nil {
+		return m.ReplyFunc(ctx, ticketId, reply)
+	}
+	var r0 *freshservice.Conversation
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("Reply", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// ReplyWithAttachments calls ReplyWithAttachmentsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) ReplyWithAttachments(ctx context.Context, ticketId int, reply *freshservice.CreateReplyModel, files ...io.Reader) (*freshservice.Conversation, *freshservice.Response, error) {
+	m.record("ReplyWithAttachments", ctx, ticketId, reply, files)
+	if m.ReplyWithAttachmentsFunc != nil {
+		return m.ReplyWithAttachmentsFunc(ctx, ticketId, reply, files...)
+	}
+	var r0 *freshservice.Conversation
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ReplyWithAttachments", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// RestoreTicket calls RestoreTicketFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) RestoreTicket(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("RestoreTicket", ctx, id)
+	if m.RestoreTicketFunc != nil {
+		return m.RestoreTicketFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("RestoreTicket", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateConversation calls UpdateConversationFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) UpdateConversation(ctx context.Context, conversationId int, conversation *freshservice.UpdateConversationModel) (*freshservice.Conversation, *freshservice.Response, error) {
+	m.record("UpdateConversation", ctx, conversationId, conversation)
+	if m.UpdateConversationFunc != nil {
+		return m.UpdateConversationFunc(ctx, conversationId, conversation)
+	}
+	var r0 *freshservice.Conversation
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateConversation", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateConversationWithAttachments calls UpdateConversationWithAttachmentsFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) UpdateConversationWithAttachments(ctx context.Context, conversationId int, conversation *freshservice.UpdateConversationModel, files ...io.Reader) (*freshservice.Conversation, *freshservice.Response, error) {
+	m.record("UpdateConversationWithAttachments", ctx, conversationId, conversation, files)
+	if m.UpdateConversationWithAttachmentsFunc != nil {
+		return m.UpdateConversationWithAttachmentsFunc(ctx, conversationId, conversation, files...)
+	}
+	var r0 *freshservice.Conversation
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateConversationWithAttachments", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateTask calls UpdateTaskFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) UpdateTask(ctx context.Context, ticketId int, taskId int, task *freshservice.UpdateTaskModel) (*freshservice.Task, *freshservice.Response, error) {
+	m.record("UpdateTask", ctx, ticketId, taskId, task)
+	if m.UpdateTaskFunc != nil {
+		return m.UpdateTaskFunc(ctx, ticketId, taskId, task)
+	}
+	var r0 *freshservice.Task
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateTask", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateTicket calls UpdateTicketFunc when set, otherwise it returns the canned response
+func (m *TicketsAPI) UpdateTicket(ctx context.Context, id int, ticket *freshservice.UpdateTicketModel) (*freshservice.Ticket, *freshservice.Response, error) {
+	m.record("UpdateTicket", ctx, id, ticket)
+	if m.UpdateTicketFunc != nil {
+		return m.UpdateTicketFunc(ctx, id, ticket)
+	}
+	var r0 *freshservice.Ticket
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateTicket", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// VendorsAPI is a mock of freshservice.VendorsAPI
+type VendorsAPI struct {
+	Mock
+
+	CreateVendorFunc   func(ctx context.Context, newVendor *freshservice.CreateVendorModel) (*freshservice.Vendor, *freshservice.Response, error)
+	DeleteVendorFunc   func(ctx context.Context, id int) (bool, *freshservice.Response, error)
+	GetVendorFunc      func(ctx context.Context, id int) (*freshservice.Vendor, *freshservice.Response, error)
+	IterVendorsFunc    func(ctx context.Context, opt *freshservice.ListVendorsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Vendor) bool) error
+	ListAllVendorsFunc func(ctx context.Context, opt *freshservice.ListVendorsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Vendor, error)
+	ListVendorsFunc    func(ctx context.Context, opt *freshservice.ListVendorsOptions) (*freshservice.Vendors, *freshservice.Response, error)
+	UpdateVendorFunc   func(ctx context.Context, id int, vendor *freshservice.UpdateVendorModel) (*freshservice.Vendor, *freshservice.Response, error)
+}
+
+// CreateVendor calls CreateVendorFunc when set, otherwise it returns the canned response
+func (m *VendorsAPI) CreateVendor(ctx context.Context, newVendor *freshservice.CreateVendorModel) (*freshservice.Vendor, *freshservice.Response, error) {
+	m.record("CreateVendor", ctx, newVendor)
+	if m.CreateVendorFunc != nil {
+		return m.CreateVendorFunc(ctx, newVendor)
+	}
+	var r0 *freshservice.Vendor
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("CreateVendor", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// DeleteVendor calls DeleteVendorFunc when set, otherwise it returns the canned response
+func (m *VendorsAPI) DeleteVendor(ctx context.Context, id int) (bool, *freshservice.Response, error) {
+	m.record("DeleteVendor", ctx, id)
+	if m.DeleteVendorFunc != nil {
+		return m.DeleteVendorFunc(ctx, id)
+	}
+	var r0 bool
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("DeleteVendor", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// GetVendor calls GetVendorFunc when set, otherwise it returns the canned response
+func (m *VendorsAPI) GetVendor(ctx context.Context, id int) (*freshservice.Vendor, *freshservice.Response, error) {
+	m.record("GetVendor", ctx, id)
+	if m.GetVendorFunc != nil {
+		return m.GetVendorFunc(ctx, id)
+	}
+	var r0 *freshservice.Vendor
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("GetVendor", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// IterVendors calls IterVendorsFunc when set, otherwise it returns the canned response
+func (m *VendorsAPI) IterVendors(ctx context.Context, opt *freshservice.ListVendorsOptions, limit *freshservice.PaginationOptions, fn func(freshservice.Vendor) bool) error {
+	m.record("IterVendors", ctx, opt, limit, fn)
+	if m.IterVendorsFunc != nil {
+		return m.IterVendorsFunc(ctx, opt, limit, fn)
+	}
+	var r0 error
+	m.returns("IterVendors", &r0)
+	return r0
+}
+
+// ListAllVendors calls ListAllVendorsFunc when set, otherwise it returns the canned response
+func (m *VendorsAPI) ListAllVendors(ctx context.Context, opt *freshservice.ListVendorsOptions, limit *freshservice.PaginationOptions) ([]freshservice.Vendor, error) {
+	m.record("ListAllVendors", ctx, opt, limit)
+	if m.ListAllVendorsFunc != nil {
+		return m.ListAllVendorsFunc(ctx, opt, limit)
+	}
+	var r0 []freshservice.Vendor
+	var r1 error
+	m.returns("ListAllVendors", &r0, &r1)
+	return r0, r1
+}
+
+// ListVendors calls ListVendorsFunc when set, otherwise it returns the canned response
+func (m *VendorsAPI) ListVendors(ctx context.Context, opt *freshservice.ListVendorsOptions) (*freshservice.Vendors, *freshservice.Response, error) {
+	m.record("ListVendors", ctx, opt)
+	if m.ListVendorsFunc != nil {
+		return m.ListVendorsFunc(ctx, opt)
+	}
+	var r0 *freshservice.Vendors
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("ListVendors", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// UpdateVendor calls UpdateVendorFunc when set, otherwise it returns the canned response
+func (m *VendorsAPI) UpdateVendor(ctx context.Context, id int, vendor *freshservice.UpdateVendorModel) (*freshservice.Vendor, *freshservice.Response, error) {
+	m.record("UpdateVendor", ctx, id, vendor)
+	if m.UpdateVendorFunc != nil {
+		return m.UpdateVendorFunc(ctx, id, vendor)
+	}
+	var r0 *freshservice.Vendor
+	var r1 *freshservice.Response
+	var r2 error
+	m.returns("UpdateVendor", &r0, &r1, &r2)
+	return r0, r1, r2
+}
+
+// API holds a mock of every service
+type API struct {
+	Agents                 *AgentsAPI
+	Announcements          *AnnouncementsAPI
+	Assets                 *AssetsAPI
+	BusinessHours          *BusinessHoursAPI
+	Changes                *ChangesAPI
+	Contracts              *ContractsAPI
+	Departments            *DepartmentsAPI
+	Groups                 *GroupsAPI
+	Locations              *LocationsAPI
+	Problems               *ProblemsAPI
+	Products               *ProductsAPI
+	PurchaseOrders         *PurchaseOrdersAPI
+	Releases               *ReleasesAPI
+	Requesters             *RequestersAPI
+	RequesterGroups        *RequesterGroupsAPI
+	Services               *ServicesAPI
+	ServiceLevelAgreements *ServiceLevelAgreementsAPI
+	Software               *SoftwareAPI
+	Solutions              *SolutionsAPI
+	Tickets                *TicketsAPI
+	Vendors                *VendorsAPI
+}
+
+// New returns an API with a new mock for every service
+func New() *API {
+	return &API{
+		Agents:                 &AgentsAPI{},
+		Announcements:          &AnnouncementsAPI{},
+		Assets:                 &AssetsAPI{},
+		BusinessHours:          &BusinessHoursAPI{},
+		Changes:                &ChangesAPI{},
+		Contracts:              &ContractsAPI{},
+		Departments:            &DepartmentsAPI{},
+		Groups:                 &GroupsAPI{},
+		Locations:              &LocationsAPI{},
+		Problems:               &ProblemsAPI{},
+		Products:               &ProductsAPI{},
+		PurchaseOrders:         &PurchaseOrdersAPI{},
+		Releases:               &ReleasesAPI{},
+		Requesters:             &RequestersAPI{},
+		RequesterGroups:        &RequesterGroupsAPI{},
+		Services:               &ServicesAPI{},
+		ServiceLevelAgreements: &ServiceLevelAgreementsAPI{},
+		Software:               &SoftwareAPI{},
+		Solutions:              &SolutionsAPI{},
+		Tickets:                &TicketsAPI{},
+		Vendors:                &VendorsAPI{},
+	}
+}
+
+// API returns the mocks as a freshservice.API
+func (a *API) API() *freshservice.API {
+	return &freshservice.API{
+		Agents:                 a.Agents,
+		Announcements:          a.Announcements,
+		Assets:                 a.Assets,
+		BusinessHours:          a.BusinessHours,
+		Changes:                a.Changes,
+		Contracts:              a.Contracts,
+		Departments:            a.Departments,
+		Groups:                 a.Groups,
+		Locations:              a.Locations,
+		Problems:               a.Problems,
+		Products:               a.Products,
+		PurchaseOrders:         a.PurchaseOrders,
+		Releases:               a.Releases,
+		Requesters:             a.Requesters,
+		RequesterGroups:        a.RequesterGroups,
+		Services:               a.Services,
+		ServiceLevelAgreements: a.ServiceLevelAgreements,
+		Software:               a.Software,
+		Solutions:              a.Solutions,
+		Tickets:                a.Tickets,
+		Vendors:                a.Vendors,
+	}
+}
+
+var (
+	_ freshservice.AgentsAPI                 = (*AgentsAPI)(nil)
+	_ freshservice.AnnouncementsAPI          = (*AnnouncementsAPI)(nil)
+	_ freshservice.AssetsAPI                 = (*AssetsAPI)(nil)
+	_ freshservice.BusinessHoursAPI          = (*BusinessHoursAPI)(nil)
+	_ freshservice.ChangesAPI                = (*ChangesAPI)(nil)
+	_ freshservice.ContractsAPI              = (*ContractsAPI)(nil)
+	_ freshservice.DepartmentsAPI            = (*DepartmentsAPI)(nil)
+	_ freshservice.GroupsAPI                 = (*GroupsAPI)(nil)
+	_ freshservice.LocationsAPI              = (*LocationsAPI)(nil)
+	_ freshservice.ProblemsAPI               = (*ProblemsAPI)(nil)
+	_ freshservice.ProductsAPI               = (*ProductsAPI)(nil)
+	_ freshservice.PurchaseOrdersAPI         = (*PurchaseOrdersAPI)(nil)
+	_ freshservice.ReleasesAPI               = (*ReleasesAPI)(nil)
+	_ freshservice.RequestersAPI             = (*RequestersAPI)(nil)
+	_ freshservice.RequesterGroupsAPI        = (*RequesterGroupsAPI)(nil)
+	_ freshservice.ServicesAPI               = (*ServicesAPI)(nil)
+	_ freshservice.ServiceLevelAgreementsAPI = (*ServiceLevelAgreementsAPI)(nil)
+	_ freshservice.SoftwareAPI               = (*SoftwareAPI)(nil)
+	_ freshservice.SolutionsAPI              = (*SolutionsAPI)(nil)
+	_ freshservice.TicketsAPI                = (*TicketsAPI)(nil)
+	_ freshservice.VendorsAPI                = (*VendorsAPI)(nil)
+)
@@ -0,0 +1,502 @@
+package freshservicetest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+// Mode controls whether a Recorder replays a cassette or records a new one
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette only, requests which have not been recorded fail
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to FreshService, saving the exchanges to the cassette on Stop
+	ModeRecord
+	// ModeAuto replays the cassette when it exists and records it otherwise
+	ModeAuto
+)
+
+var (
+	// emailPattern matches email addresses, which are scrubbed from cassettes
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// placeholderPattern matches the placeholders email addresses are replaced with
+	placeholderPattern = regexp.MustCompile(`^user\d+@example\.com$`)
+)
+
+// recordedHeaders are the response headers kept in a cassette
+var recordedHeaders = []string{
+	"Content-Type",
+	"Link",
+	"Retry-After",
+	"X-Request-Id",
+	"X-RateLimit-Total",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Used-CurrentRequest",
+}
+
+// Cassette is the recorded set of exchanges with FreshService
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request used to match it during replay
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is the response replayed for a matching request, bodies which are not valid UTF-8 (e.g. downloaded
+// attachments) are kept in BodyBase64 instead of Body
+type RecordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+	BodyBase64 string              `json:"body_base64,omitempty"`
+}
+
+// body returns the recorded body
+func (res RecordedResponse) body() ([]byte, error) {
+	if res.BodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(res.BodyBase64)
+	}
+	return []byte(res.Body), nil
+}
+
+// Recorder is an http.RoundTripper which records exchanges with FreshService to a cassette file, or replays them.
+// The API key is never recorded and email addresses are replaced with placeholders numbered in the order they are first
+// seen (user1@example.com, user2@example.com, ...). When replaying, the addresses within a request are matched to the
+// placeholders at the same position of the recorded request, and keep that placeholder for the rest of the cassette.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+	// placeholders maps the (lower case) email addresses seen so far to their placeholder
+	placeholders map[string]string
+}
+
+// NewRecorder creates a Recorder for the cassette file at path, transport is used when recording
+// (http.DefaultTransport when nil)
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	r := &Recorder{path: path, mode: mode, transport: transport, placeholders: map[string]string{}}
+
+	if mode == ModeAuto {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+
+	if r.mode == ModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cassette: %v", err)
+		}
+		if err = json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("unable to read cassette %s: %v", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// UseCassette creates a Recorder for the test, saving the cassette when the test completes, and returns the
+// ClientOption to use it
+func UseCassette(t testing.TB, path string, mode Mode) freshservice.ClientOption {
+	t.Helper()
+
+	r, err := NewRecorder(path, mode, nil)
+	if err != nil {
+		t.Fatalf("unable to use cassette: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Errorf("unable to save cassette: %v", err)
+		}
+	})
+
+	return r.Option()
+}
+
+// Option returns the ClientOption for a Client to send its requests through the Recorder
+func (r *Recorder) Option() freshservice.ClientOption {
+	return freshservice.WithTransport(r)
+}
+
+// Recording reports whether the Recorder is recording rather than replaying
+func (r *Recorder) Recording() bool {
+	return r.mode == ModeRecord
+}
+
+// Stop saves the cassette when recording
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, out, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	res, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	headers := map[string][]string{}
+	for _, h := range recordedHeaders {
+		if v := res.Header.Values(h); len(v) > 0 {
+			headers[h] = r.scrubAll(v)
+		}
+	}
+
+	response := RecordedResponse{StatusCode: res.StatusCode, Headers: headers}
+	if utf8.Valid(body) {
+		response.Body = r.scrub(string(body))
+	} else {
+		response.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: recorded.Method,
+			Path:   r.scrub(recorded.Path),
+			Query:  r.scrubQuery(recorded.Query),
+			Body:   r.scrub(recorded.Body),
+		},
+		Response: response,
+	})
+
+	return res, nil
+}
+
+// replay returns the response of the first unused Interaction matching the request
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] {
+			continue
+		}
+		bound, ok := r.bind(recorded, in.Request)
+		if !ok {
+			continue
+		}
+		body, err := in.Response.body()
+		if err != nil {
+			return nil, fmt.Errorf("invalid body of recorded %s %s in cassette %s: %v", in.Request.Method, in.Request.Path, r.path, err)
+		}
+
+		r.used[i] = true
+		for email, placeholder := range bound {
+			r.placeholders[email] = placeholder
+		}
+
+		header := http.Header{}
+		for k, v := range in.Response.Headers {
+			header[http.CanonicalHeaderKey(k)] = v
+		}
+		// the wait was served when recording, there is no need to sit through it again
+		if header.Get("Retry-After") != "" {
+			header.Set("Retry-After", "0")
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction for %s %s in cassette %s", recorded.Method, recorded.Path, r.path)
+}
+
+// bind reports whether the request matches a recorded one, once the email addresses which have no placeholder yet are
+// given the placeholder at the same position in the recorded request. The new placeholders are returned.
+func (r *Recorder) bind(req RecordedRequest, recorded RecordedRequest) (map[string]string, bool) {
+	if req.Method != recorded.Method {
+		return nil, false
+	}
+
+	bound := map[string]string{}
+	taken := map[string]bool{}
+	for _, p := range r.placeholders {
+		taken[p] = true
+	}
+
+	pairs := [][2][]string{
+		{emailPattern.FindAllString(req.Path, -1), emailPattern.FindAllString(recorded.Path, -1)},
+		{queryEmails(req.Query), queryEmails(recorded.Query)},
+		{emailPattern.FindAllString(req.Body, -1), emailPattern.FindAllString(recorded.Body, -1)},
+	}
+	for _, pair := range pairs {
+		emails, placeholders := pair[0], pair[1]
+		if len(emails) != len(placeholders) {
+			return nil, false
+		}
+
+		for i, email := range emails {
+			key := strings.ToLower(email)
+			want := placeholders[i]
+			switch p, ok := r.placeholders[key]; {
+			case placeholderPattern.MatchString(email):
+			case ok:
+				if p != want {
+					return nil, false
+				}
+			case bound[key] != "":
+				if bound[key] != want {
+					return nil, false
+				}
+			default:
+				if taken[want] || !placeholderPattern.MatchString(want) {
+					return nil, false
+				}
+				bound[key] = want
+				taken[want] = true
+			}
+		}
+	}
+
+	replace := func(email string) string {
+		if p, ok := bound[strings.ToLower(email)]; ok {
+			return p
+		}
+		if p, ok := r.placeholders[strings.ToLower(email)]; ok {
+			return p
+		}
+		return email
+	}
+
+	scrubbed := RecordedRequest{
+		Method: req.Method,
+		Path:   emailPattern.ReplaceAllStringFunc(req.Path, replace),
+		Query:  replaceQuery(req.Query, replace),
+		Body:   emailPattern.ReplaceAllStringFunc(req.Body, replace),
+	}
+	if !matches(recorded, scrubbed) {
+		return nil, false
+	}
+	return bound, true
+}
+
+// recordRequest captures the method, path, query and body of a request, along with the request to send on. req is left
+// untouched: its body is read from GetBody when possible, otherwise the returned request is a clone of req with the
+// body which was read. Multipart bodies are not recorded as their boundaries differ on every request.
+func recordRequest(req *http.Request) (RecordedRequest, *http.Request, error) {
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return recorded, req, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if strings.HasPrefix(mediaType, "multipart/") {
+		return recorded, req, nil
+	}
+
+	out := req
+	var rc io.ReadCloser
+	if req.GetBody != nil {
+		var err error
+		if rc, err = req.GetBody(); err != nil {
+			return recorded, nil, fmt.Errorf("unable to read request body: %v", err)
+		}
+	} else {
+		rc = req.Body
+	}
+
+	body, err := ioutil.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		return recorded, nil, fmt.Errorf("unable to read request body: %v", err)
+	}
+	recorded.Body = string(body)
+
+	if req.GetBody == nil {
+		out = req.Clone(req.Context())
+		out.Body = ioutil.NopCloser(bytes.NewReader(body))
+		out.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	return recorded, out, nil
+}
+
+// matches compares requests by method, path, query and body (semantically when JSON)
+func matches(a RecordedRequest, b RecordedRequest) bool {
+	if a.Method != b.Method || a.Path != b.Path || !sameQuery(a.Query, b.Query) {
+		return false
+	}
+
+	if a.Body == b.Body {
+		return true
+	}
+
+	var ja, jb interface{}
+	if json.Unmarshal([]byte(a.Body), &ja) != nil || json.Unmarshal([]byte(b.Body), &jb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(ja, jb)
+}
+
+// sameQuery compares query strings regardless of the order of parameters
+func sameQuery(a string, b string) bool {
+	qa, errA := url.ParseQuery(a)
+	qb, errB := url.ParseQuery(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(qa, qb)
+}
+
+// scrub replaces the email addresses within s with placeholders, the same address always gets the same placeholder
+// so that requests can still be matched
+func (r *Recorder) scrub(s string) string {
+	return emailPattern.ReplaceAllStringFunc(s, r.placeholder)
+}
+
+func (r *Recorder) scrubAll(values []string) []string {
+	scrubbed := make([]string, 0, len(values))
+	for _, v := range values {
+		scrubbed = append(scrubbed, r.scrub(v))
+	}
+	return scrubbed
+}
+
+// scrubQuery scrubs the values of an encoded query string, in which the @ of an address is escaped
+func (r *Recorder) scrubQuery(query string) string {
+	return replaceQuery(query, r.placeholder)
+}
+
+// placeholder returns the placeholder of email, numbering a new one when the address has not been seen before
+func (r *Recorder) placeholder(email string) string {
+	if placeholderPattern.MatchString(email) {
+		return email
+	}
+
+	key := strings.ToLower(email)
+	p, ok := r.placeholders[key]
+	if !ok {
+		p = fmt.Sprintf("user%d@example.com", len(r.placeholders)+1)
+		r.placeholders[key] = p
+	}
+	return p
+}
+
+// replaceQuery replaces the email addresses within the values of an encoded query string
+func replaceQuery(query string, fn func(email string) string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return emailPattern.ReplaceAllStringFunc(query, fn)
+	}
+	for k, list := range values {
+		for i, v := range list {
+			list[i] = emailPattern.ReplaceAllStringFunc(v, fn)
+		}
+		values[k] = list
+	}
+	return values.Encode()
+}
+
+// queryEmails returns the email addresses within the values of an encoded query string, ordered by parameter
+func queryEmails(query string) []string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return emailPattern.FindAllString(query, -1)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var emails []string
+	for _, k := range keys {
+		for _, v := range values[k] {
+			emails = append(emails, emailPattern.FindAllString(v, -1)...)
+		}
+	}
+	return emails
+}
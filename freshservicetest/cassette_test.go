@@ -0,0 +1,243 @@
+package freshservicetest_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicetest"
+)
+
+// createRequesters creates a requester for each email and looks the first one up again by email
+func createRequesters(t *testing.T, fs *freshservice.Client, emails ...string) []freshservice.Requester {
+	t.Helper()
+
+	ctx := context.Background()
+	var created []freshservice.Requester
+	for _, email := range emails {
+		r, _, err := fs.Requesters.CreateRequester(ctx, &freshservice.CreateRequesterModel{FirstName: "Jane", Email: email})
+		if err != nil {
+			t.Fatalf("CreateRequester %s: %v", email, err)
+		}
+		created = append(created, *r)
+	}
+
+	if _, _, err := fs.Requesters.ListRequesters(ctx, &freshservice.ListRequestersOptions{Email: freshservice.String(emails[0])}); err != nil {
+		t.Fatalf("ListRequesters: %v", err)
+	}
+
+	return created
+}
+
+func TestRecorderReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requesters.json")
+
+	_, srv := freshservicetest.New(t)
+	rec, err := freshservicetest.NewRecorder(path, freshservicetest.ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	fs, err := srv.Client(rec.Option())
+	if err != nil {
+		t.Fatalf("Client: %v", err)
+	}
+	createRequesters(t, fs, "Jane.Doe@acme.test", "john@acme.test")
+	if err = rec.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read cassette: %v", err)
+	}
+	if bytes.Contains(bytes.ToLower(b), []byte("acme.test")) {
+		t.Errorf("cassette leaks email addresses: %s", b)
+	}
+	for _, p := range []string{"user1@example.com", "user2@example.com"} {
+		if !bytes.Contains(b, []byte(p)) {
+			t.Errorf("cassette has no placeholder %s", p)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		emails  []string
+		wantErr bool
+	}{
+		{name: "recorded emails", emails: []string{"jane.doe@acme.test", "john@acme.test"}},
+		{name: "other emails", emails: []string{"ann@corp.test", "bob@corp.test"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := freshservicetest.NewRecorder(path, freshservicetest.ModeReplay, nil)
+			if err != nil {
+				t.Fatalf("NewRecorder: %v", err)
+			}
+			// nothing listens on the base URL, every request must be replayed
+			fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL("http://127.0.0.1:1/api/v2"), rec.Option())
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			created := createRequesters(t, fs, tt.emails...)
+			if created[0].Email != "user1@example.com" || created[1].Email != "user2@example.com" {
+				t.Errorf("got emails %s and %s, want the placeholders", created[0].Email, created[1].Email)
+			}
+		})
+	}
+
+	t.Run("emails swapped", func(t *testing.T) {
+		rec, err := freshservicetest.NewRecorder(path, freshservicetest.ModeReplay, nil)
+		if err != nil {
+			t.Fatalf("NewRecorder: %v", err)
+		}
+		fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL("http://127.0.0.1:1/api/v2"), rec.Option())
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+
+		ctx := context.Background()
+		for _, email := range []string{"ann@corp.test", "bob@corp.test"} {
+			if _, _, err = fs.Requesters.CreateRequester(ctx, &freshservice.CreateRequesterModel{FirstName: "Jane", Email: email}); err != nil {
+				t.Fatalf("CreateRequester: %v", err)
+			}
+		}
+		// bob@corp.test took the place of user2@example.com, so it can't be used to look up user1@example.com
+		_, _, err = fs.Requesters.ListRequesters(ctx, &freshservice.ListRequestersOptions{Email: freshservice.String("bob@corp.test")})
+		if err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+			t.Errorf("got error %v, want no recorded interaction", err)
+		}
+	})
+}
+
+func TestRecorderReplaysRateLimitsWithoutWaiting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.json")
+	cassette := `{"interactions": [
+  {"request": {"method": "GET", "path": "/tickets/1"}, "response": {"status_code": 429, "headers": {"Retry-After": ["30"]}}},
+  {"request": {"method": "GET", "path": "/tickets/1"}, "response": {"status_code": 200, "body": "{\"ticket\": {\"id\": 1, \"subject\": \"Printer on fire\"}}"}}
+]}`
+	if err := ioutil.WriteFile(path, []byte(cassette), 0644); err != nil {
+		t.Fatalf("unable to write cassette: %v", err)
+	}
+
+	fs, err := freshservice.NewClient(nil, "", "key",
+		freshservice.WithBaseURL("http://127.0.0.1:1"),
+		freshservicetest.UseCassette(t, path, freshservicetest.ModeReplay),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	start := time.Now()
+	ticket, _, err := fs.Tickets.GetTicket(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetTicket: %v", err)
+	}
+	if ticket.Subject != "Printer on fire" {
+		t.Errorf("got subject %q, want the replayed ticket", ticket.Subject)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("replay took %s, the recorded Retry-After should not be waited for", elapsed)
+	}
+}
+
+func TestRecorderBinaryBody(t *testing.T) {
+	png := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+	var downloads int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&downloads, 1)
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(png)
+	}))
+	defer ts.Close()
+
+	attachment := &freshservice.TicketAttachment{Name: "logo.png", Size: len(png), ContentType: "image/png", AttachmentUrl: ts.URL + "/attachments/1"}
+	path := filepath.Join(t.TempDir(), "download.json")
+
+	for _, mode := range []freshservicetest.Mode{freshservicetest.ModeRecord, freshservicetest.ModeReplay} {
+		rec, err := freshservicetest.NewRecorder(path, mode, nil)
+		if err != nil {
+			t.Fatalf("NewRecorder: %v", err)
+		}
+		fs, err := freshservice.NewClient(nil, "", "key", freshservice.WithBaseURL(ts.URL), rec.Option())
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+
+		var buf bytes.Buffer
+		if _, err = fs.Tickets.DownloadAttachment(context.Background(), attachment, &buf, nil); err != nil {
+			t.Fatalf("mode %d: DownloadAttachment: %v", mode, err)
+		}
+		if !bytes.Equal(buf.Bytes(), png) {
+			t.Errorf("mode %d: got %x, want %x", mode, buf.Bytes(), png)
+		}
+		if err = rec.Stop(); err != nil {
+			t.Fatalf("Stop: %v", err)
+		}
+	}
+
+	if n := atomic.LoadInt32(&downloads); n != 1 {
+		t.Errorf("got %d downloads from the server, want 1 as the second is replayed", n)
+	}
+}
+
+func TestRecorderLeavesRequestUntouched(t *testing.T) {
+	var received string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received = string(b)
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name    string
+		getBody bool
+	}{
+		{name: "without GetBody"},
+		{name: "with GetBody", getBody: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := freshservicetest.NewRecorder(filepath.Join(t.TempDir(), "cassette.json"), freshservicetest.ModeRecord, nil)
+			if err != nil {
+				t.Fatalf("NewRecorder: %v", err)
+			}
+
+			body := ioutil.NopCloser(strings.NewReader(`{"subject": "Printer on fire"}`))
+			req, err := http.NewRequest(http.MethodPost, ts.URL+"/api/v2/tickets", body)
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			req.GetBody = nil
+			if tt.getBody {
+				req.GetBody = func() (io.ReadCloser, error) {
+					return ioutil.NopCloser(strings.NewReader(`{"subject": "Printer on fire"}`)), nil
+				}
+			}
+
+			res, err := rec.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip: %v", err)
+			}
+			res.Body.Close()
+
+			if req.Body != body {
+				t.Errorf("the body of the request was replaced")
+			}
+			if received != `{"subject": "Printer on fire"}` {
+				t.Errorf("server received %q", received)
+			}
+		})
+	}
+}
@@ -0,0 +1,484 @@
+package freshservicetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+// schema describes the resources of a collection, keyed by the collection path without ids (e.g. service_catalog/items)
+// or its last segment (e.g. tasks). Only the collections listed in schemas are emulated, requests to any other path are
+// answered with 501 Not Implemented.
+type schema struct {
+	// required fields on create, alternatives are separated by | (e.g. requester_id|email)
+	required []string
+	// choices restricts the values of numeric fields
+	choices map[string][]int
+	// trashable resources are marked as deleted rather than removed, and can be restored
+	trashable bool
+	// singular overrides the name used to wrap a single resource
+	singular string
+	// key overrides the name the resources are listed under
+	key string
+	// filters are the query parameters holding a filter query (e.g. "priority:4") which listed resources must match
+	filters []string
+	// readOnly collections can only be listed and read, their resources are added using Seed
+	readOnly bool
+}
+
+var schemas = map[string]schema{
+	"tickets": {
+		required:  []string{"subject", "description", "status", "priority", "requester_id|email|phone"},
+		choices:   map[string][]int{"status": {2, 3, 4, 5}, "priority": {1, 2, 3, 4}, "urgency": {1, 2, 3}, "impact": {1, 2, 3}},
+		trashable: true,
+	},
+	"changes": {
+		required:  []string{"subject", "description", "requester_id"},
+		choices:   map[string][]int{"status": {1, 2, 3, 4, 5, 6}, "priority": {1, 2, 3, 4}, "risk": {1, 2, 3, 4}, "change_type": {1, 2, 3, 4}},
+		trashable: true,
+	},
+	"problems": {
+		required:  []string{"subject", "description", "requester_id"},
+		choices:   map[string][]int{"status": {1, 2, 3}, "priority": {1, 2, 3, 4}, "impact": {1, 2, 3}},
+		trashable: true,
+	},
+	"releases": {
+		required:  []string{"subject", "description"},
+		choices:   map[string][]int{"status": {1, 2, 3, 4, 5}, "priority": {1, 2, 3, 4}, "release_type": {1, 2, 3, 4}},
+		trashable: true,
+	},
+	"assets":           {required: []string{"name", "asset_type_id"}, trashable: true, filters: []string{"search", "filter"}},
+	"agents":           {required: []string{"first_name", "email"}},
+	"requesters":       {required: []string{"first_name", "primary_email"}},
+	"tasks":            {required: []string{"title"}},
+	"time_entries":     {required: []string{"agent_id"}},
+	"notes":            {required: []string{"body"}},
+	"departments":      {required: []string{"name"}},
+	"locations":        {required: []string{"name"}},
+	"groups":           {required: []string{"name"}},
+	"requester_groups": {required: []string{"name"}},
+	"vendors":          {required: []string{"name"}},
+	"products":         {required: []string{"name", "asset_type_id"}},
+	"asset_types":      {required: []string{"name"}},
+	"announcements":    {required: []string{"title", "body_html"}},
+	"business_hours":   {singular: "business_hours"},
+	"relationships":    {required: []string{"relationship_type_id", "primary_id", "primary_type", "secondary_id", "secondary_type"}},
+
+	"roles":                      {},
+	"applications":               {},
+	"installations":              {},
+	"users":                      {key: "application_users"},
+	"components":                 {},
+	"contracts":                  {},
+	"contract_types":             {},
+	"associated_assets":          {},
+	"purchase_orders":            {},
+	"relationship_types":         {},
+	"activities":                 {},
+	"conversations":              {},
+	"sla_policies":               {},
+	"service_catalog/items":      {key: "service_items"},
+	"service_catalog/categories": {key: "service_categories"},
+	"categories":                 {},
+	"folders":                    {},
+	"articles":                   {},
+
+	"ticket_form_fields":  {key: "ticket_fields", readOnly: true},
+	"change_form_fields":  {key: "change_fields", readOnly: true},
+	"problem_form_fields": {key: "problem_fields", readOnly: true},
+	"release_form_fields": {key: "release_fields", readOnly: true},
+	"requester_fields":    {readOnly: true},
+}
+
+// schemaFor returns the schema of the collection at path, reporting whether it is emulated
+func schemaFor(path string) (schema, bool) {
+	var segments []string
+	for _, seg := range strings.Split(path, "/") {
+		if _, err := strconv.Atoi(seg); err != nil {
+			segments = append(segments, seg)
+		}
+	}
+
+	if sc, ok := schemas[strings.Join(segments, "/")]; ok {
+		return sc, true
+	}
+	sc, ok := schemas[segments[len(segments)-1]]
+	return sc, ok
+}
+
+// collection holds the resources at a path in memory
+type collection struct {
+	key      string
+	singular string
+	schema   schema
+	items    map[int]map[string]interface{}
+}
+
+// collection returns the collection at path, creating it when empty
+func (s *Server) collection(path string) *collection {
+	if c, ok := s.collections[path]; ok {
+		return c
+	}
+
+	key := path[strings.LastIndex(path, "/")+1:]
+	sc, _ := schemaFor(path)
+	if sc.key != "" {
+		key = sc.key
+	}
+
+	singular := sc.singular
+	if singular == "" {
+		singular = singularize(key)
+	}
+
+	c := &collection{key: key, singular: singular, schema: sc, items: map[int]map[string]interface{}{}}
+	s.collections[path] = c
+	return c
+}
+
+// singularize returns the name used for a single resource of a collection
+func singularize(key string) string {
+	switch {
+	case strings.HasSuffix(key, "ies"):
+		return strings.TrimSuffix(key, "ies") + "y"
+	case strings.HasSuffix(key, "s"):
+		return strings.TrimSuffix(key, "s")
+	}
+	return key
+}
+
+// add stores obj, assigning an id and timestamps where missing
+func (c *collection) add(s *Server, obj map[string]interface{}) int {
+	id := intValue(obj["id"])
+	if id == 0 {
+		s.nextID++
+		id = s.nextID
+	} else if id > s.nextID {
+		s.nextID = id
+	}
+
+	obj["id"] = id
+	if c.key == "assets" {
+		obj["display_id"] = id
+	}
+
+	now := s.now().UTC().Format(time.RFC3339)
+	for _, k := range []string{"created_at", "updated_at"} {
+		if v, ok := obj[k]; !ok || v == nil || v == "" {
+			obj[k] = now
+		}
+	}
+
+	c.items[id] = obj
+	return id
+}
+
+// update merges the fields into obj, null values clear the field and custom/type fields are merged individually
+func (c *collection) update(s *Server, obj map[string]interface{}, fields map[string]interface{}) {
+	for k, v := range fields {
+		if k == "id" || k == "display_id" || k == "created_at" {
+			continue
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok && (k == "custom_fields" || k == "type_fields") {
+			existing, _ := obj[k].(map[string]interface{})
+			if existing == nil {
+				existing = map[string]interface{}{}
+			}
+			for nk, nv := range nested {
+				existing[nk] = nv
+			}
+			obj[k] = existing
+			continue
+		}
+
+		obj[k] = v
+	}
+
+	obj["updated_at"] = s.now().UTC().Format(time.RFC3339)
+}
+
+// trash marks obj as deleted (or removes it when the collection is not trashable)
+func (c *collection) trash(id int) {
+	if c.schema.trashable {
+		c.items[id]["deleted"] = true
+		return
+	}
+	delete(c.items, id)
+}
+
+// visible reports whether the resource with id exists and has not been trashed
+func (c *collection) visible(id int) (map[string]interface{}, bool) {
+	obj, ok := c.items[id]
+	if !ok || obj["deleted"] == true {
+		return nil, false
+	}
+	return obj, true
+}
+
+// sorted returns the visible resources ordered by id, only those updated since when it is set
+func (c *collection) sorted(since time.Time) []map[string]interface{} {
+	ids := make([]int, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	items := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		obj, ok := c.visible(id)
+		if !ok {
+			continue
+		}
+		if !since.IsZero() {
+			updated, _ := time.Parse(time.RFC3339, fmt.Sprint(obj["updated_at"]))
+			if updated.Before(since) {
+				continue
+			}
+		}
+		items = append(items, obj)
+	}
+	return items
+}
+
+// validate checks the fields required to create a resource and the values of fields with fixed choices
+func (c *collection) validate(fields map[string]interface{}) []freshservice.FieldError {
+	errs := requireFields(fields, c.schema.required...)
+
+	keys := make([]string, 0, len(c.schema.choices))
+	for k := range c.schema.choices {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v, ok := fields[k]
+		if !ok || isBlank(v) {
+			continue
+		}
+		if !containsInt(c.schema.choices[k], intValue(v)) {
+			errs = append(errs, freshservice.FieldError{
+				Field:   k,
+				Message: fmt.Sprintf("It should be one of these values: '%s'", joinInts(c.schema.choices[k])),
+				Code:    "invalid_value",
+			})
+		}
+	}
+
+	return errs
+}
+
+// requireFields reports the required fields which are missing or blank
+func requireFields(fields map[string]interface{}, required ...string) []freshservice.FieldError {
+	var errs []freshservice.FieldError
+	for _, r := range required {
+		alternatives := strings.Split(r, "|")
+		found := false
+		for _, a := range alternatives {
+			if !isBlank(fields[a]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, freshservice.FieldError{Field: alternatives[0], Message: "It should not be blank", Code: "missing_field"})
+		}
+	}
+	return errs
+}
+
+// requestBody is the decoded body of a request, either JSON or multipart/form-data
+type requestBody struct {
+	raw    []byte
+	fields map[string]interface{}
+}
+
+// object returns a copy of the fields, unwrapping them when they are nested under the singular name
+func (b *requestBody) object(singular string) map[string]interface{} {
+	fields := b.fields
+	if nested, ok := fields[singular].(map[string]interface{}); ok && singular != "" && len(fields) == 1 {
+		fields = nested
+	}
+
+	obj := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		obj[k] = v
+	}
+	return obj
+}
+
+// readBody decodes the body of a request
+func readBody(r *http.Request) (*requestBody, error) {
+	b := &requestBody{fields: map[string]interface{}{}}
+	if r.Body == nil {
+		return b, nil
+	}
+
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		return b, readMultipart(multipart.NewReader(r.Body, params["boundary"]), b)
+	}
+
+	raw, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	b.raw = raw
+
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return b, nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err = d.Decode(&b.fields); err != nil {
+		return nil, fmt.Errorf("invalid JSON body: %v", err)
+	}
+
+	return b, nil
+}
+
+// readMultipart reads the form fields (expanding the name[] and name[key] forms) and attachments of a multipart body
+func readMultipart(mr *multipart.Reader, b *requestBody) error {
+	var attachments []interface{}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid multipart body: %v", err)
+		}
+
+		data, err := ioutil.ReadAll(part)
+		if err != nil {
+			return fmt.Errorf("invalid multipart body: %v", err)
+		}
+
+		name := part.FormName()
+		if part.FileName() != "" {
+			attachments = append(attachments, map[string]interface{}{
+				"name":         part.FileName(),
+				"content_type": part.Header.Get("Content-Type"),
+				"size":         len(data),
+			})
+			continue
+		}
+
+		value := formValue(string(data))
+		switch {
+		case strings.HasSuffix(name, "[]"):
+			key := strings.TrimSuffix(name, "[]")
+			list, _ := b.fields[key].([]interface{})
+			b.fields[key] = append(list, value)
+		case strings.HasSuffix(name, "]") && strings.Contains(name, "["):
+			i := strings.Index(name, "[")
+			key, nested := name[:i], strings.TrimSuffix(name[i+1:], "]")
+			m, _ := b.fields[key].(map[string]interface{})
+			if m == nil {
+				m = map[string]interface{}{}
+			}
+			m[nested] = value
+			b.fields[key] = m
+		default:
+			b.fields[name] = value
+		}
+	}
+
+	if attachments != nil {
+		b.fields["attachments"] = attachments
+	}
+	return nil
+}
+
+// formValue converts form values back to the JSON types they were flattened from where possible
+func formValue(s string) interface{} {
+	if s == "true" || s == "false" {
+		return s == "true"
+	}
+	if n, err := strconv.Atoi(s); err == nil && strconv.Itoa(n) == s {
+		return json.Number(s)
+	}
+	return s
+}
+
+// toObject converts v into its JSON object form
+func toObject(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]interface{}{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err = d.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("%T is not a JSON object: %v", v, err)
+	}
+	return obj, nil
+}
+
+// fromObject decodes obj into v
+func fromObject(obj map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func isBlank(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(val) == ""
+	case json.Number:
+		return val.String() == "0"
+	}
+	return false
+}
+
+func intValue(v interface{}) int {
+	switch n := v.(type) {
+	case json.Number:
+		i, _ := n.Int64()
+		return int(i)
+	case int:
+		return n
+	case float64:
+		return int(n)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	}
+	return 0
+}
+
+func containsInt(values []int, v int) bool {
+	for _, i := range values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func joinInts(values []int) string {
+	s := make([]string, 0, len(values))
+	for _, i := range values {
+		s = append(s, strconv.Itoa(i))
+	}
+	return strings.Join(s, ",")
+}
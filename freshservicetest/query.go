@@ -0,0 +1,271 @@
+package freshservicetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// queryDate matches the date values of filter queries e.g. '2021-07-01'
+var queryDate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// filter is a parsed filter query (e.g. "priority:4 AND (status:2 OR status:3)") as sent to the filter and search
+// endpoints, AND binds more tightly than OR
+type filter interface {
+	match(obj map[string]interface{}) bool
+}
+
+type filterAnd []filter
+
+func (f filterAnd) match(obj map[string]interface{}) bool {
+	for _, c := range f {
+		if !c.match(obj) {
+			return false
+		}
+	}
+	return true
+}
+
+type filterOr []filter
+
+func (f filterOr) match(obj map[string]interface{}) bool {
+	for _, c := range f {
+		if c.match(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterCondition compares a field with a value using : (equals), :> (at least) or :< (at most)
+type filterCondition struct {
+	field string
+	op    string
+	value interface{}
+}
+
+func (c filterCondition) match(obj map[string]interface{}) bool {
+	v := fieldValue(obj, c.field)
+	if list, ok := v.([]interface{}); ok {
+		for _, item := range list {
+			if compareValue(item, c.op, c.value) {
+				return true
+			}
+		}
+		return false
+	}
+	return compareValue(v, c.op, c.value)
+}
+
+// fieldValue returns the value of field from obj, falling back to its custom_fields and type_fields
+func fieldValue(obj map[string]interface{}, field string) interface{} {
+	if v, ok := obj[field]; ok {
+		return v
+	}
+	for _, k := range []string{"custom_fields", "type_fields"} {
+		if nested, ok := obj[k].(map[string]interface{}); ok {
+			if v, ok := nested[field]; ok {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// compareValue compares the value of a field with the value of a condition
+func compareValue(v interface{}, op string, want interface{}) bool {
+	switch w := want.(type) {
+	case nil:
+		return op == ":" && isBlank(v)
+	case bool:
+		b, ok := v.(bool)
+		return ok && op == ":" && b == w
+	case float64:
+		n, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil {
+			return false
+		}
+		return compareOrdered(op, n == w, n > w)
+	case string:
+		s := fmt.Sprint(v)
+		if queryDate.MatchString(w) {
+			if t, err := time.Parse(time.RFC3339, s); err == nil {
+				s = t.UTC().Format("2006-01-02")
+			}
+			return compareOrdered(op, s == w, s > w)
+		}
+		return compareOrdered(op, strings.EqualFold(s, w), strings.ToLower(s) > strings.ToLower(w))
+	}
+	return false
+}
+
+// compareOrdered applies op given whether the values are equal and whether the field is greater, :> and :< are inclusive
+func compareOrdered(op string, equal bool, greater bool) bool {
+	switch op {
+	case ":>":
+		return equal || greater
+	case ":<":
+		return equal || !greater
+	}
+	return equal
+}
+
+// parseFilter parses a filter query, which may be surrounded by double quotes
+func parseFilter(query string) (filter, error) {
+	query = strings.TrimSpace(query)
+	if len(query) >= 2 && strings.HasPrefix(query, `"`) && strings.HasSuffix(query, `"`) {
+		query = query[1 : len(query)-1]
+	}
+
+	p := &filterParser{s: query}
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	p.space()
+	if p.pos < len(p.s) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.s[p.pos:], p.pos)
+	}
+	return f, nil
+}
+
+// filterParser is a recursive descent parser of filter queries
+type filterParser struct {
+	s   string
+	pos int
+}
+
+func (p *filterParser) or() (filter, error) {
+	var f filterOr
+	for {
+		c, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		f = append(f, c)
+		if !p.keyword("OR") {
+			break
+		}
+	}
+	if len(f) == 1 {
+		return f[0], nil
+	}
+	return f, nil
+}
+
+func (p *filterParser) and() (filter, error) {
+	var f filterAnd
+	for {
+		c, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		f = append(f, c)
+		if !p.keyword("AND") {
+			break
+		}
+	}
+	if len(f) == 1 {
+		return f[0], nil
+	}
+	return f, nil
+}
+
+func (p *filterParser) term() (filter, error) {
+	p.space()
+	if p.pos < len(p.s) && p.s[p.pos] == '(' {
+		p.pos++
+		f, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		p.space()
+		if p.pos >= len(p.s) || p.s[p.pos] != ')' {
+			return nil, fmt.Errorf("missing ) at position %d", p.pos)
+		}
+		p.pos++
+		return f, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) && isFieldChar(p.s[p.pos]) {
+		p.pos++
+	}
+	field := p.s[start:p.pos]
+	if field == "" {
+		return nil, fmt.Errorf("expected a field at position %d", start)
+	}
+
+	if p.pos >= len(p.s) || p.s[p.pos] != ':' {
+		return nil, fmt.Errorf("expected : after %s", field)
+	}
+	op := ":"
+	p.pos++
+	if p.pos < len(p.s) && (p.s[p.pos] == '>' || p.s[p.pos] == '<') {
+		op += string(p.s[p.pos])
+		p.pos++
+	}
+
+	value, err := p.value()
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s: %v", field, err)
+	}
+	return filterCondition{field: field, op: op, value: value}, nil
+}
+
+// value reads a quoted string, a number, true, false or null
+func (p *filterParser) value() (interface{}, error) {
+	if p.pos < len(p.s) && p.s[p.pos] == '\'' {
+		end := strings.IndexByte(p.s[p.pos+1:], '\'')
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated string")
+		}
+		v := p.s[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return v, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] != ' ' && p.s[p.pos] != ')' {
+		p.pos++
+	}
+	raw := p.s[start:p.pos]
+
+	switch raw {
+	case "":
+		return nil, fmt.Errorf("missing value")
+	case "null":
+		return nil, nil
+	case "true", "false":
+		return raw == "true", nil
+	}
+
+	var n float64
+	if err := json.Unmarshal([]byte(raw), &n); err != nil {
+		return nil, fmt.Errorf("%q is not a number, strings must be quoted", raw)
+	}
+	return n, nil
+}
+
+// keyword consumes the keyword (surrounded by spaces) when it is next
+func (p *filterParser) keyword(k string) bool {
+	p.space()
+	if strings.HasPrefix(p.s[p.pos:], k+" ") || strings.HasPrefix(p.s[p.pos:], k+"(") {
+		p.pos += len(k)
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) space() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func isFieldChar(c byte) bool {
+	return c == '_' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,64 @@
+package freshservicetest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	obj := map[string]interface{}{
+		"priority":      json.Number("4"),
+		"status":        json.Number("2"),
+		"name":          "Laptop",
+		"tags":          []interface{}{"printer", "vip"},
+		"agent_id":      nil,
+		"is_escalated":  true,
+		"due_by":        "2021-07-01T15:04:05Z",
+		"custom_fields": map[string]interface{}{"team": "Ops"},
+	}
+
+	tests := []struct {
+		query   string
+		want    bool
+		wantErr bool
+	}{
+		{query: `"priority:4"`, want: true},
+		{query: `priority:3`, want: false},
+		{query: `priority:>3 AND priority:<4`, want: true},
+		{query: `name:'laptop'`, want: true},
+		{query: `tags:'vip'`, want: true},
+		{query: `tags:'office'`, want: false},
+		{query: `agent_id:null`, want: true},
+		{query: `is_escalated:true`, want: true},
+		{query: `due_by:>'2021-07-01'`, want: true},
+		{query: `due_by:<'2021-06-30'`, want: false},
+		{query: `team:'Ops'`, want: true},
+		{query: `priority:1 OR status:2`, want: true},
+		{query: `priority:1 OR status:2 AND name:'Desk'`, want: false},
+		{query: `(priority:1 OR status:2) AND name:'Laptop'`, want: true},
+		{query: `priority:4 AND (status:3 OR (status:2 AND tags:'vip'))`, want: true},
+		{query: `name:Laptop`, wantErr: true},
+		{query: `priority`, wantErr: true},
+		{query: `(priority:4`, wantErr: true},
+		{query: `priority:4 status:2`, wantErr: true},
+		{query: `name:'Laptop`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			f, err := parseFilter(tt.query)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parsed an invalid query")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseFilter: %v", err)
+			}
+			if got := f.match(obj); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,669 @@
+// Package freshservicetest provides an in-process fake FreshService API for testing code which uses the freshservice
+// package. The fake keeps resources in memory, checks the API key, paginates using Link headers, validates required
+// fields and can be told to rate limit or fail requests. Filter queries (tickets/filter and the search/filter of assets)
+// are evaluated, relationship jobs complete immediately and form fields are served from the resources given to Seed.
+// Endpoints which are not emulated respond with 501 Not Implemented rather than pretending to succeed.
+package freshservicetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+const (
+	// APIKey is the API key accepted by the Server unless WithAPIKey is used
+	APIKey = "freshservicetest"
+
+	// apiPath is the path the API is served under
+	apiPath = "/api/v2/"
+
+	defaultPerPage = 30
+	maxPerPage     = 100
+
+	// filterPerPage and filterMaxPages are the fixed page size and last page of the filter endpoints
+	filterPerPage  = 30
+	filterMaxPages = 10
+)
+
+// Server is a fake FreshService API, create one with NewServer (or New from a test)
+type Server struct {
+	// URL is the base URL of the API (including /api/v2/)
+	URL string
+
+	apiKey    string
+	rateLimit int
+	now       func() time.Time
+	srv       *httptest.Server
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	nextID      int
+	used        int
+	window      time.Time
+	failures    []failure
+	requests    []Request
+	jobs        map[string]map[string]interface{}
+}
+
+// Request is a record of a request received by the Server
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Body   []byte
+}
+
+// failure is a response injected using RateLimitNext or FailNext
+type failure struct {
+	status     int
+	retryAfter int
+}
+
+// Option configures the Server
+type Option func(*Server)
+
+// WithAPIKey sets the API key the Server accepts
+func WithAPIKey(key string) Option {
+	return func(s *Server) {
+		s.apiKey = key
+	}
+}
+
+// WithRateLimit sets the per-minute limit reported through the X-RateLimit-* headers, requests beyond it are rejected
+// with 429 until the minute is up (default 50000)
+func WithRateLimit(perMinute int) Option {
+	return func(s *Server) {
+		s.rateLimit = perMinute
+	}
+}
+
+// WithClock sets the source of the created_at / updated_at times given to resources
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// NewServer starts a new Server, which must be closed using Close
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		apiKey:      APIKey,
+		rateLimit:   50000,
+		now:         time.Now,
+		collections: map[string]*collection{},
+		jobs:        map[string]map[string]interface{}{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL + apiPath
+	return s
+}
+
+// New starts a Server which is closed at the end of the test, returning it with a Client configured to use it
+func New(t testing.TB, opts ...Option) (*freshservice.Client, *Server) {
+	t.Helper()
+
+	s := NewServer(opts...)
+	t.Cleanup(s.Close)
+
+	c, err := s.Client()
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+
+	return c, s
+}
+
+// Close shuts down the Server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a freshservice.Client configured to use the Server, retrying quickly so that injected failures do
+// not slow tests down. opts are applied after the defaults.
+func (s *Server) Client(opts ...freshservice.ClientOption) (*freshservice.Client, error) {
+	defaults := []freshservice.ClientOption{
+		freshservice.WithBaseURL(s.URL),
+		freshservice.WithHTTPClient(s.srv.Client()),
+		freshservice.WithRetryPolicy(3, time.Millisecond, 10*time.Millisecond),
+	}
+
+	return freshservice.NewClient(nil, "", s.apiKey, append(defaults, opts...)...)
+}
+
+// RateLimitNext makes the next n requests fail with 429 Too Many Requests, with the given Retry-After
+func (s *Server) RateLimitNext(n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < n; i++ {
+		s.failures = append(s.failures, failure{status: http.StatusTooManyRequests, retryAfter: int(retryAfter.Seconds())})
+	}
+}
+
+// FailNext makes the next n requests fail with status (e.g. 500 or 503)
+func (s *Server) FailNext(n int, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < n; i++ {
+		s.failures = append(s.failures, failure{status: status})
+	}
+}
+
+// Requests returns the requests received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// Seed adds v (e.g. a freshservice.Ticket) to the resources at path (e.g. "tickets" or "tickets/1/tasks") without
+// validation, returning its id. An id is assigned unless v has one, as are any missing created_at / updated_at times.
+func (s *Server) Seed(path string, v interface{}) (int, error) {
+	path = strings.Trim(path, "/")
+	if _, ok := schemaFor(path); !ok {
+		return 0, fmt.Errorf("%s is not emulated by freshservicetest", path)
+	}
+
+	obj, err := toObject(v)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.collection(path).add(s, obj), nil
+}
+
+// Get decodes the resource at path with id into v (e.g. a *freshservice.Ticket), reporting whether it exists
+func (s *Server) Get(path string, id int, v interface{}) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collection(strings.Trim(path, "/")).visible(id)
+	if !ok {
+		return false, nil
+	}
+
+	return true, fromObject(obj, v)
+}
+
+// Count returns the number of (untrashed) resources at path
+func (s *Server) Count(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.collection(strings.Trim(path, "/")).sorted(time.Time{}))
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Body: body.raw})
+
+	if key, _, ok := r.BasicAuth(); !ok || key != s.apiKey {
+		writeError(w, http.StatusUnauthorized, "invalid_credentials", "You have to be logged in to perform this action.", nil)
+		return
+	}
+
+	if !s.allow(w) {
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, apiPath) {
+		writeError(w, http.StatusNotFound, "not_found", "Resource not found", nil)
+		return
+	}
+
+	s.route(w, r, strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, apiPath), "/"), "/"), body)
+}
+
+// allow applies the rate limit and any injected failures, writing the rate-limit headers
+func (s *Server) allow(w http.ResponseWriter) bool {
+	now := s.now()
+	if now.Sub(s.window) >= time.Minute {
+		s.window = now
+		s.used = 0
+	}
+	s.used++
+
+	remaining := s.rateLimit - s.used
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	w.Header().Set("X-RateLimit-Total", strconv.Itoa(s.rateLimit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	w.Header().Set("X-RateLimit-Used-CurrentRequest", "1")
+
+	if s.used > s.rateLimit {
+		w.Header().Set("Retry-After", strconv.Itoa(int(time.Minute-now.Sub(s.window))/int(time.Second)))
+		writeError(w, http.StatusTooManyRequests, "rate_limited", "You have exceeded the limit of requests per minute", nil)
+		return false
+	}
+
+	if len(s.failures) > 0 {
+		f := s.failures[0]
+		s.failures = s.failures[1:]
+		if f.status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", strconv.Itoa(f.retryAfter))
+		}
+		writeError(w, f.status, "injected", http.StatusText(f.status), nil)
+		return false
+	}
+
+	return true
+}
+
+// route dispatches the request, paths are made up of collections and ids e.g. tickets/1/tasks/2
+func (s *Server) route(w http.ResponseWriter, r *http.Request, segments []string, body *requestBody) {
+	path, id, action := parsePath(segments)
+
+	// conversations are created through the reply and notes actions of a ticket, but updated/deleted directly
+	if path == "conversations" {
+		if p, ok := s.conversationPath(id); ok {
+			path = p
+		}
+	}
+
+	switch {
+	case path == "tickets/filter" && r.Method == http.MethodGet:
+		s.filter(w, r, s.collection("tickets"))
+	case path == "relationships/bulk-create" && r.Method == http.MethodPost:
+		s.createRelationships(w, body)
+	case strings.HasPrefix(path, "jobs/") && r.Method == http.MethodGet:
+		s.job(w, strings.TrimPrefix(path, "jobs/"))
+	case path == "assets" && action == "relationships" && r.Method == http.MethodGet:
+		s.list(w, r, s.collection("relationships"), func(obj map[string]interface{}) bool {
+			return intValue(obj["primary_id"]) == id || intValue(obj["secondary_id"]) == id
+		})
+	case action == "reply" || (action == "notes" && strings.HasPrefix(path, "tickets")):
+		s.createConversation(w, r, path, id, action, body)
+	case action == "restore" && r.Method == http.MethodPut:
+		s.restore(w, path, id)
+	case action != "":
+		s.handle(w, r, fmt.Sprintf("%s/%d/%s", path, id, action), 0, body)
+	default:
+		s.handle(w, r, path, id, body)
+	}
+}
+
+// handle serves the CRUD endpoints of a collection
+func (s *Server) handle(w http.ResponseWriter, r *http.Request, path string, id int, body *requestBody) {
+	if _, ok := schemaFor(path); !ok {
+		writeError(w, http.StatusNotImplemented, "not_implemented", fmt.Sprintf("%s %s is not emulated by freshservicetest", r.Method, path), nil)
+		return
+	}
+	c := s.collection(path)
+
+	if c.schema.readOnly && r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not supported for %s", r.Method, path), nil)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && id == 0:
+		s.list(w, r, c, nil)
+	case r.Method == http.MethodGet:
+		obj, ok := c.visible(id)
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{c.singular: obj})
+	case r.Method == http.MethodPost && id == 0:
+		if errs := c.validate(body.fields); len(errs) > 0 {
+			writeError(w, http.StatusBadRequest, "invalid_value", "Validation failed", errs)
+			return
+		}
+		obj := body.object(c.singular)
+		obj["id"] = 0
+		c.add(s, obj)
+		writeJSON(w, http.StatusCreated, map[string]interface{}{c.singular: obj})
+	case r.Method == http.MethodPut && id != 0:
+		obj, ok := c.visible(id)
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		c.update(s, obj, body.object(c.singular))
+		writeJSON(w, http.StatusOK, map[string]interface{}{c.singular: obj})
+	case r.Method == http.MethodDelete && id != 0:
+		if _, ok := c.visible(id); !ok {
+			writeNotFound(w)
+			return
+		}
+		c.trash(id)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && r.URL.Query().Get("ids") != "":
+		ids := queryIDs(r.URL.Query().Get("ids"))
+		for _, id := range ids {
+			if _, ok := c.visible(id); ok {
+				c.trash(id)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not supported for %s", r.Method, path), nil)
+	}
+}
+
+// list writes a page of the collection, along with a Link header when there is a next page. The resources are
+// restricted to the ids parameter, the filter queries of the collection and match when set.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, c *collection, match func(obj map[string]interface{}) bool) {
+	q := r.URL.Query()
+
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(q.Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	var since time.Time
+	if v := q.Get("updated_since"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_value", "updated_since must be RFC3339", nil)
+			return
+		}
+		since = t
+	}
+
+	items := c.sorted(since)
+
+	if ids := q.Get("ids"); ids != "" {
+		items = filterItems(items, func(obj map[string]interface{}) bool {
+			return containsInt(queryIDs(ids), intValue(obj["id"]))
+		})
+	}
+	for _, param := range c.schema.filters {
+		if query := q.Get(param); query != "" {
+			f, err := parseFilter(query)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "invalid_value", fmt.Sprintf("invalid %s: %v", param, err), nil)
+				return
+			}
+			items = filterItems(items, f.match)
+		}
+	}
+	if match != nil {
+		items = filterItems(items, match)
+	}
+
+	start := (page - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	if end < len(items) {
+		next := *r.URL
+		nq := next.Query()
+		nq.Set("page", strconv.Itoa(page+1))
+		nq.Set("per_page", strconv.Itoa(perPage))
+		next.RawQuery = nq.Encode()
+		w.Header().Set("Link", fmt.Sprintf("<%s%s>; rel=\"next\"", s.srv.URL, next.String()))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{c.key: items[start:end]})
+}
+
+// filter serves the filter endpoint of a collection (e.g. tickets/filter), which returns fixed pages of 30 resources
+// along with the total number of matches instead of a Link header, up to page 10
+func (s *Server) filter(w http.ResponseWriter, r *http.Request, c *collection) {
+	q := r.URL.Query()
+
+	f, err := parseFilter(q.Get("query"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_value", fmt.Sprintf("invalid query: %v", err), nil)
+		return
+	}
+
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	if page > filterMaxPages {
+		writeError(w, http.StatusBadRequest, "invalid_value", fmt.Sprintf("page must be at most %d", filterMaxPages), nil)
+		return
+	}
+
+	items := filterItems(c.sorted(time.Time{}), f.match)
+
+	start := (page - 1) * filterPerPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + filterPerPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{c.key: items[start:end], "total": len(items)})
+}
+
+// createRelationships handles the bulk creation of relationships, the job completes immediately and its results are
+// served by job
+func (s *Server) createRelationships(w http.ResponseWriter, body *requestBody) {
+	list, ok := body.fields["relationships"].([]interface{})
+	if !ok || len(list) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid_value", "Validation failed", requireFields(body.fields, "relationships"))
+		return
+	}
+
+	c := s.collection("relationships")
+	results := make([]map[string]interface{}, 0, len(list))
+	succeeded := 0
+	for _, item := range list {
+		fields, _ := item.(map[string]interface{})
+		if fields == nil {
+			fields = map[string]interface{}{}
+		}
+
+		result := make(map[string]interface{}, len(fields)+2)
+		for k, v := range fields {
+			result[k] = v
+		}
+
+		if errs := c.validate(fields); len(errs) > 0 {
+			result["success"] = false
+			result["errors"] = errs
+		} else {
+			obj := (&requestBody{fields: fields}).object("")
+			obj["id"] = 0
+			result["id"] = c.add(s, obj)
+			result["success"] = true
+			succeeded++
+		}
+		results = append(results, result)
+	}
+
+	status := freshservice.JobStatusSuccess
+	switch {
+	case succeeded == 0:
+		status = freshservice.JobStatusFailed
+	case succeeded < len(results):
+		status = freshservice.JobStatusPartial
+	}
+
+	id := fmt.Sprintf("job-%d", len(s.jobs)+1)
+	now := s.now().UTC().Format(time.RFC3339)
+	s.jobs[id] = map[string]interface{}{
+		"job_id":         id,
+		"status":         status,
+		"operation_name": "Create Relationships in bulk",
+		"relationships":  results,
+		"created_at":     now,
+		"updated_at":     now,
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"job_id": id, "href": s.URL + "jobs/" + id})
+}
+
+// job serves the state of a relationship job
+func (s *Server) job(w http.ResponseWriter, id string) {
+	job, ok := s.jobs[id]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, job)
+}
+
+// createConversation handles the reply and notes actions of a Ticket
+func (s *Server) createConversation(w http.ResponseWriter, r *http.Request, path string, id int, action string, body *requestBody) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not supported for %s", r.Method, action), nil)
+		return
+	}
+
+	if _, ok := s.collection(path).visible(id); !ok {
+		writeNotFound(w)
+		return
+	}
+
+	if errs := requireFields(body.fields, "body"); len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, "invalid_value", "Validation failed", errs)
+		return
+	}
+
+	obj := body.object("")
+	obj["id"] = 0
+	obj["ticket_id"] = id
+	if action == "notes" {
+		obj["source"] = 2
+		if _, ok := obj["private"]; !ok {
+			obj["private"] = true
+		}
+	} else {
+		obj["source"] = 0
+	}
+
+	s.collection(fmt.Sprintf("%s/%d/conversations", path, id)).add(s, obj)
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"conversation": obj})
+}
+
+// conversationPath finds the ticket conversations collection containing the conversation id
+func (s *Server) conversationPath(id int) (string, bool) {
+	for path, c := range s.collections {
+		if strings.HasSuffix(path, "/conversations") {
+			if _, ok := c.items[id]; ok {
+				return path, true
+			}
+		}
+	}
+	return "", false
+}
+
+// restore handles the restore action of trashable resources
+func (s *Server) restore(w http.ResponseWriter, path string, id int) {
+	obj, ok := s.collection(path).items[id]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(obj, "deleted")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parsePath splits a path into the collection, the id within it and any trailing action e.g.
+// tickets/1/tasks/2 is (tickets/1/tasks, 2, "") and tickets/1/reply is (tickets, 1, reply)
+func parsePath(segments []string) (string, int, string) {
+	var path []string
+	id := 0
+	for i, seg := range segments {
+		n, err := strconv.Atoi(seg)
+		if err != nil || n <= 0 {
+			if id != 0 && i == len(segments)-1 {
+				return strings.Join(path, "/"), id, seg
+			}
+			if id != 0 {
+				path = append(path, strconv.Itoa(id))
+				id = 0
+			}
+			path = append(path, seg)
+			continue
+		}
+		id = n
+	}
+	return strings.Join(path, "/"), id, ""
+}
+
+// filterItems returns the items matching match
+func filterItems(items []map[string]interface{}, match func(obj map[string]interface{}) bool) []map[string]interface{} {
+	matched := make([]map[string]interface{}, 0, len(items))
+	for _, obj := range items {
+		if match(obj) {
+			matched = append(matched, obj)
+		}
+	}
+	return matched
+}
+
+// queryIDs parses a comma separated list of ids
+func queryIDs(s string) []int {
+	var ids []int
+	for _, v := range strings.Split(s, ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// writeJSON writes v as the JSON response body
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the format used by FreshService
+func writeError(w http.ResponseWriter, status int, code string, description string, errs []freshservice.FieldError) {
+	if errs == nil {
+		errs = []freshservice.FieldError{}
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"code":        code,
+		"description": description,
+		"errors":      errs,
+	})
+}
+
+// writeNotFound writes the response for a resource which does not exist
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "not_found", "Record not found", nil)
+}
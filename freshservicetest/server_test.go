@@ -0,0 +1,173 @@
+package freshservicetest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/freshservicetest"
+)
+
+// do sends a raw request to the Server, so its responses are seen without the retries of the Client
+func do(t *testing.T, srv *freshservicetest.Server, method string, path string, body string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.SetBasicAuth(freshservicetest.APIKey, "X")
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	res.Body.Close()
+	return res
+}
+
+func TestServerCRUD(t *testing.T) {
+	fs, srv := freshservicetest.New(t)
+	ctx := context.Background()
+
+	created, _, err := fs.Tickets.CreateTicket(ctx, &freshservice.CreateTicketModel{
+		Subject:     "Printer on fire",
+		Description: "Smoke is coming out of the printer",
+		Email:       "jane@acme.test",
+		Priority:    freshservice.PriorityLow,
+		Status:      freshservice.TicketOpen,
+	})
+	if err != nil {
+		t.Fatalf("CreateTicket: %v", err)
+	}
+
+	updated, _, err := fs.Tickets.UpdateTicket(ctx, created.ID, &freshservice.UpdateTicketModel{Priority: freshservice.PriorityUrgent.Ptr()})
+	if err != nil {
+		t.Fatalf("UpdateTicket: %v", err)
+	}
+	if updated.Priority != freshservice.PriorityUrgent || updated.Subject != "Printer on fire" {
+		t.Errorf("got ticket %+v, want the priority updated and the subject kept", updated)
+	}
+
+	var stored freshservice.Ticket
+	if ok, err := srv.Get("tickets", created.ID, &stored); !ok || err != nil || stored.Priority != freshservice.PriorityUrgent {
+		t.Errorf("got stored ticket %+v (%t, %v), want it updated", stored, ok, err)
+	}
+
+	if _, _, err = fs.Tickets.DeleteTicket(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteTicket: %v", err)
+	}
+	if _, _, err = fs.Tickets.GetTicket(ctx, 999); !freshservice.IsNotFound(err) {
+		t.Errorf("got error %v, want not found", err)
+	}
+}
+
+func TestServerValidation(t *testing.T) {
+	fs, _ := freshservicetest.New(t)
+
+	_, _, err := fs.Requesters.CreateRequester(context.Background(), &freshservice.CreateRequesterModel{FirstName: "Jane"})
+
+	var e *freshservice.ErrorResponse
+	if !errors.As(err, &e) || !freshservice.IsValidation(err) {
+		t.Fatalf("got error %v, want a validation error", err)
+	}
+	if len(e.Errors) != 1 || e.Errors[0].Field != "primary_email" {
+		t.Errorf("got field errors %v, want primary_email", e.Errors)
+	}
+}
+
+func TestServerUnsupportedEndpoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{
+		{name: "unknown collection", method: http.MethodGet, path: "/widgets", want: http.StatusNotImplemented},
+		{name: "unknown action", method: http.MethodPost, path: "/tickets/1/merge", want: http.StatusNotImplemented},
+		{name: "read-only collection", method: http.MethodPost, path: "/ticket_form_fields", want: http.StatusMethodNotAllowed},
+		{name: "read-only collection list", method: http.MethodGet, path: "/ticket_form_fields", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, srv := freshservicetest.New(t)
+			if res := do(t, srv, tt.method, tt.path, `{}`); res.StatusCode != tt.want {
+				t.Errorf("got status %d, want %d", res.StatusCode, tt.want)
+			}
+		})
+	}
+
+	_, srv := freshservicetest.New(t)
+	if _, err := srv.Seed("widgets", map[string]interface{}{"name": "Sprocket"}); err == nil {
+		t.Errorf("seeded a collection which is not emulated")
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Date(2021, 7, 1, 9, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	_, srv := freshservicetest.New(t, freshservicetest.WithRateLimit(2), freshservicetest.WithClock(clock))
+
+	steps := []struct {
+		advance        time.Duration
+		want           int
+		wantRemaining  string
+		wantRetryAfter string
+	}{
+		{want: http.StatusOK, wantRemaining: "1"},
+		{want: http.StatusOK, wantRemaining: "0"},
+		{advance: 20 * time.Second, want: http.StatusTooManyRequests, wantRemaining: "0", wantRetryAfter: "40"},
+		{advance: 40 * time.Second, want: http.StatusOK, wantRemaining: "1"},
+	}
+
+	for i, s := range steps {
+		mu.Lock()
+		now = now.Add(s.advance)
+		mu.Unlock()
+
+		res := do(t, srv, http.MethodGet, "/tickets", "")
+		if res.StatusCode != s.want {
+			t.Errorf("request %d: got status %d, want %d", i+1, res.StatusCode, s.want)
+		}
+		if got := res.Header.Get("X-RateLimit-Remaining"); got != s.wantRemaining {
+			t.Errorf("request %d: got remaining %s, want %s", i+1, got, s.wantRemaining)
+		}
+		if got := res.Header.Get("Retry-After"); got != s.wantRetryAfter {
+			t.Errorf("request %d: got Retry-After %q, want %q", i+1, got, s.wantRetryAfter)
+		}
+	}
+}
+
+func TestServerInjectedFailures(t *testing.T) {
+	fs, srv := freshservicetest.New(t)
+	ctx := context.Background()
+
+	srv.FailNext(2, http.StatusBadGateway)
+	if _, _, err := fs.Tickets.ListTickets(ctx, nil); err != nil {
+		t.Fatalf("ListTickets was not retried: %v", err)
+	}
+	if got := len(srv.Requests()); got != 3 {
+		t.Errorf("got %d requests, want 3", got)
+	}
+
+	srv.FailNext(10, http.StatusInternalServerError)
+	if _, _, err := fs.Tickets.ListTickets(ctx, nil); err == nil {
+		t.Errorf("ListTickets succeeded, want the injected failures to exhaust the retries")
+	}
+
+	if res := do(t, srv, http.MethodGet, "/tickets", ""); res.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got status %d, want the remaining injected failures", res.StatusCode)
+	}
+}
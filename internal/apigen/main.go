@@ -0,0 +1,479 @@
+// Command apigen generates the service interfaces of the freshservice package (api.go) and their mocks in the
+// freshservicemock package (mocks.go) from the methods of the services on freshservice.Client.
+//
+// It is run from the freshservice package using go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	pkgPath    = "github.com/theapsgroup/go-freshservice/freshservice"
+	apiFile    = "api.go"
+	mockDir    = "../freshservicemock"
+	mockFile   = "mocks.go"
+	generated  = "// Code generated by apigen; DO NOT EDIT.\n\n"
+	clientType = "Client"
+)
+
+// service is a service of the Client, e.g. Tickets *TicketService
+type service struct {
+	Field   string
+	Type    string
+	Methods []method
+}
+
+// Interface is the name of the interface of the service
+func (s service) Interface() string {
+	return s.Field + "API"
+}
+
+type method struct {
+	Name    string
+	Doc     string
+	Params  []param
+	Results []ast.Expr
+}
+
+type param struct {
+	Name     string
+	Type     ast.Expr
+	Variadic bool
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != apiFile
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pkg, ok := pkgs["freshservice"]
+	if !ok {
+		log.Fatal("apigen must be run from the freshservice package")
+	}
+
+	services, imports := collect(pkg)
+
+	if err = write(apiFile, generateAPI(services, imports)); err != nil {
+		log.Fatal(err)
+	}
+	if err = write(filepath.Join(mockDir, mockFile), generateMocks(services, imports)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// collect finds the services of the Client and their exported methods, along with the imports of the package
+func collect(pkg *ast.Package) ([]service, map[string]string) {
+	var services []service
+	byType := map[string]*service{}
+	imports := map[string]string{}
+
+	files := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	for _, name := range files {
+		for _, imp := range pkg.Files[name].Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			local := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				local = imp.Name.Name
+			}
+			imports[local] = path
+		}
+
+		for _, decl := range pkg.Files[name].Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok || ts.Name.Name != clientType {
+					continue
+				}
+				for _, f := range st.Fields.List {
+					star, ok := f.Type.(*ast.StarExpr)
+					if !ok || len(f.Names) != 1 || !f.Names[0].IsExported() {
+						continue
+					}
+					if id, ok := star.X.(*ast.Ident); ok && strings.HasSuffix(id.Name, "Service") {
+						services = append(services, service{Field: f.Names[0].Name, Type: id.Name})
+					}
+				}
+			}
+		}
+	}
+
+	for i := range services {
+		byType[services[i].Type] = &services[i]
+	}
+
+	for _, name := range files {
+		for _, decl := range pkg.Files[name].Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			s, ok := byType[star.X.(*ast.Ident).Name]
+			if !ok {
+				continue
+			}
+			s.Methods = append(s.Methods, newMethod(fn))
+		}
+	}
+
+	for i := range services {
+		sort.Slice(services[i].Methods, func(a, b int) bool {
+			return services[i].Methods[a].Name < services[i].Methods[b].Name
+		})
+	}
+
+	return services, imports
+}
+
+func newMethod(fn *ast.FuncDecl) method {
+	m := method{Name: fn.Name.Name}
+	if fn.Doc != nil {
+		m.Doc = strings.TrimSpace(fn.Doc.Text())
+	}
+
+	for _, f := range fn.Type.Params.List {
+		typ, variadic := f.Type, false
+		if e, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = e.Elt, true
+		}
+		if len(f.Names) == 0 {
+			m.Params = append(m.Params, param{Type: typ, Variadic: variadic})
+		}
+		for _, n := range f.Names {
+			m.Params = append(m.Params, param{Name: n.Name, Type: typ, Variadic: variadic})
+		}
+	}
+	for i := range m.Params {
+		if m.Params[i].Name == "" || m.Params[i].Name == "_" || m.Params[i].Name == "m" {
+			m.Params[i].Name = fmt.Sprintf("p%d", i)
+		}
+	}
+
+	if fn.Type.Results != nil {
+		for _, f := range fn.Type.Results.List {
+			n := len(f.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.Results = append(m.Results, f.Type)
+			}
+		}
+	}
+
+	return m
+}
+
+// typeString formats a type expression, qualifying the types of the freshservice package with qualifier when set
+func typeString(expr ast.Expr, qualifier string, used map[string]bool) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if qualifier != "" && t.IsExported() {
+			return qualifier + "." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, qualifier, used)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		used[pkg] = true
+		return pkg + "." + t.Sel.Name
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return fmt.Sprintf("[%s]%s", t.Len.(*ast.BasicLit).Value, typeString(t.Elt, qualifier, used))
+		}
+		return "[]" + typeString(t.Elt, qualifier, used)
+	case *ast.MapType:
+		return fmt.Sprintf("map[%s]%s", typeString(t.Key, qualifier, used), typeString(t.Value, qualifier, used))
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt, qualifier, used)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.ChanType:
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + typeString(t.Value, qualifier, used)
+		case ast.RECV:
+			return "<-chan " + typeString(t.Value, qualifier, used)
+		}
+		return "chan " + typeString(t.Value, qualifier, used)
+	case *ast.FuncType:
+		var params, results []string
+		for _, f := range t.Params.List {
+			for range namesOrOne(f) {
+				params = append(params, typeString(f.Type, qualifier, used))
+			}
+		}
+		if t.Results != nil {
+			for _, f := range t.Results.List {
+				for range namesOrOne(f) {
+					results = append(results, typeString(f.Type, qualifier, used))
+				}
+			}
+		}
+		return "func(" + strings.Join(params, ", ") + ")" + resultString(results)
+	}
+	log.Fatalf("unsupported type %T", expr)
+	return ""
+}
+
+func namesOrOne(f *ast.Field) []*ast.Ident {
+	if len(f.Names) == 0 {
+		return []*ast.Ident{nil}
+	}
+	return f.Names
+}
+
+func resultString(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + results[0]
+	}
+	return " (" + strings.Join(results, ", ") + ")"
+}
+
+// signature formats the parameters and results of a method
+func (m method) signature(qualifier string, used map[string]bool) string {
+	params := make([]string, 0, len(m.Params))
+	for _, p := range m.Params {
+		typ := typeString(p.Type, qualifier, used)
+		if p.Variadic {
+			typ = "..." + typ
+		}
+		params = append(params, p.Name+" "+typ)
+	}
+
+	return "(" + strings.Join(params, ", ") + ")" + resultString(m.results(qualifier, used))
+}
+
+func (m method) results(qualifier string, used map[string]bool) []string {
+	results := make([]string, 0, len(m.Results))
+	for _, r := range m.Results {
+		results = append(results, typeString(r, qualifier, used))
+	}
+	return results
+}
+
+// args formats the arguments used to forward a call
+func (m method) args() string {
+	args := make([]string, 0, len(m.Params))
+	for _, p := range m.Params {
+		if p.Variadic {
+			args = append(args, p.Name+"...")
+			continue
+		}
+		args = append(args, p.Name)
+	}
+	return strings.Join(args, ", ")
+}
+
+// recordArgs formats the arguments as recorded by a mock
+func (m method) recordArgs() string {
+	args := make([]string, 0, len(m.Params))
+	for _, p := range m.Params {
+		args = append(args, p.Name)
+	}
+	return strings.Join(args, ", ")
+}
+
+func generateAPI(services []service, imports map[string]string) []byte {
+	used := map[string]bool{}
+	var body bytes.Buffer
+
+	for _, s := range services {
+		fmt.Fprintf(&body, "// %s is the interface of %s, allowing it to be replaced (e.g. by freshservicemock) in tests\n", s.Interface(), s.Type)
+		fmt.Fprintf(&body, "type %s interface {\n", s.Interface())
+		for i, m := range s.Methods {
+			if i > 0 && m.Doc != "" {
+				body.WriteString("\n")
+			}
+			writeDoc(&body, m.Doc)
+			fmt.Fprintf(&body, "%s%s\n", m.Name, m.signature("", used))
+		}
+		body.WriteString("}\n\n")
+	}
+
+	body.WriteString("var (\n")
+	for _, s := range services {
+		fmt.Fprintf(&body, "_ %s = (*%s)(nil)\n", s.Interface(), s.Type)
+	}
+	body.WriteString(")\n\n")
+
+	body.WriteString("// API holds the services of a Client as interfaces, code depending on API rather than Client can be tested by\n")
+	body.WriteString("// assembling it from mocks (see freshservicemock)\n")
+	body.WriteString("type API struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(&body, "%s %s\n", s.Field, s.Interface())
+	}
+	body.WriteString("}\n\n")
+
+	body.WriteString("// API returns the services of the Client as an API\n")
+	body.WriteString("func (c *Client) API() *API {\nreturn &API{\n")
+	for _, s := range services {
+		fmt.Fprintf(&body, "%s: c.%s,\n", s.Field, s.Field)
+	}
+	body.WriteString("}\n}\n")
+
+	var out bytes.Buffer
+	out.WriteString(generated)
+	out.WriteString("package freshservice\n\n")
+	writeImports(&out, used, imports, nil)
+	out.Write(body.Bytes())
+	return out.Bytes()
+}
+
+func generateMocks(services []service, imports map[string]string) []byte {
+	used := map[string]bool{}
+	var body bytes.Buffer
+
+	for _, s := range services {
+		fmt.Fprintf(&body, "// %s is a mock of freshservice.%s\n", s.Interface(), s.Interface())
+		fmt.Fprintf(&body, "type %s struct {\nMock\n\n", s.Interface())
+		for _, m := range s.Methods {
+			fmt.Fprintf(&body, "%sFunc func%s\n", m.Name, m.signature("freshservice", used))
+		}
+		body.WriteString("}\n\n")
+
+		for _, m := range s.Methods {
+			results := m.results("freshservice", used)
+
+			fmt.Fprintf(&body, "// %s calls %sFunc when set, otherwise it returns the canned response\n", m.Name, m.Name)
+			fmt.Fprintf(&body, "func (m *%s) %s%s {\n", s.Interface(), m.Name, m.signature("freshservice", used))
+			if len(m.Params) > 0 {
+				fmt.Fprintf(&body, "m.record(%q, %s)\n", m.Name, m.recordArgs())
+			} else {
+				fmt.Fprintf(&body, "m.record(%q)\n", m.Name)
+			}
+			fmt.Fprintf(&body, "if m.%sFunc != nil {\n", m.Name)
+			if len(results) > 0 {
+				fmt.Fprintf(&body, "return m.%sFunc(%s)\n}\n", m.Name, m.args())
+			} else {
+				fmt.Fprintf(&body, "m.%sFunc(%s)\nreturn\n}\n", m.Name, m.args())
+			}
+
+			if len(results) == 0 {
+				fmt.Fprintf(&body, "m.returns(%q)\n}\n\n", m.Name)
+				continue
+			}
+
+			names := make([]string, len(results))
+			ptrs := make([]string, len(results))
+			for i, r := range results {
+				names[i] = fmt.Sprintf("r%d", i)
+				ptrs[i] = "&" + names[i]
+				fmt.Fprintf(&body, "var %s %s\n", names[i], r)
+			}
+			fmt.Fprintf(&body, "m.returns(%q, %s)\n", m.Name, strings.Join(ptrs, ", "))
+			fmt.Fprintf(&body, "return %s\n}\n\n", strings.Join(names, ", "))
+		}
+	}
+
+	body.WriteString("// API holds a mock of every service\n")
+	body.WriteString("type API struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(&body, "%s *%s\n", s.Field, s.Interface())
+	}
+	body.WriteString("}\n\n")
+
+	body.WriteString("// New returns an API with a new mock for every service\n")
+	body.WriteString("func New() *API {\nreturn &API{\n")
+	for _, s := range services {
+		fmt.Fprintf(&body, "%s: &%s{},\n", s.Field, s.Interface())
+	}
+	body.WriteString("}\n}\n\n")
+
+	body.WriteString("// API returns the mocks as a freshservice.API\n")
+	body.WriteString("func (a *API) API() *freshservice.API {\nreturn &freshservice.API{\n")
+	for _, s := range services {
+		fmt.Fprintf(&body, "%s: a.%s,\n", s.Field, s.Field)
+	}
+	body.WriteString("}\n}\n\n")
+
+	body.WriteString("var (\n")
+	for _, s := range services {
+		fmt.Fprintf(&body, "_ freshservice.%s = (*%s)(nil)\n", s.Interface(), s.Interface())
+	}
+	body.WriteString(")\n")
+
+	var out bytes.Buffer
+	out.WriteString(generated)
+	out.WriteString("package freshservicemock\n\n")
+	writeImports(&out, used, imports, []string{pkgPath})
+	out.Write(body.Bytes())
+	return out.Bytes()
+}
+
+func writeDoc(w *bytes.Buffer, doc string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		fmt.Fprintf(w, "// %s\n", line)
+	}
+}
+
+func writeImports(w *bytes.Buffer, used map[string]bool, imports map[string]string, extra []string) {
+	var paths []string
+	for pkg := range used {
+		path, ok := imports[pkg]
+		if !ok {
+			log.Fatalf("unknown import %s", pkg)
+		}
+		if pkg != path[strings.LastIndex(path, "/")+1:] {
+			path = pkg + " " + strconv.Quote(path)
+		} else {
+			path = strconv.Quote(path)
+		}
+		paths = append(paths, path)
+	}
+	for _, e := range extra {
+		paths = append(paths, strconv.Quote(e))
+	}
+	sort.Strings(paths)
+
+	w.WriteString("import (\n")
+	for _, p := range paths {
+		fmt.Fprintf(w, "%s\n", p)
+	}
+	w.WriteString(")\n\n")
+}
+
+func write(name string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("unable to format %s: %v\n%s", name, err, src)
+	}
+	return ioutil.WriteFile(name, formatted, 0644)
+}
@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+var (
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	timeType            = reflect.TypeOf(freshservice.Time{})
+	dateType            = reflect.TypeOf(freshservice.Date{})
+)
+
+// displayIDPattern matches ids as rendered by placeholders, e.g. 12, #INC-12 or CHN-12
+var displayIDPattern = regexp.MustCompile(`^#?(?:[A-Za-z]+-)?(\d+)$`)
+
+// placeholderTimeLayouts are the formats of date placeholders (e.g. {{ticket.due_by_time}}), which are not
+// understood by freshservice.Time
+var placeholderTimeLayouts = []string{
+	"Mon, 02 Jan 2006 03:04 PM",
+	"Mon, 2 Jan 2006 3:04 PM",
+	"Mon, 02 Jan, 2006 03:04 PM",
+	"Mon, 02 Jan 2006 03:04 PM -0700",
+	"Mon, 02 Jan, 2006 03:04 PM -0700",
+}
+
+// decodePlaceholders decodes raw (the object under key) into v, converting the text rendered by placeholders to the types
+// of the fields of v
+func decodePlaceholders(key string, raw json.RawMessage, v interface{}) error {
+	var value interface{}
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&value); err != nil {
+		return err
+	}
+
+	value, err := normalize(value, reflect.TypeOf(v), key)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// normalize converts value to the JSON form expected by t
+func normalize(value interface{}, t reflect.Type, path string) (interface{}, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	s, isString := value.(string)
+	if isString {
+		s = strings.TrimSpace(s)
+	}
+
+	if t == timeType || t == dateType {
+		if isString {
+			return placeholderTime(s), nil
+		}
+		return value, nil
+	}
+
+	if reflect.PtrTo(t).Implements(unmarshalerType) || reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		if isString && s == "" {
+			return nil, nil
+		}
+		if isString && isEnum(t) && !decodesAs(t, s) {
+			// the names of custom values (e.g. a custom ticket status) can't be mapped to their number, so they are left
+			// as 0 and remain readable through Event.Field
+			return nil, nil
+		}
+		return value, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !isString {
+			return value, nil
+		}
+		if s == "" {
+			return nil, nil
+		}
+		m := displayIDPattern.FindStringSubmatch(s)
+		if m == nil {
+			return nil, fmt.Errorf("%s: %q is not a number", path, s)
+		}
+		return json.Number(m[1]), nil
+
+	case reflect.Float32, reflect.Float64:
+		if !isString {
+			return value, nil
+		}
+		if s == "" {
+			return nil, nil
+		}
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("%s: %q is not a number", path, s)
+		}
+		return json.Number(s), nil
+
+	case reflect.Bool:
+		if !isString {
+			return value, nil
+		}
+		switch strings.ToLower(s) {
+		case "true", "yes", "1":
+			return true, nil
+		case "false", "no", "0", "":
+			return false, nil
+		}
+		return nil, fmt.Errorf("%s: %q is not a boolean", path, s)
+
+	case reflect.String:
+		switch val := value.(type) {
+		case json.Number:
+			return val.String(), nil
+		case bool:
+			return strconv.FormatBool(val), nil
+		}
+		return value, nil
+
+	case reflect.Slice:
+		// list placeholders (e.g. {{ticket.tags}}) are rendered as comma separated text
+		if isString {
+			var list []interface{}
+			for _, item := range strings.Split(s, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, item)
+				}
+			}
+			value = list
+		}
+		list, ok := value.([]interface{})
+		if !ok {
+			return value, nil
+		}
+		for i := range list {
+			item, err := normalize(list[i], t.Elem(), fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = item
+		}
+		return list, nil
+
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return value, nil
+		}
+		fields := jsonFields(t)
+		for k, v := range obj {
+			ft, ok := fields[k]
+			if !ok {
+				continue
+			}
+			nv, err := normalize(v, ft, joinPath(path, k))
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = nv
+		}
+		return obj, nil
+
+	case reflect.Map:
+		obj, ok := value.(map[string]interface{})
+		if !ok || t.Elem().Kind() == reflect.Interface {
+			return value, nil
+		}
+		for k, v := range obj {
+			nv, err := normalize(v, t.Elem(), joinPath(path, k))
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = nv
+		}
+		return obj, nil
+	}
+
+	return value, nil
+}
+
+// isEnum reports whether t is one of the numeric enums of freshservice (e.g. freshservice.TicketStatus)
+func isEnum(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.PtrTo(t).Implements(unmarshalerType)
+	}
+	return false
+}
+
+// decodesAs reports whether the JSON string s can be decoded into t
+func decodesAs(t reflect.Type, s string) bool {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, reflect.New(t).Interface()) == nil
+}
+
+// placeholderTime converts the date placeholder formats to RFC3339, other values are left to freshservice.Time
+func placeholderTime(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	for _, layout := range placeholderTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format(time.RFC3339)
+		}
+	}
+	return s
+}
+
+// jsonFields returns the types of the fields of struct t by their JSON names, including embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || (f.PkgPath != "" && !f.Anonymous) {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFields(ft) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
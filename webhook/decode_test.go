@@ -0,0 +1,81 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/webhook"
+)
+
+func post(h http.Handler, body string, prepare func(r *http.Request)) int {
+	r := httptest.NewRequest(http.MethodPost, "/freshservice", strings.NewReader(body))
+	if prepare != nil {
+		prepare(r)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestHandlerEnumNames(t *testing.T) {
+	tests := []struct {
+		name         string
+		payload      string
+		wantStatus   freshservice.TicketStatus
+		wantPriority freshservice.Priority
+		wantText     string
+	}{
+		{
+			name:         "known names",
+			payload:      `{"event": "ticket_updated", "ticket": {"id": "12", "status": "Pending", "priority": "High"}}`,
+			wantStatus:   freshservice.TicketPending,
+			wantPriority: freshservice.PriorityHigh,
+			wantText:     "Pending",
+		},
+		{
+			name:         "custom status",
+			payload:      `{"event": "ticket_updated", "ticket": {"id": "12", "status": "Awaiting Vendor", "priority": "Low"}}`,
+			wantStatus:   0,
+			wantPriority: freshservice.PriorityLow,
+			wantText:     "Awaiting Vendor",
+		},
+		{
+			name:       "numbers",
+			payload:    `{"event": "ticket_updated", "ticket": {"id": "12", "status": "7"}}`,
+			wantStatus: freshservice.TicketStatus(7),
+			wantText:   "7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := webhook.NewHandler(webhook.WithoutAuth())
+			if err != nil {
+				t.Fatalf("NewHandler: %v", err)
+			}
+
+			var got *webhook.TicketEvent
+			h.OnTicket(webhook.TicketUpdated, func(_ context.Context, e *webhook.TicketEvent) error {
+				got = e
+				return nil
+			})
+
+			if code := post(h, tt.payload, nil); code != http.StatusOK {
+				t.Fatalf("got status %d, want 200", code)
+			}
+			if got == nil {
+				t.Fatalf("handler was not called")
+			}
+			if got.Ticket.Status != tt.wantStatus || got.Ticket.Priority != tt.wantPriority {
+				t.Errorf("got status %d priority %d, want %d and %d", got.Ticket.Status, got.Ticket.Priority, tt.wantStatus, tt.wantPriority)
+			}
+			if text := got.Field("ticket", "status"); text != tt.wantText {
+				t.Errorf("got status text %q, want %q", text, tt.wantText)
+			}
+		})
+	}
+}
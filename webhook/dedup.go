@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DedupStatus is the result of Deduplicator.Reserve
+type DedupStatus int
+
+const (
+	// Reserved means the event was claimed by the caller, which must handle it then Mark or Release it
+	Reserved DedupStatus = iota
+	// InFlight means the event is being handled by another delivery, whose outcome is not known yet
+	InFlight
+	// Handled means the event has been handled successfully
+	Handled
+)
+
+// Deduplicator tracks the events which have been handled, so retried deliveries of the Workflow Automator are ignored.
+// Reserve atomically claims an event before it is dispatched, so that concurrent deliveries are only handled once, Mark
+// is called once its handlers succeed and Release when they fail so that the next retry handles it again.
+// A Deduplicator shared by several replicas (e.g. backed by Redis SET NX) can be provided using WithDeduplicator.
+type Deduplicator interface {
+	// Reserve claims key, reporting InFlight or Handled when the event is being handled or has been
+	Reserve(ctx context.Context, key string) (DedupStatus, error)
+	// Release gives up the claim on key of a failed event
+	Release(ctx context.Context, key string) error
+	// Mark records key as handled
+	Mark(ctx context.Context, key string) error
+}
+
+// MemoryDeduplicator is an in-memory Deduplicator which remembers events for a window
+type MemoryDeduplicator struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]dedupEntry
+	swept   time.Time
+}
+
+// dedupEntry is an event which is being handled, or has been when handled is set
+type dedupEntry struct {
+	at      time.Time
+	handled bool
+}
+
+// NewMemoryDeduplicator creates a MemoryDeduplicator remembering events for window
+func NewMemoryDeduplicator(window time.Duration) *MemoryDeduplicator {
+	return &MemoryDeduplicator{window: window, now: time.Now, entries: map[string]dedupEntry{}}
+}
+
+// Reserve implements Deduplicator, an expired entry of key is ignored and the others are swept at most once per window
+func (d *MemoryDeduplicator) Reserve(_ context.Context, key string) (DedupStatus, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	if now.Sub(d.swept) >= d.window {
+		for k, e := range d.entries {
+			if now.Sub(e.at) >= d.window {
+				delete(d.entries, k)
+			}
+		}
+		d.swept = now
+	}
+
+	if e, ok := d.entries[key]; ok && now.Sub(e.at) < d.window {
+		if e.handled {
+			return Handled, nil
+		}
+		return InFlight, nil
+	}
+	d.entries[key] = dedupEntry{at: now}
+	return Reserved, nil
+}
+
+// Release implements Deduplicator, events which have been marked as handled are kept
+func (d *MemoryDeduplicator) Release(_ context.Context, key string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if e, ok := d.entries[key]; ok && !e.handled {
+		delete(d.entries, key)
+	}
+	return nil
+}
+
+// Mark implements Deduplicator
+func (d *MemoryDeduplicator) Mark(_ context.Context, key string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.entries[key] = dedupEntry{at: d.now(), handled: true}
+	return nil
+}
+
+// size returns the number of remembered events
+func (d *MemoryDeduplicator) size() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.entries)
+}
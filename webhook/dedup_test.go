@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestMemoryDeduplicatorExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2021, 7, 1, 9, 0, 0, 0, time.UTC)
+	d := NewMemoryDeduplicator(time.Hour)
+	d.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		if _, err := d.Reserve(ctx, strconv.Itoa(i)); err != nil {
+			t.Fatalf("Reserve: %v", err)
+		}
+	}
+	if err := d.Mark(ctx, "0"); err != nil {
+		t.Fatalf("Mark: %v", err)
+	}
+
+	now = now.Add(30 * time.Minute)
+	if status, _ := d.Reserve(ctx, "0"); status != Handled {
+		t.Errorf("got %d within the window, want Handled", status)
+	}
+	if got := d.size(); got != 10 {
+		t.Errorf("got %d entries within the window, want 10", got)
+	}
+
+	// the entry of the key expires on its own, the others are swept once a window has passed since the last sweep
+	now = now.Add(45 * time.Minute)
+	if status, _ := d.Reserve(ctx, "0"); status != Reserved {
+		t.Errorf("got %d once expired, want Reserved", status)
+	}
+	if got := d.size(); got != 1 {
+		t.Errorf("got %d entries after the sweep, want 1", got)
+	}
+}
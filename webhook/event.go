@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+)
+
+// EventType is the name of an event, as set in the "event" field of the payload by the Workflow Automator
+type EventType string
+
+const (
+	TicketCreated  EventType = "ticket_created"
+	TicketUpdated  EventType = "ticket_updated"
+	TicketResolved EventType = "ticket_resolved"
+	TicketClosed   EventType = "ticket_closed"
+	ChangeCreated  EventType = "change_created"
+	ChangeUpdated  EventType = "change_updated"
+	ChangeApproved EventType = "change_approved"
+	ChangeRejected EventType = "change_rejected"
+	ProblemCreated EventType = "problem_created"
+	ProblemUpdated EventType = "problem_updated"
+	ReleaseCreated EventType = "release_created"
+	ReleaseUpdated EventType = "release_updated"
+)
+
+// Event is a payload received from the Workflow Automator.
+// The payload is a JSON object with the event name, an optional id used to detect retries, and the resource built from
+// placeholders under its name (e.g. "ticket").
+//
+//	{
+//	  "event": "ticket_created",
+//	  "event_id": "{{ticket.id}}-{{ticket.created_at}}",
+//	  "ticket": {"id": "{{ticket.id_numeric}}", "subject": "{{ticket.subject}}", "status": "{{ticket.status}}"}
+//	}
+type Event struct {
+	Type       EventType
+	ID         string
+	ReceivedAt time.Time
+	// Payload is the raw body of the request
+	Payload json.RawMessage
+
+	fields map[string]json.RawMessage
+}
+
+// Decode decodes the object under key in the payload (e.g. "ticket") into v, converting the placeholder values to the
+// types of v (see the package documentation)
+func (e *Event) Decode(key string, v interface{}) error {
+	raw, ok := e.fields[key]
+	if !ok {
+		return fmt.Errorf("payload of %s has no %s", e.Type, key)
+	}
+	return decodePlaceholders(key, raw, v)
+}
+
+// Field returns the text rendered for field of the object under key (e.g. "ticket", "status"), or "" when it is
+// missing. It gives access to values the models can't represent, such as the name of a custom status which decodes as 0.
+func (e *Event) Field(key string, field string) string {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(e.fields[key], &obj); err != nil {
+		return ""
+	}
+	return stringValue(obj[field])
+}
+
+// TicketEvent is an Event carrying a Ticket
+type TicketEvent struct {
+	*Event
+	Ticket freshservice.Ticket
+}
+
+// ChangeEvent is an Event carrying a Change
+type ChangeEvent struct {
+	*Event
+	Change freshservice.Change
+}
+
+// ProblemEvent is an Event carrying a Problem
+type ProblemEvent struct {
+	*Event
+	Problem freshservice.Problem
+}
+
+// ReleaseEvent is an Event carrying a Release
+type ReleaseEvent struct {
+	*Event
+	Release freshservice.Release
+}
+
+// parseEvent reads the payload of a request
+func parseEvent(body []byte) (*Event, error) {
+	e := &Event{Payload: body}
+	if err := json.Unmarshal(body, &e.fields); err != nil {
+		return nil, fmt.Errorf("invalid payload: %v", err)
+	}
+
+	e.Type = EventType(stringValue(e.fields["event"]))
+	e.ID = stringValue(e.fields["event_id"])
+	if e.Type == "" {
+		return nil, fmt.Errorf("invalid payload: missing event")
+	}
+	return e, nil
+}
+
+// stringValue returns the text of a JSON string or the literal of any other value (placeholders may be left unquoted)
+func stringValue(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	if string(raw) == "null" {
+		return ""
+	}
+	return string(raw)
+}
@@ -0,0 +1,325 @@
+// Package webhook receives the payloads POSTed by the FreshService Workflow Automator ("Trigger Webhook" action).
+//
+// A Handler authenticates the request using a shared secret header and/or basic auth, parses the payload into an Event,
+// ignores retried deliveries and dispatches it to the handler funcs registered for its type. One of the authentication
+// options is required, WithoutAuth must be given explicitly to accept unauthenticated requests.
+//
+// Deliveries of an event which has been handled are answered with 200, those arriving while it is being handled with 503
+// and a Retry-After header so that FreshService retries them. Events are identified by their event_id, events without
+// one are identified by the hash of their payload so distinct events with identical payloads are only handled once.
+//
+// Payloads are built from placeholders which FreshService renders as text, so values are converted to the types of the
+// models when decoded: numbers and ids (including display ids such as #INC-12), booleans, comma separated lists (e.g.
+// tags), dates and enum names (e.g. "Open", "Urgent"). Unknown fields are ignored, as are unknown enum names (e.g. of
+// custom statuses) which decode as 0 and can be read as text using Event.Field.
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSecretHeader is the header checked for the shared secret when no header is given to WithSecret
+	DefaultSecretHeader = "X-Freshservice-Secret"
+	// DefaultMaxBodySize is the size limit of payloads
+	DefaultMaxBodySize = 1 << 20
+	// DefaultDedupWindow is how long events are remembered by the default Deduplicator
+	DefaultDedupWindow = 24 * time.Hour
+
+	// inFlightRetryAfter is the Retry-After (in seconds) of deliveries of an event which is being handled
+	inFlightRetryAfter = "30"
+)
+
+// HandlerFunc handles an Event, returning an error makes the Handler respond with 500 so that FreshService retries
+type HandlerFunc func(ctx context.Context, e *Event) error
+
+// Handler is an http.Handler receiving Workflow Automator payloads
+type Handler struct {
+	secretHeader string
+	secret       string
+	username     string
+	password     string
+	noAuth       bool
+	maxBodySize  int64
+	dedup        Deduplicator
+	onError      func(r *http.Request, err error)
+
+	mu       sync.RWMutex
+	handlers map[EventType][]HandlerFunc
+	fallback []HandlerFunc
+}
+
+// Option configures a Handler
+type Option func(h *Handler)
+
+// WithSecret requires requests to carry the shared secret in header (DefaultSecretHeader when empty)
+func WithSecret(header string, secret string) Option {
+	return func(h *Handler) {
+		if header == "" {
+			header = DefaultSecretHeader
+		}
+		h.secretHeader = header
+		h.secret = secret
+	}
+}
+
+// WithBasicAuth requires requests to use basic auth with the username and password
+func WithBasicAuth(username string, password string) Option {
+	return func(h *Handler) {
+		h.username = username
+		h.password = password
+	}
+}
+
+// WithoutAuth accepts requests without authentication, e.g. when the Handler is behind a proxy which authenticates them
+func WithoutAuth() Option {
+	return func(h *Handler) {
+		h.noAuth = true
+	}
+}
+
+// WithDeduplicator replaces the in-memory Deduplicator, nil disables deduplication
+func WithDeduplicator(d Deduplicator) Option {
+	return func(h *Handler) {
+		h.dedup = d
+	}
+}
+
+// WithMaxBodySize sets the size limit of payloads
+func WithMaxBodySize(n int64) Option {
+	return func(h *Handler) {
+		h.maxBodySize = n
+	}
+}
+
+// WithErrorHandler sets a func called with the errors of rejected requests and failed handlers, e.g. for logging
+func WithErrorHandler(fn func(r *http.Request, err error)) Option {
+	return func(h *Handler) {
+		h.onError = fn
+	}
+}
+
+// NewHandler creates a Handler, failing unless requests are authenticated using WithSecret or WithBasicAuth, or
+// WithoutAuth is given
+func NewHandler(opts ...Option) (*Handler, error) {
+	h := &Handler{
+		maxBodySize: DefaultMaxBodySize,
+		dedup:       NewMemoryDeduplicator(DefaultDedupWindow),
+		handlers:    map[EventType][]HandlerFunc{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	if !h.noAuth && h.secret == "" && h.username == "" && h.password == "" {
+		return nil, errors.New("no authentication configured, use WithSecret, WithBasicAuth or WithoutAuth")
+	}
+	return h, nil
+}
+
+// On registers fn for events of type t
+func (h *Handler) On(t EventType, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[t] = append(h.handlers[t], fn)
+}
+
+// OnUnhandled registers fn for events without a handler of their type
+func (h *Handler) OnUnhandled(fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.fallback = append(h.fallback, fn)
+}
+
+// OnTicket registers fn for events of type t carrying a Ticket
+func (h *Handler) OnTicket(t EventType, fn func(ctx context.Context, e *TicketEvent) error) {
+	h.On(t, func(ctx context.Context, e *Event) error {
+		te := &TicketEvent{Event: e}
+		if err := e.Decode("ticket", &te.Ticket); err != nil {
+			return &payloadError{err}
+		}
+		return fn(ctx, te)
+	})
+}
+
+// OnChange registers fn for events of type t carrying a Change
+func (h *Handler) OnChange(t EventType, fn func(ctx context.Context, e *ChangeEvent) error) {
+	h.On(t, func(ctx context.Context, e *Event) error {
+		ce := &ChangeEvent{Event: e}
+		if err := e.Decode("change", &ce.Change); err != nil {
+			return &payloadError{err}
+		}
+		return fn(ctx, ce)
+	})
+}
+
+// OnProblem registers fn for events of type t carrying a Problem
+func (h *Handler) OnProblem(t EventType, fn func(ctx context.Context, e *ProblemEvent) error) {
+	h.On(t, func(ctx context.Context, e *Event) error {
+		pe := &ProblemEvent{Event: e}
+		if err := e.Decode("problem", &pe.Problem); err != nil {
+			return &payloadError{err}
+		}
+		return fn(ctx, pe)
+	})
+}
+
+// OnRelease registers fn for events of type t carrying a Release
+func (h *Handler) OnRelease(t EventType, fn func(ctx context.Context, e *ReleaseEvent) error) {
+	h.On(t, func(ctx context.Context, e *Event) error {
+		re := &ReleaseEvent{Event: e}
+		if err := e.Decode("release", &re.Release); err != nil {
+			return &payloadError{err}
+		}
+		return fn(ctx, re)
+	})
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	if !h.authenticated(r) {
+		h.fail(w, r, http.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		h.fail(w, r, http.StatusRequestEntityTooLarge, fmt.Errorf("unable to read payload: %v", err))
+		return
+	}
+
+	e, err := parseEvent(body)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	e.ReceivedAt = time.Now()
+
+	ctx := r.Context()
+	key := dedupKey(e)
+	if h.dedup != nil {
+		status, err := h.dedup.Reserve(ctx, key)
+		if err != nil {
+			h.fail(w, r, http.StatusInternalServerError, fmt.Errorf("unable to check for duplicate: %v", err))
+			return
+		}
+		switch status {
+		case Handled:
+			w.WriteHeader(http.StatusOK)
+			return
+		case InFlight:
+			// the outcome of the delivery handling the event is unknown, so FreshService has to retry later
+			w.Header().Set("Retry-After", inFlightRetryAfter)
+			h.fail(w, r, http.StatusServiceUnavailable, fmt.Errorf("%s event %s is being handled", e.Type, e.ID))
+			return
+		}
+	}
+
+	if err = h.dispatch(ctx, e); err != nil {
+		if h.dedup != nil {
+			if rerr := h.dedup.Release(ctx, key); rerr != nil && h.onError != nil {
+				h.onError(r, fmt.Errorf("unable to release event: %v", rerr))
+			}
+		}
+
+		var pe *payloadError
+		if errors.As(err, &pe) {
+			h.fail(w, r, http.StatusBadRequest, err)
+			return
+		}
+		h.fail(w, r, http.StatusInternalServerError, fmt.Errorf("%s handler failed: %w", e.Type, err))
+		return
+	}
+
+	if h.dedup != nil {
+		if err = h.dedup.Mark(ctx, key); err != nil && h.onError != nil {
+			h.onError(r, fmt.Errorf("unable to mark event as handled: %v", err))
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls the handlers of the Event in the order they were registered, stopping at the first error
+func (h *Handler) dispatch(ctx context.Context, e *Event) error {
+	h.mu.RLock()
+	handlers := h.handlers[e.Type]
+	if len(handlers) == 0 {
+		handlers = h.fallback
+	}
+	h.mu.RUnlock()
+
+	for _, fn := range handlers {
+		if err := fn(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authenticated checks the shared secret and basic auth credentials, when configured
+func (h *Handler) authenticated(r *http.Request) bool {
+	if h.secret != "" && !secureEqual(r.Header.Get(h.secretHeader), h.secret) {
+		return false
+	}
+
+	if h.username != "" || h.password != "" {
+		username, password, ok := r.BasicAuth()
+		if !ok || !secureEqual(username, h.username) || !secureEqual(password, h.password) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.onError != nil {
+		h.onError(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// dedupKey identifies an Event by its event_id, or the hash of its payload when it has none. Without an event_id distinct
+// events with identical payloads (e.g. the same ticket updated twice to the same values) are seen as duplicates.
+func dedupKey(e *Event) string {
+	if e.ID != "" {
+		return string(e.Type) + ":" + e.ID
+	}
+	sum := sha256.Sum256(e.Payload)
+	return hex.EncodeToString(sum[:])
+}
+
+func secureEqual(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// payloadError is returned when the payload of an Event can't be decoded for its handler
+type payloadError struct {
+	err error
+}
+
+func (e *payloadError) Error() string {
+	return fmt.Sprintf("invalid payload: %v", e.err)
+}
+
+func (e *payloadError) Unwrap() error {
+	return e.err
+}
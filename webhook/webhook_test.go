@@ -0,0 +1,311 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/theapsgroup/go-freshservice/freshservice"
+	"github.com/theapsgroup/go-freshservice/webhook"
+)
+
+const ticketPayload = `{"event": "ticket_created", "event_id": "12-1", "ticket": {"id": "#INC-12", "subject": "Printer on fire", "priority": "Urgent", "status": "Open", "tags": "printer, vip"}}`
+
+func TestNewHandlerRequiresAuth(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []webhook.Option
+		wantErr bool
+	}{
+		{name: "no options", wantErr: true},
+		{name: "empty secret", opts: []webhook.Option{webhook.WithSecret("", "")}, wantErr: true},
+		{name: "secret", opts: []webhook.Option{webhook.WithSecret("", "s3cret")}},
+		{name: "basic auth", opts: []webhook.Option{webhook.WithBasicAuth("freshservice", "s3cret")}},
+		{name: "without auth", opts: []webhook.Option{webhook.WithoutAuth()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := webhook.NewHandler(tt.opts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got a Handler, want an error")
+				}
+				return
+			}
+			if err != nil || h == nil {
+				t.Fatalf("NewHandler: %v", err)
+			}
+		})
+	}
+}
+
+func TestHandlerAuth(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []webhook.Option
+		prepare func(r *http.Request)
+		want    int
+	}{
+		{
+			name:    "secret",
+			opts:    []webhook.Option{webhook.WithSecret("", "s3cret")},
+			prepare: func(r *http.Request) { r.Header.Set(webhook.DefaultSecretHeader, "s3cret") },
+			want:    http.StatusOK,
+		},
+		{
+			name:    "wrong secret",
+			opts:    []webhook.Option{webhook.WithSecret("", "s3cret")},
+			prepare: func(r *http.Request) { r.Header.Set(webhook.DefaultSecretHeader, "guess") },
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name: "missing secret",
+			opts: []webhook.Option{webhook.WithSecret("", "s3cret")},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name:    "custom secret header",
+			opts:    []webhook.Option{webhook.WithSecret("X-Token", "s3cret")},
+			prepare: func(r *http.Request) { r.Header.Set("X-Token", "s3cret") },
+			want:    http.StatusOK,
+		},
+		{
+			name:    "basic auth",
+			opts:    []webhook.Option{webhook.WithBasicAuth("freshservice", "s3cret")},
+			prepare: func(r *http.Request) { r.SetBasicAuth("freshservice", "s3cret") },
+			want:    http.StatusOK,
+		},
+		{
+			name:    "wrong password",
+			opts:    []webhook.Option{webhook.WithBasicAuth("freshservice", "s3cret")},
+			prepare: func(r *http.Request) { r.SetBasicAuth("freshservice", "guess") },
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name: "secret and basic auth both required",
+			opts: []webhook.Option{webhook.WithSecret("", "s3cret"), webhook.WithBasicAuth("freshservice", "s3cret")},
+			prepare: func(r *http.Request) {
+				r.Header.Set(webhook.DefaultSecretHeader, "s3cret")
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "without auth",
+			opts: []webhook.Option{webhook.WithoutAuth()},
+			want: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := webhook.NewHandler(tt.opts...)
+			if err != nil {
+				t.Fatalf("NewHandler: %v", err)
+			}
+			h.OnUnhandled(func(context.Context, *webhook.Event) error { return nil })
+
+			if got := post(h, ticketPayload, tt.prepare); got != tt.want {
+				t.Errorf("got status %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlerDecodesTicket(t *testing.T) {
+	h, err := webhook.NewHandler(webhook.WithoutAuth())
+	if err != nil {
+		t.Fatalf("NewHandler: %v", err)
+	}
+
+	var got *webhook.TicketEvent
+	h.OnTicket(webhook.TicketCreated, func(_ context.Context, e *webhook.TicketEvent) error {
+		got = e
+		return nil
+	})
+
+	if code := post(h, ticketPayload, nil); code != http.StatusOK {
+		t.Fatalf("got status %d, want 200", code)
+	}
+	if got == nil {
+		t.Fatalf("handler was not called")
+	}
+	if got.Ticket.ID != 12 || got.Ticket.Priority != freshservice.PriorityUrgent || got.Ticket.Status != freshservice.TicketOpen {
+		t.Errorf("got ticket %d priority %s status %s, want 12 Urgent Open", got.Ticket.ID, got.Ticket.Priority, got.Ticket.Status)
+	}
+	if len(got.Ticket.Tags) != 2 || got.Ticket.Tags[1] != "vip" {
+		t.Errorf("got tags %v, want [printer vip]", got.Ticket.Tags)
+	}
+}
+
+func TestHandlerDeduplicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		failFirst bool
+		wantCodes []int
+		wantCalls int32
+	}{
+		{name: "retry of a handled event is ignored", wantCodes: []int{200, 200}, wantCalls: 1},
+		{name: "retry of a failed event is handled", failFirst: true, wantCodes: []int{500, 200, 200}, wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := webhook.NewHandler(webhook.WithoutAuth())
+			if err != nil {
+				t.Fatalf("NewHandler: %v", err)
+			}
+
+			var calls int32
+			h.On(webhook.TicketCreated, func(context.Context, *webhook.Event) error {
+				if atomic.AddInt32(&calls, 1) == 1 && tt.failFirst {
+					return errors.New("database is down")
+				}
+				return nil
+			})
+
+			for i, want := range tt.wantCodes {
+				if got := post(h, ticketPayload, nil); got != want {
+					t.Errorf("delivery %d: got status %d, want %d", i+1, got, want)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("handler called %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestHandlerConcurrentDeliveries(t *testing.T) {
+	h, err := webhook.NewHandler(webhook.WithoutAuth())
+	if err != nil {
+		t.Fatalf("NewHandler: %v", err)
+	}
+
+	var calls int32
+	h.On(webhook.TicketCreated, func(context.Context, *webhook.Event) error {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+
+	var ok, busy int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			switch code := post(h, ticketPayload, nil); code {
+			case http.StatusOK:
+				atomic.AddInt32(&ok, 1)
+			case http.StatusServiceUnavailable:
+				atomic.AddInt32(&busy, 1)
+			default:
+				t.Errorf("got status %d, want 200 or 503", code)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("handler called %d times, want 1", calls)
+	}
+	if ok+busy != 10 || ok < 1 {
+		t.Errorf("got %d deliveries answered 200 and %d answered 503, want at least one 200", ok, busy)
+	}
+}
+
+func TestHandlerRetryWhileInFlight(t *testing.T) {
+	tests := []struct {
+		name         string
+		fail         bool
+		wantFirst    int
+		wantNextCall int32
+	}{
+		{name: "first delivery fails", fail: true, wantFirst: http.StatusInternalServerError, wantNextCall: 2},
+		{name: "first delivery succeeds", wantFirst: http.StatusOK, wantNextCall: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := webhook.NewHandler(webhook.WithoutAuth())
+			if err != nil {
+				t.Fatalf("NewHandler: %v", err)
+			}
+
+			started := make(chan struct{})
+			finish := make(chan struct{})
+			var calls int32
+			h.On(webhook.TicketCreated, func(context.Context, *webhook.Event) error {
+				if atomic.AddInt32(&calls, 1) > 1 {
+					return nil
+				}
+				close(started)
+				<-finish
+				if tt.fail {
+					return errors.New("database is down")
+				}
+				return nil
+			})
+
+			first := make(chan int)
+			go func() { first <- post(h, ticketPayload, nil) }()
+			<-started
+
+			r := httptest.NewRequest(http.MethodPost, "/freshservice", strings.NewReader(ticketPayload))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != http.StatusServiceUnavailable || w.Header().Get("Retry-After") == "" {
+				t.Errorf("retry while in flight: got status %d with Retry-After %q, want 503 with a Retry-After", w.Code, w.Header().Get("Retry-After"))
+			}
+
+			close(finish)
+			if got := <-first; got != tt.wantFirst {
+				t.Errorf("first delivery: got status %d, want %d", got, tt.wantFirst)
+			}
+
+			if got := post(h, ticketPayload, nil); got != http.StatusOK {
+				t.Errorf("next retry: got status %d, want 200", got)
+			}
+			if calls != tt.wantNextCall {
+				t.Errorf("handler called %d times, want %d", calls, tt.wantNextCall)
+			}
+		})
+	}
+}
+
+func TestMemoryDeduplicator(t *testing.T) {
+	ctx := context.Background()
+	d := webhook.NewMemoryDeduplicator(time.Hour)
+
+	steps := []struct {
+		name string
+		do   func() (webhook.DedupStatus, error)
+		want webhook.DedupStatus
+	}{
+		{name: "reserve", do: func() (webhook.DedupStatus, error) { return d.Reserve(ctx, "a") }, want: webhook.Reserved},
+		{name: "reserve while reserved", do: func() (webhook.DedupStatus, error) { return d.Reserve(ctx, "a") }, want: webhook.InFlight},
+		{name: "release", do: func() (webhook.DedupStatus, error) { return webhook.Reserved, d.Release(ctx, "a") }, want: webhook.Reserved},
+		{name: "reserve after release", do: func() (webhook.DedupStatus, error) { return d.Reserve(ctx, "a") }, want: webhook.Reserved},
+		{name: "mark", do: func() (webhook.DedupStatus, error) { return webhook.Reserved, d.Mark(ctx, "a") }, want: webhook.Reserved},
+		{name: "release after mark", do: func() (webhook.DedupStatus, error) { return webhook.Reserved, d.Release(ctx, "a") }, want: webhook.Reserved},
+		{name: "reserve after mark", do: func() (webhook.DedupStatus, error) { return d.Reserve(ctx, "a") }, want: webhook.Handled},
+		{name: "reserve another key", do: func() (webhook.DedupStatus, error) { return d.Reserve(ctx, "b") }, want: webhook.Reserved},
+	}
+
+	for _, s := range steps {
+		got, err := s.do()
+		if err != nil {
+			t.Fatalf("%s: %v", s.name, err)
+		}
+		if got != s.want {
+			t.Fatalf("%s: got %d, want %d", s.name, got, s.want)
+		}
+	}
+}